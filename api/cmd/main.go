@@ -7,6 +7,7 @@ import (
 	"github.com/escalopa/gobank/api/handlers"
 
 	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/risk"
 	"github.com/escalopa/gobank/util"
 )
 
@@ -27,7 +28,12 @@ func main() {
 
 	// Initialize the database
 	conn := db.InitDatabase(config)
-	store := db.NewStore(conn)
+
+	rules, err := risk.NewRules(config)
+	if err != nil {
+		log.Fatalf("cannot create risk rules, err: %s", err)
+	}
+	store := db.NewAuditedStore(conn, db.WithRiskRules(rules...))
 
 	ginServer, err := handlers.NewServer(config, store)
 	if err != nil {
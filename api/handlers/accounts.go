@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"database/sql"
+	"errors"
 	"log"
 	"net/http"
 	"time"
@@ -15,10 +16,13 @@ import (
 )
 
 type accountResponse struct {
-	ID        int64     `json:"id"`
-	Balance   int64     `json:"balance"`
-	Currency  string    `json:"currency"`
-	CreatedAt time.Time `json:"created_at"`
+	ID               int64            `json:"id"`
+	Balance          int64            `json:"balance"`
+	AvailableBalance int64            `json:"available_balance"`
+	OverdraftLimit   int64            `json:"overdraft_limit"`
+	Currency         string           `json:"currency"`
+	Status           db.AccountStatus `json:"status"`
+	CreatedAt        time.Time        `json:"created_at"`
 }
 
 type createAccountReq struct {
@@ -46,7 +50,7 @@ func (s *GinServer) createAccount(ctx *gin.Context) {
 	payload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
 	account, err := s.db.CreateAccount(ctx, db.CreateAccountParams{
 		Owner:    payload.Username,
-		Balance:  1000,
+		Balance:  s.startingBalance,
 		Currency: req.Currency,
 	})
 
@@ -258,3 +262,109 @@ func (s *GinServer) restoreAccount(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, response.Success(account.ID))
 }
+
+type closeAccountReq struct {
+	// SweepToAccountID receives the balance of the account, it can be omitted when the balance is zero
+	SweepToAccountID int64  `json:"sweep_to_account_id" binding:"omitempty,min=1"`
+	Reason           string `json:"reason" binding:"max=255"`
+}
+
+type closeAccountResponse struct {
+	Account *accountResponse `json:"account"`
+	// Sweep is the transfer that emptied the account, omitted when nothing was swept
+	Sweep *transferResponse `json:"sweep,omitempty"`
+}
+
+// CloseAccount godoc
+//
+//	@Summary		closes an account of the currently logged-in user
+//	@Description	closes an account for good, its balance must be zero or is swept to another account first, closed accounts can neither be credited nor debited
+//	@Tags			accounts
+//	@Accept			json
+//	@Produce		json
+//	@Param			id						path		int64			true	"Account ID"
+//	@Param			body					body		closeAccountReq	true	"Account to sweep the balance to"
+//	@Success		200						{object}	response.JSON{data=closeAccountResponse}
+//	@Failure		400,401,403,404,422,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/accounts/{id}/close [post]
+func (s *GinServer) closeAccount(ctx *gin.Context) {
+	var uri getAccountReq
+	if err := parseUri(ctx, &uri); err != nil {
+		return
+	}
+
+	var req closeAccountReq
+	if err := parseBody(ctx, &req); err != nil {
+		return
+	}
+
+	account, isValid := s.isValidAccount(ctx, uri.ID)
+	if !isValid {
+		return
+	}
+
+	if !isUserAccountOwner(ctx, account) {
+		ctx.JSON(http.StatusUnauthorized, response.Err(ErrNotAccountOwner))
+		return
+	}
+
+	arg := db.CloseAccountTxParam{
+		AccountID: account.ID,
+		ChangedBy: account.Owner,
+		Reason:    req.Reason,
+	}
+
+	if req.SweepToAccountID != 0 {
+		from, to, isValid := s.validateTransfer(ctx, account.ID, req.SweepToAccountID)
+		if !isValid {
+			return
+		}
+
+		rate, isValid := s.transferRate(ctx, from, to)
+		if !isValid {
+			return
+		}
+
+		arg.SweepToAccountID = to.ID
+		arg.Rate = rate
+	}
+
+	result, err := s.db.CloseAccountTx(ctx, arg)
+	if err != nil {
+		accountStatusTxError(ctx, err)
+		return
+	}
+
+	res := closeAccountResponse{Account: mapAccountToResponse(result.Account)}
+	if result.Sweep != nil {
+		sweep := fromTransferTxToTransferResponse(*result.Sweep)
+		res.Sweep = &sweep
+	}
+	ctx.JSON(http.StatusOK, response.Success(res))
+}
+
+// accountStatusTxError responds with the error of a status change, the limit and risk errors come from the sweep
+func accountStatusTxError(ctx *gin.Context, err error) {
+	var limitErr *db.TransferLimitError
+	if errors.As(err, &limitErr) {
+		ctx.JSON(http.StatusUnprocessableEntity, response.ErrWithData(err, limitErr))
+		return
+	}
+
+	var deniedErr *db.RiskDeniedError
+	if errors.As(err, &deniedErr) {
+		ctx.JSON(http.StatusForbidden, response.ErrWithData(err, deniedErr))
+		return
+	}
+
+	switch err {
+	case db.ErrInvalidStatusTransition, db.ErrAccountBalanceNotZero, db.ErrAccountHasHolds, db.ErrAccountFrozen, db.ErrAccountClosed, db.ErrConvertedAmountTooSmall:
+		ctx.JSON(http.StatusBadRequest, response.Err(err))
+		return
+	case db.ErrInsufficientFunds:
+		ctx.JSON(http.StatusUnprocessableEntity, response.Err(err))
+		return
+	}
+	ctx.JSON(http.StatusInternalServerError, response.Err(err))
+}
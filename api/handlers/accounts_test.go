@@ -412,3 +412,140 @@ func TestDeleteAccount(t *testing.T) {
 		runServerTest(t, tc, req)
 	}
 }
+
+func TestCloseAccount(t *testing.T) {
+	user, _ := createRandomUser(t)
+	account := createRandomAccount(user.Username)
+	sweepTo := createRandomAccount(user.Username)
+	sweepTo.ID = account.ID + 1
+	account.Currency, sweepTo.Currency = util.EGP, util.EGP
+
+	closed := account
+	closed.Status = db.AccountStatusClosed
+	closed.Balance = 0
+
+	testCases := []struct {
+		name string
+		body closeAccountReq
+		testCaseBase
+	}{
+		{
+			name: "OK-Sweep",
+			body: closeAccountReq{SweepToAccountID: sweepTo.ID, Reason: "moving banks"},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(2).Return(account, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(sweepTo.ID)).Times(1).Return(sweepTo, nil)
+					store.EXPECT().
+						CloseAccountTx(gomock.Any(), gomock.Eq(db.CloseAccountTxParam{
+							AccountID:        account.ID,
+							SweepToAccountID: sweepTo.ID,
+							ChangedBy:        user.Username,
+							Reason:           "moving banks",
+						})).
+						Times(1).
+						Return(db.AccountStatusTxResult{
+							Account: closed,
+							Sweep:   &db.TransferTxResult{Transfer: db.Transfer{ID: 1, Amount: account.Balance}},
+						}, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+
+					var res struct {
+						Data closeAccountResponse `json:"data"`
+					}
+					require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+					require.Equal(t, db.AccountStatusClosed, res.Data.Account.Status)
+					require.NotNil(t, res.Data.Sweep)
+					require.Equal(t, account.Balance, res.Data.Sweep.Amount)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name: "BalanceNotZero",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+					store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Any()).Times(1).
+						Return(db.AccountStatusTxResult{}, db.ErrAccountBalanceNotZero)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name: "SweepLimitExceeded",
+			body: closeAccountReq{SweepToAccountID: sweepTo.ID},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(2).Return(account, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(sweepTo.ID)).Times(1).Return(sweepTo, nil)
+					store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Any()).Times(1).
+						Return(db.AccountStatusTxResult{}, &db.TransferLimitError{Limit: db.TransferLimitMaxAmount, Max: 10, Remaining: 10})
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name: "SweepDenied",
+			body: closeAccountReq{SweepToAccountID: sweepTo.ID},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(2).Return(account, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(sweepTo.ID)).Times(1).Return(sweepTo, nil)
+					store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Any()).Times(1).
+						Return(db.AccountStatusTxResult{}, &db.RiskDeniedError{Rule: "new_payee", Reason: "first transfer to the payee"})
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusForbidden, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name: "NotAccountOwner",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+					store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, util.RandomOwner())
+				},
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/api/accounts/%d/close", account.ID)
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
@@ -0,0 +1,514 @@
+package handlers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/escalopa/gobank/api/handlers/response"
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/token"
+	"github.com/escalopa/gobank/util"
+	"github.com/gin-gonic/gin"
+)
+
+type adminAccountResponse struct {
+	accountResponse
+	Owner     string `json:"owner"`
+	IsDeleted bool   `json:"is_deleted"`
+}
+
+type listUsersResponse struct {
+	Users []userResponse `json:"users"`
+	// NextCursor is empty on the last page
+	NextCursor string `json:"next_cursor"`
+}
+
+type adminUserUri struct {
+	Username string `uri:"username" binding:"required,alphanum"`
+}
+
+// ListUsers godoc
+//
+//	@Summary		lists all users
+//	@Description	gets a page of all the users ordered by username, deleted ones included, pass next_cursor as cursor to get the next page
+//	@Tags			admin
+//	@Produce		json
+//	@Param			page_size		query		int32	false	"Page Size, defaults to and is capped by PAGE_SIZE_MAX"
+//	@Param			cursor			query		string	false	"next_cursor of the previous page"
+//	@Success		200				{object}	response.JSON{data=listUsersResponse}
+//	@Failure		400,401,403,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/admin/users [get]
+func (s *GinServer) listUsers(ctx *gin.Context) {
+	cursor, pageSize, err := s.parsePage(ctx)
+	if err != nil {
+		return
+	}
+
+	users, err := s.db.ListUsers(ctx, db.ListUsersParams{
+		AfterUsername: cursor.Key,
+		PageSize:      pageSize + 1,
+	})
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
+	}
+
+	res := listUsersResponse{Users: []userResponse{}}
+	users, res.NextCursor = util.Paginate(users, pageSize, func(user db.User) util.Cursor {
+		return util.Cursor{Key: user.Username}
+	})
+
+	for i := range users {
+		res.Users = append(res.Users, mapUserToResponse(&users[i]))
+	}
+
+	ctx.JSON(http.StatusOK, response.Success(res))
+}
+
+// AdminGetAccount godoc
+//
+//	@Summary		gets any account by id
+//	@Description	gets any account by id along with its owner, deleted ones included
+//	@Tags			admin
+//	@Produce		json
+//	@Param			id					path		int64	true	"Account ID"
+//	@Success		200					{object}	response.JSON{data=adminAccountResponse}
+//	@Failure		400,401,403,404,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/admin/accounts/{id} [get]
+func (s *GinServer) adminGetAccount(ctx *gin.Context) {
+	var req getAccountReq
+	if err := parseUri(ctx, &req); err != nil {
+		return
+	}
+
+	account, isValid := s.isValidAccount(ctx, req.ID)
+	if !isValid {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Success(mapAccountToAdminResponse(account)))
+}
+
+// BlockUserSessions godoc
+//
+//	@Summary		blocks all the sessions of a user
+//	@Description	blocks all the sessions of a user so that none of their refresh tokens can be renewed and their access tokens are rejected right away
+//	@Tags			admin
+//	@Produce		json
+//	@Param			username			path		string	true	"Username"
+//	@Success		200					{object}	response.JSON{}
+//	@Failure		400,401,403,404,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/admin/users/{username}/sessions/block [post]
+func (s *GinServer) blockUserSessions(ctx *gin.Context) {
+	var uri adminUserUri
+	if err := parseUri(ctx, &uri); err != nil {
+		return
+	}
+
+	user, found := s.getUserIfExists(ctx, uri.Username)
+	if !found {
+		return
+	}
+
+	if err := s.db.BlockUserSessions(ctx, user.Username); err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Success(user.Username))
+}
+
+type accountStatusReq struct {
+	Reason string `json:"reason" binding:"required,max=255"`
+}
+
+type accountStatusChangeResponse struct {
+	FromStatus db.AccountStatus `json:"from_status"`
+	ToStatus   db.AccountStatus `json:"to_status"`
+	ChangedBy  string           `json:"changed_by"`
+	Reason     string           `json:"reason"`
+	// SweepTransferID is the transfer that emptied the account when it was closed
+	SweepTransferID int64     `json:"sweep_transfer_id,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// FreezeAccount godoc
+//
+//	@Summary		freezes an account
+//	@Description	freezes an account, it can still be credited but every debit is refused until it is unfrozen
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id					path		int64				true	"Account ID"
+//	@Param			body				body		accountStatusReq	true	"Why the account is frozen"
+//	@Success		200					{object}	response.JSON{data=adminAccountResponse}
+//	@Failure		400,401,403,404,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/admin/accounts/{id}/freeze [post]
+func (s *GinServer) freezeAccount(ctx *gin.Context) {
+	s.changeAccountStatus(ctx, db.AccountStatusFrozen)
+}
+
+// UnfreezeAccount godoc
+//
+//	@Summary		unfreezes an account
+//	@Description	moves a frozen account back to active
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id					path		int64				true	"Account ID"
+//	@Param			body				body		accountStatusReq	true	"Why the account is unfrozen"
+//	@Success		200					{object}	response.JSON{data=adminAccountResponse}
+//	@Failure		400,401,403,404,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/admin/accounts/{id}/unfreeze [post]
+func (s *GinServer) unfreezeAccount(ctx *gin.Context) {
+	s.changeAccountStatus(ctx, db.AccountStatusActive)
+}
+
+func (s *GinServer) changeAccountStatus(ctx *gin.Context, status db.AccountStatus) {
+	var uri getAccountReq
+	if err := parseUri(ctx, &uri); err != nil {
+		return
+	}
+
+	var req accountStatusReq
+	if err := parseBody(ctx, &req); err != nil {
+		return
+	}
+
+	account, isValid := s.isValidAccount(ctx, uri.ID)
+	if !isValid {
+		return
+	}
+
+	payload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	result, err := s.db.ChangeAccountStatusTx(ctx, db.ChangeAccountStatusTxParam{
+		AccountID: account.ID,
+		Status:    status,
+		ChangedBy: payload.Username,
+		Reason:    req.Reason,
+	})
+
+	if err != nil {
+		accountStatusTxError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Success(mapAccountToAdminResponse(result.Account)))
+}
+
+// ListAccountStatusChanges godoc
+//
+//	@Summary		lists the status changes of an account
+//	@Description	lists every status change of an account from the oldest, with who made it and why
+//	@Tags			admin
+//	@Produce		json
+//	@Param			id					path		int64	true	"Account ID"
+//	@Success		200					{object}	response.JSON{data=[]accountStatusChangeResponse}
+//	@Failure		400,401,403,404,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/admin/accounts/{id}/status-changes [get]
+func (s *GinServer) listAccountStatusChanges(ctx *gin.Context) {
+	var uri getAccountReq
+	if err := parseUri(ctx, &uri); err != nil {
+		return
+	}
+
+	account, isValid := s.isValidAccount(ctx, uri.ID)
+	if !isValid {
+		return
+	}
+
+	changes, err := s.db.ListAccountStatusChanges(ctx, account.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
+	}
+
+	res := []accountStatusChangeResponse{}
+	for _, change := range changes {
+		res = append(res, mapAccountStatusChangeToResponse(change))
+	}
+
+	ctx.JSON(http.StatusOK, response.Success(res))
+}
+
+// ReverseTransfer godoc
+//
+//	@Summary		reverses a transfer
+//	@Description	gives the amount, or all that is left of the transfer when omitted, back to its sender with a reversal transfer, at any time after the transfer was made
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id							path		int64				true	"Transfer ID"
+//	@Param			body						body		reverseTransferReq	false	"Part of the transfer to reverse"
+//	@Success		200							{object}	response.JSON{data=reverseTransferResponse}
+//	@Failure		400,401,403,404,409,422,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/admin/transfers/{id}/reverse [post]
+func (s *GinServer) reverseTransfer(ctx *gin.Context) {
+	var uri transferUri
+	if err := parseUri(ctx, &uri); err != nil {
+		return
+	}
+
+	req, err := parseReverseTransferReq(ctx)
+	if err != nil {
+		return
+	}
+
+	s.reverseTransferTx(ctx, uri.ID, req.Amount)
+}
+
+type transferReviewResponse struct {
+	transferResponse
+	// RiskRule is the risk rule that put the transfer into review and RiskReason why
+	RiskRule   string     `json:"risk_rule"`
+	RiskReason string     `json:"risk_reason"`
+	ReviewedBy string     `json:"reviewed_by,omitempty"`
+	ReviewNote string     `json:"review_note,omitempty"`
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+}
+
+type listPendingTransfersResponse struct {
+	Transfers []transferReviewResponse `json:"transfers"`
+	// NextCursor is empty on the last page
+	NextCursor string `json:"next_cursor"`
+}
+
+type reviewTransferReq struct {
+	// Note is why the transfer is approved or rejected
+	Note string `json:"note" binding:"max=255"`
+}
+
+// ListPendingTransfers godoc
+//
+//	@Summary		lists the transfers pending review
+//	@Description	gets a page of the transfers put into review by a risk rule from the oldest, with the rule and why, pass next_cursor as cursor to get the next page
+//	@Tags			admin
+//	@Produce		json
+//	@Param			page_size		query		int32	false	"Page Size, defaults to and is capped by PAGE_SIZE_MAX"
+//	@Param			cursor			query		string	false	"next_cursor of the previous page"
+//	@Success		200				{object}	response.JSON{data=listPendingTransfersResponse}
+//	@Failure		400,401,403,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/admin/transfers/pending [get]
+func (s *GinServer) listPendingTransfers(ctx *gin.Context) {
+	cursor, pageSize, err := s.parsePage(ctx)
+	if err != nil {
+		return
+	}
+
+	transfers, err := s.db.ListPendingTransfers(ctx, db.ListPendingTransfersParams{
+		AfterID:  cursor.ID,
+		PageSize: pageSize + 1,
+	})
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
+	}
+
+	res := listPendingTransfersResponse{Transfers: []transferReviewResponse{}}
+	transfers, res.NextCursor = util.Paginate(transfers, pageSize, func(transfer db.Transfer) util.Cursor {
+		return util.Cursor{ID: transfer.ID}
+	})
+
+	for _, transfer := range transfers {
+		res.Transfers = append(res.Transfers, mapTransferReviewToResponse(*mapTransferToResponse(transfer), transfer))
+	}
+
+	ctx.JSON(http.StatusOK, response.Success(res))
+}
+
+// ApproveTransfer godoc
+//
+//	@Summary		approves a transfer pending review
+//	@Description	moves the money of a transfer put into review by a risk rule, at the exchange rate it was made with. Its accounts must still allow it
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id							path		int64				true	"Transfer ID"
+//	@Param			body						body		reviewTransferReq	false	"Why the transfer is approved"
+//	@Success		200							{object}	response.JSON{data=transferReviewResponse}
+//	@Failure		400,401,403,404,409,422,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/admin/transfers/{id}/approve [post]
+func (s *GinServer) approveTransfer(ctx *gin.Context) {
+	arg, err := parseReviewTransferReq(ctx)
+	if err != nil {
+		return
+	}
+
+	result, err := s.db.ApproveTransferTx(ctx, arg)
+	if err != nil {
+		reviewTransferTxError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Success(mapTransferReviewToResponse(fromTransferTxToTransferResponse(result), result.Transfer)))
+}
+
+// RejectTransfer godoc
+//
+//	@Summary		rejects a transfer pending review
+//	@Description	closes a transfer put into review by a risk rule, its money is never moved
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id						path		int64				true	"Transfer ID"
+//	@Param			body					body		reviewTransferReq	false	"Why the transfer is rejected"
+//	@Success		200						{object}	response.JSON{data=transferReviewResponse}
+//	@Failure		400,401,403,404,409,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/admin/transfers/{id}/reject [post]
+func (s *GinServer) rejectTransfer(ctx *gin.Context) {
+	arg, err := parseReviewTransferReq(ctx)
+	if err != nil {
+		return
+	}
+
+	transfer, err := s.db.RejectTransferTx(ctx, arg)
+	if err != nil {
+		reviewTransferTxError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Success(mapTransferReviewToResponse(*mapTransferToResponse(transfer), transfer)))
+}
+
+// parseReviewTransferReq parses the transfer id and the body of a review, which can be left out, reviewed by the admin
+func parseReviewTransferReq(ctx *gin.Context) (arg db.ReviewTransferTxParam, err error) {
+	var uri transferUri
+	if err = parseUri(ctx, &uri); err != nil {
+		return
+	}
+
+	var req reviewTransferReq
+	if ctx.Request.ContentLength != 0 {
+		if err = parseBody(ctx, &req); err != nil {
+			return
+		}
+	}
+
+	payload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	arg = db.ReviewTransferTxParam{
+		TransferID: uri.ID,
+		ReviewedBy: payload.Username,
+		Note:       req.Note,
+	}
+	return
+}
+
+func reviewTransferTxError(ctx *gin.Context, err error) {
+	switch err {
+	case sql.ErrNoRows:
+		ctx.JSON(http.StatusNotFound, response.Err(err))
+		return
+	case db.ErrTransferNotPending:
+		ctx.JSON(http.StatusConflict, response.Err(err))
+		return
+	case db.ErrInsufficientFunds:
+		ctx.JSON(http.StatusUnprocessableEntity, response.Err(err))
+		return
+	case db.ErrAccountFrozen, db.ErrAccountClosed:
+		ctx.JSON(http.StatusBadRequest, response.Err(err))
+		return
+	}
+	ctx.JSON(http.StatusInternalServerError, response.Err(err))
+}
+
+type listAuditEventsQuery struct {
+	Actor      string    `form:"actor"`
+	Action     string    `form:"action"`
+	TargetType string    `form:"target_type"`
+	TargetID   string    `form:"target_id"`
+	From       time.Time `form:"from"`
+	To         time.Time `form:"to"`
+}
+
+type auditEventResponse struct {
+	ID            int64  `json:"id"`
+	ActorUsername string `json:"actor_username"`
+	// SessionID is empty when the change wasn't made with a session
+	SessionID  string          `json:"session_id,omitempty"`
+	ClientIP   string          `json:"client_ip"`
+	UserAgent  string          `json:"user_agent"`
+	Action     string          `json:"action"`
+	TargetType string          `json:"target_type"`
+	TargetID   string          `json:"target_id"`
+	Before     json.RawMessage `json:"before" swaggertype:"object"`
+	After      json.RawMessage `json:"after" swaggertype:"object"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+type listAuditEventsResponse struct {
+	Events []auditEventResponse `json:"events"`
+	// NextCursor is empty on the last page
+	NextCursor string `json:"next_cursor"`
+}
+
+// ListAuditEvents godoc
+//
+//	@Summary		lists the audit events
+//	@Description	gets a page of the audit events matching the filters from the oldest, pass next_cursor as cursor to get the next page
+//	@Tags			admin
+//	@Produce		json
+//	@Param			actor			query		string	false	"Username of the user who made the change"
+//	@Param			action			query		string	false	"Action, e.g. account.create or transfer.create"
+//	@Param			target_type		query		string	false	"Type of the changed entity, e.g. account or user"
+//	@Param			target_id		query		string	false	"ID of the changed entity, the username for users"
+//	@Param			from			query		string	false	"Created at or after, RFC3339"
+//	@Param			to				query		string	false	"Created before, RFC3339"
+//	@Param			page_size		query		int32	false	"Page Size, defaults to and is capped by PAGE_SIZE_MAX"
+//	@Param			cursor			query		string	false	"next_cursor of the previous page"
+//	@Success		200				{object}	response.JSON{data=listAuditEventsResponse}
+//	@Failure		400,401,403,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/admin/audit-events [get]
+func (s *GinServer) listAuditEvents(ctx *gin.Context) {
+	var query listAuditEventsQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.Err(err))
+		return
+	}
+
+	cursor, pageSize, err := s.parsePage(ctx)
+	if err != nil {
+		return
+	}
+
+	events, err := s.db.ListAuditEvents(ctx, db.ListAuditEventsParams{
+		AfterID:       cursor.ID,
+		ActorUsername: sql.NullString{String: query.Actor, Valid: query.Actor != ""},
+		Action:        sql.NullString{String: query.Action, Valid: query.Action != ""},
+		TargetType:    sql.NullString{String: query.TargetType, Valid: query.TargetType != ""},
+		TargetID:      sql.NullString{String: query.TargetID, Valid: query.TargetID != ""},
+		FromTime:      sql.NullTime{Time: query.From, Valid: !query.From.IsZero()},
+		ToTime:        sql.NullTime{Time: query.To, Valid: !query.To.IsZero()},
+		PageSize:      pageSize + 1,
+	})
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
+	}
+
+	res := listAuditEventsResponse{Events: []auditEventResponse{}}
+	events, res.NextCursor = util.Paginate(events, pageSize, func(event db.AuditEvent) util.Cursor {
+		return util.Cursor{ID: event.ID}
+	})
+
+	for _, event := range events {
+		res.Events = append(res.Events, mapAuditEventToResponse(event))
+	}
+
+	ctx.JSON(http.StatusOK, response.Success(res))
+}
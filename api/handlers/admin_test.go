@@ -0,0 +1,807 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	mockdb "github.com/escalopa/gobank/db/mock"
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/token"
+	"github.com/escalopa/gobank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListUsers(t *testing.T) {
+	admin, _ := createRandomUser(t)
+
+	n := 3
+	users := make([]db.User, n)
+	for i := range users {
+		users[i], _ = createRandomUser(t)
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].Username < users[j].Username })
+
+	testCases := []struct {
+		name   string
+		cursor string
+		testCaseBase
+	}{
+		{
+			name: "OK",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleAdmin)
+					store.EXPECT().
+						ListUsers(gomock.Any(), gomock.Eq(db.ListUsersParams{PageSize: 3})).
+						Times(1).
+						Return(users, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+
+					var res struct {
+						Data listUsersResponse `json:"data"`
+					}
+					require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+					require.Len(t, res.Data.Users, 2)
+					require.Equal(t, users[0].Username, res.Data.Users[0].Username)
+
+					cursor, err := util.DecodeCursor(res.Data.NextCursor)
+					require.NoError(t, err)
+					require.Equal(t, users[1].Username, cursor.Key)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username, db.UserRoleAdmin)
+				},
+			},
+		},
+		{
+			name:   "NextPage",
+			cursor: util.Cursor{Key: users[1].Username}.Encode(),
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleAdmin)
+					store.EXPECT().
+						ListUsers(gomock.Any(), gomock.Eq(db.ListUsersParams{AfterUsername: users[1].Username, PageSize: 3})).
+						Times(1).
+						Return(users[2:], nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+
+					var res struct {
+						Data listUsersResponse `json:"data"`
+					}
+					require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+					require.Len(t, res.Data.Users, 1)
+					require.Empty(t, res.Data.NextCursor)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username, db.UserRoleAdmin)
+				},
+			},
+		},
+		{
+			name: "Forbidden",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleCustomer)
+					store.EXPECT().ListUsers(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusForbidden, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username)
+				},
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			url := fmt.Sprintf("/api/admin/users?page_size=2&cursor=%s", tc.cursor)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
+
+func TestAdminGetAccount(t *testing.T) {
+	admin, _ := createRandomUser(t)
+	account := createRandomAccount(util.RandomOwner())
+	account.IsDeleted = true
+
+	testCases := []struct {
+		name string
+		testCaseBase
+	}{
+		{
+			name: "OK",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleAdmin)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+
+					var res struct {
+						Data adminAccountResponse `json:"data"`
+					}
+					require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+					require.Equal(t, account.ID, res.Data.ID)
+					require.Equal(t, account.Owner, res.Data.Owner)
+					require.True(t, res.Data.IsDeleted)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username, db.UserRoleAdmin)
+				},
+			},
+		},
+		{
+			name: "NotFound",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleAdmin)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(db.Account{}, sql.ErrNoRows)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusNotFound, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username, db.UserRoleAdmin)
+				},
+			},
+		},
+		{
+			name: "Forbidden",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, account.Owner, db.UserRoleCustomer)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusForbidden, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, account.Owner)
+				},
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			url := fmt.Sprintf("/api/admin/accounts/%d", account.ID)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
+
+func TestBlockUserSessions(t *testing.T) {
+	admin, _ := createRandomUser(t)
+	user, _ := createRandomUser(t)
+
+	testCases := []struct {
+		name string
+		testCaseBase
+	}{
+		{
+			name: "OK",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleAdmin)
+					store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+					store.EXPECT().BlockUserSessions(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username, db.UserRoleAdmin)
+				},
+			},
+		},
+		{
+			name: "UserNotFound",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleAdmin)
+					store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(db.User{}, sql.ErrNoRows)
+					store.EXPECT().BlockUserSessions(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusNotFound, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username, db.UserRoleAdmin)
+				},
+			},
+		},
+		{
+			name: "Forbidden",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, user.Username, db.UserRoleCustomer)
+					store.EXPECT().BlockUserSessions(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusForbidden, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			url := fmt.Sprintf("/api/admin/users/%s/sessions/block", user.Username)
+			req, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
+
+func TestFreezeAccount(t *testing.T) {
+	admin, _ := createRandomUser(t)
+	account := createRandomAccount(util.RandomOwner())
+
+	frozen := account
+	frozen.Status = db.AccountStatusFrozen
+
+	testCases := []struct {
+		name string
+		body accountStatusReq
+		testCaseBase
+	}{
+		{
+			name: "OK",
+			body: accountStatusReq{Reason: "suspicious activity"},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleAdmin)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+					store.EXPECT().
+						ChangeAccountStatusTx(gomock.Any(), gomock.Eq(db.ChangeAccountStatusTxParam{
+							AccountID: account.ID,
+							Status:    db.AccountStatusFrozen,
+							ChangedBy: admin.Username,
+							Reason:    "suspicious activity",
+						})).
+						Times(1).
+						Return(db.AccountStatusTxResult{Account: frozen}, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+
+					var res struct {
+						Data adminAccountResponse `json:"data"`
+					}
+					require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+					require.Equal(t, db.AccountStatusFrozen, res.Data.Status)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username, db.UserRoleAdmin)
+				},
+			},
+		},
+		{
+			name: "InvalidTransition",
+			body: accountStatusReq{Reason: "suspicious activity"},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleAdmin)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(frozen, nil)
+					store.EXPECT().ChangeAccountStatusTx(gomock.Any(), gomock.Any()).Times(1).
+						Return(db.AccountStatusTxResult{}, db.ErrInvalidStatusTransition)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username, db.UserRoleAdmin)
+				},
+			},
+		},
+		{
+			name: "MissingReason",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleAdmin)
+					store.EXPECT().ChangeAccountStatusTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username, db.UserRoleAdmin)
+				},
+			},
+		},
+		{
+			name: "Forbidden",
+			body: accountStatusReq{Reason: "suspicious activity"},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, account.Owner, db.UserRoleCustomer)
+					store.EXPECT().ChangeAccountStatusTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusForbidden, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, account.Owner)
+				},
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/api/admin/accounts/%d/freeze", account.ID)
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
+
+func TestReverseTransfer(t *testing.T) {
+	admin, _ := createRandomUser(t)
+	transferID := util.RandomInteger(1, 1000)
+
+	testCases := []struct {
+		name string
+		testCaseBase
+	}{
+		{
+			name: "OK",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleAdmin)
+					// Admins can reverse transfers of any account, at any time
+					store.EXPECT().GetTransfer(gomock.Any(), gomock.Any()).Times(0)
+					store.EXPECT().
+						ReverseTransferTx(gomock.Any(), gomock.Eq(db.ReverseTransferTxParam{TransferID: transferID})).
+						Times(1).
+						Return(db.ReverseTransferTxResult{Original: db.Transfer{ID: transferID, Amount: 10, ReversedAmount: 10}}, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username, db.UserRoleAdmin)
+				},
+			},
+		},
+		{
+			name: "NotFound",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleAdmin)
+					store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Any()).Times(1).
+						Return(db.ReverseTransferTxResult{}, sql.ErrNoRows)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusNotFound, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username, db.UserRoleAdmin)
+				},
+			},
+		},
+		{
+			name: "IsReversal",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleAdmin)
+					store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Any()).Times(1).
+						Return(db.ReverseTransferTxResult{}, db.ErrTransferIsReversal)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username, db.UserRoleAdmin)
+				},
+			},
+		},
+		{
+			name: "Forbidden",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleCustomer)
+					store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusForbidden, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username)
+				},
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			url := fmt.Sprintf("/api/admin/transfers/%d/reverse", transferID)
+			req, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
+
+func TestListAuditEvents(t *testing.T) {
+	admin, _ := createRandomUser(t)
+
+	events := make([]db.AuditEvent, 3)
+	for i := range events {
+		events[i] = db.AuditEvent{
+			ID:            int64(i + 1),
+			ActorUsername: util.RandomOwner(),
+			Action:        db.AuditActionAccountCreate,
+			TargetType:    db.AuditTargetAccount,
+			TargetID:      fmt.Sprint(util.RandomInteger(1, 1000)),
+			Before:        json.RawMessage(`null`),
+			After:         json.RawMessage(`{"balance":0}`),
+		}
+	}
+
+	testCases := []struct {
+		name  string
+		query string
+		testCaseBase
+	}{
+		{
+			name:  "OK",
+			query: "page_size=2&actor=" + events[0].ActorUsername + "&target_type=account",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleAdmin)
+					arg := db.ListAuditEventsParams{
+						ActorUsername: sql.NullString{String: events[0].ActorUsername, Valid: true},
+						TargetType:    sql.NullString{String: db.AuditTargetAccount, Valid: true},
+						PageSize:      3,
+					}
+					store.EXPECT().
+						ListAuditEvents(gomock.Any(), gomock.Eq(arg)).
+						Times(1).
+						DoAndReturn(func(ctx context.Context, _ db.ListAuditEventsParams) ([]db.AuditEvent, error) {
+							// The actor of the request is handed to the store through the context
+							require.Equal(t, admin.Username, db.ActorFromContext(ctx).Username)
+							return events, nil
+						})
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+
+					var res struct {
+						Data listAuditEventsResponse `json:"data"`
+					}
+					require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+					require.Len(t, res.Data.Events, 2)
+					require.Equal(t, events[0].ActorUsername, res.Data.Events[0].ActorUsername)
+					require.JSONEq(t, string(events[0].After), string(res.Data.Events[0].After))
+					require.Empty(t, res.Data.Events[0].SessionID)
+
+					cursor, err := util.DecodeCursor(res.Data.NextCursor)
+					require.NoError(t, err)
+					require.Equal(t, events[1].ID, cursor.ID)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username, db.UserRoleAdmin)
+				},
+			},
+		},
+		{
+			name:  "InvalidFrom",
+			query: "from=yesterday",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleAdmin)
+					store.EXPECT().ListAuditEvents(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username, db.UserRoleAdmin)
+				},
+			},
+		},
+		{
+			name: "Forbidden",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleCustomer)
+					store.EXPECT().ListAuditEvents(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusForbidden, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username)
+				},
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, "/api/admin/audit-events?"+tc.query, nil)
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
+
+func TestListPendingTransfers(t *testing.T) {
+	admin, _ := createRandomUser(t)
+
+	transfers := make([]db.Transfer, 3)
+	for i := range transfers {
+		transfers[i] = db.Transfer{
+			ID:         int64(i + 1),
+			Amount:     util.RandomMoney(),
+			Status:     db.TransferStatusPendingReview,
+			RiskRule:   sql.NullString{String: "new_counterparty", Valid: true},
+			RiskReason: sql.NullString{String: "first transfer", Valid: true},
+		}
+	}
+
+	testCases := []struct {
+		name string
+		testCaseBase
+	}{
+		{
+			name: "OK",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleAdmin)
+					store.EXPECT().
+						ListPendingTransfers(gomock.Any(), gomock.Eq(db.ListPendingTransfersParams{PageSize: 3})).
+						Times(1).
+						Return(transfers, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+
+					var res struct {
+						Data listPendingTransfersResponse `json:"data"`
+					}
+					require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+					require.Len(t, res.Data.Transfers, 2)
+					require.Equal(t, transfers[0].ID, res.Data.Transfers[0].ID)
+					require.Equal(t, db.TransferStatusPendingReview, res.Data.Transfers[0].Status)
+					require.Equal(t, "new_counterparty", res.Data.Transfers[0].RiskRule)
+					require.Equal(t, "first transfer", res.Data.Transfers[0].RiskReason)
+
+					cursor, err := util.DecodeCursor(res.Data.NextCursor)
+					require.NoError(t, err)
+					require.Equal(t, transfers[1].ID, cursor.ID)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username, db.UserRoleAdmin)
+				},
+			},
+		},
+		{
+			name: "Forbidden",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleCustomer)
+					store.EXPECT().ListPendingTransfers(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusForbidden, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username)
+				},
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, "/api/admin/transfers/pending?page_size=2", nil)
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
+
+func TestReviewTransfer(t *testing.T) {
+	admin, _ := createRandomUser(t)
+	transferID := util.RandomInteger(1, 1000)
+
+	testCases := []struct {
+		name   string
+		action string
+		body   *reviewTransferReq
+		testCaseBase
+	}{
+		{
+			name:   "Approve",
+			action: "approve",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleAdmin)
+					store.EXPECT().
+						ApproveTransferTx(gomock.Any(), gomock.Eq(db.ReviewTransferTxParam{TransferID: transferID, ReviewedBy: admin.Username})).
+						Times(1).
+						Return(db.TransferTxResult{Transfer: db.Transfer{
+							ID:         transferID,
+							Status:     db.TransferStatusCompleted,
+							ReviewedBy: sql.NullString{String: admin.Username, Valid: true},
+						}}, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+
+					var res struct {
+						Data transferReviewResponse `json:"data"`
+					}
+					require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+					require.Equal(t, db.TransferStatusCompleted, res.Data.Status)
+					require.Equal(t, admin.Username, res.Data.ReviewedBy)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username, db.UserRoleAdmin)
+				},
+			},
+		},
+		{
+			name:   "ApproveInsufficientFunds",
+			action: "approve",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleAdmin)
+					store.EXPECT().ApproveTransferTx(gomock.Any(), gomock.Any()).Times(1).
+						Return(db.TransferTxResult{}, db.ErrInsufficientFunds)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username, db.UserRoleAdmin)
+				},
+			},
+		},
+		{
+			name:   "Reject",
+			action: "reject",
+			body:   &reviewTransferReq{Note: "the user didn't make it"},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleAdmin)
+					store.EXPECT().
+						RejectTransferTx(gomock.Any(), gomock.Eq(db.ReviewTransferTxParam{
+							TransferID: transferID,
+							ReviewedBy: admin.Username,
+							Note:       "the user didn't make it",
+						})).
+						Times(1).
+						Return(db.Transfer{ID: transferID, Status: db.TransferStatusRejected}, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username, db.UserRoleAdmin)
+				},
+			},
+		},
+		{
+			name:   "RejectNotPending",
+			action: "reject",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleAdmin)
+					store.EXPECT().RejectTransferTx(gomock.Any(), gomock.Any()).Times(1).
+						Return(db.Transfer{}, db.ErrTransferNotPending)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusConflict, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username, db.UserRoleAdmin)
+				},
+			},
+		},
+		{
+			name:   "NotFound",
+			action: "approve",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleAdmin)
+					store.EXPECT().ApproveTransferTx(gomock.Any(), gomock.Any()).Times(1).
+						Return(db.TransferTxResult{}, sql.ErrNoRows)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusNotFound, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username, db.UserRoleAdmin)
+				},
+			},
+		},
+		{
+			name:   "Forbidden",
+			action: "approve",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleCustomer)
+					store.EXPECT().ApproveTransferTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusForbidden, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username)
+				},
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			// The body can be left out
+			var data []byte
+			if tc.body != nil {
+				var err error
+				data, err = json.Marshal(tc.body)
+				require.NoError(t, err)
+			}
+
+			url := fmt.Sprintf("/api/admin/transfers/%d/%s", transferID, tc.action)
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
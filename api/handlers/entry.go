@@ -0,0 +1,209 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/escalopa/gobank/api/handlers/response"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/util"
+	"github.com/gin-gonic/gin"
+)
+
+type entryResponse struct {
+	ID           int64        `json:"id"`
+	AccountID    int64        `json:"account_id"`
+	Amount       int64        `json:"amount"`
+	Type         db.EntryType `json:"type"`
+	TransferID   int64        `json:"transfer_id,omitempty"`
+	ExternalRef  string       `json:"external_ref,omitempty"`
+	BalanceAfter int64        `json:"balance_after"`
+	Description  string       `json:"description"`
+	CreatedAt    time.Time    `json:"created_at"`
+}
+
+type entryTxResponse struct {
+	Account *accountResponse `json:"account"`
+	Entry   entryResponse    `json:"entry"`
+}
+
+type entryTxUri struct {
+	AccountID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type entryTxReq struct {
+	Amount      int64  `json:"amount" binding:"required,gte=1"`
+	ExternalRef string `json:"external_ref" binding:"max=255"`
+}
+
+// Deposit godoc
+//
+//	@Summary		deposits money into an account
+//	@Description	credits the account with the amount and records a deposit entry with the external reference, only tellers and admins can make deposits
+//	@Tags			accounts
+//	@Accept			json
+//	@Produce		json
+//	@Param			id					path		int64		true	"Account ID"
+//	@Param			body				body		entryTxReq	true	"Deposit to make"
+//	@Success		200					{object}	response.JSON{data=entryTxResponse}
+//	@Failure		400,401,403,404,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/accounts/{id}/deposits [post]
+func (s *GinServer) deposit(ctx *gin.Context) {
+	account, req, isValid := s.validateEntryTx(ctx)
+	if !isValid {
+		return
+	}
+
+	result, err := s.db.DepositTx(ctx, db.DepositTxParam{
+		AccountID:   account.ID,
+		Amount:      req.Amount,
+		ExternalRef: req.ExternalRef,
+	})
+
+	if err != nil {
+		if err == db.ErrAccountClosed {
+			ctx.JSON(http.StatusBadRequest, response.Err(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Success(mapEntryTxToResponse(result)))
+}
+
+// Withdraw godoc
+//
+//	@Summary		withdraws money from an account
+//	@Description	debits the account with the amount and records a withdrawal entry with the external reference, only tellers and admins can make withdrawals
+//	@Tags			accounts
+//	@Accept			json
+//	@Produce		json
+//	@Param			id						path		int64		true	"Account ID"
+//	@Param			body					body		entryTxReq	true	"Withdrawal to make"
+//	@Success		200						{object}	response.JSON{data=entryTxResponse}
+//	@Failure		400,401,403,404,422,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/accounts/{id}/withdrawals [post]
+func (s *GinServer) withdraw(ctx *gin.Context) {
+	account, req, isValid := s.validateEntryTx(ctx)
+	if !isValid {
+		return
+	}
+
+	result, err := s.db.WithdrawTx(ctx, db.WithdrawTxParam{
+		AccountID:   account.ID,
+		Amount:      req.Amount,
+		ExternalRef: req.ExternalRef,
+	})
+
+	if err != nil {
+		switch err {
+		case db.ErrInsufficientFunds:
+			ctx.JSON(http.StatusUnprocessableEntity, response.Err(err))
+			return
+		case db.ErrAccountFrozen, db.ErrAccountClosed:
+			ctx.JSON(http.StatusBadRequest, response.Err(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Success(mapEntryTxToResponse(result)))
+}
+
+// validateEntryTx parses a deposit or withdrawal and makes sure the account can be used for it
+func (s *GinServer) validateEntryTx(ctx *gin.Context) (account db.Account, req entryTxReq, isValid bool) {
+	var uri entryTxUri
+	if err := parseUri(ctx, &uri); err != nil {
+		return
+	}
+
+	if err := parseBody(ctx, &req); err != nil {
+		return
+	}
+
+	account, isValid = s.isValidAccount(ctx, uri.AccountID)
+	if !isValid {
+		return
+	}
+
+	if account.IsDeleted {
+		isValid = false
+		ctx.JSON(http.StatusBadRequest, response.Err(ErrAccountDeleted(account.ID)))
+		return
+	}
+
+	return
+}
+
+type listEntriesUri struct {
+	AccountID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type listEntriesResponse struct {
+	Entries []entryResponse `json:"entries"`
+	// NextCursor is empty on the last page
+	NextCursor string `json:"next_cursor"`
+}
+
+// ListEntries godoc
+//
+//	@Summary		gets all entries for an account
+//	@Description	gets a page of the ledger entries of an account ordered by creation, pass next_cursor as cursor to get the next page
+//	@Tags			accounts
+//	@Produce		json
+//	@Param			id				path		int64	true	"Account ID"
+//	@Param			page_size		query		int32	false	"Page Size, defaults to and is capped by PAGE_SIZE_MAX"
+//	@Param			cursor			query		string	false	"next_cursor of the previous page"
+//	@Success		200				{object}	response.JSON{data=listEntriesResponse}
+//	@Failure		400,401,404,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/accounts/{id}/entries [get]
+func (s *GinServer) listEntries(ctx *gin.Context) {
+	var uri listEntriesUri
+	if err := parseUri(ctx, &uri); err != nil {
+		return
+	}
+
+	cursor, pageSize, err := s.parsePage(ctx)
+	if err != nil {
+		return
+	}
+
+	account, isValid := s.isValidAccount(ctx, uri.AccountID)
+	if !isValid {
+		return
+	}
+
+	if !isUserAccountOwner(ctx, account) {
+		ctx.JSON(http.StatusUnauthorized, response.Err(ErrNotAccountOwner))
+		return
+	}
+
+	entries, err := s.db.ListEntries(ctx, db.ListEntriesParams{
+		AccountID:      account.ID,
+		AfterCreatedAt: cursor.CreatedAt,
+		AfterID:        cursor.ID,
+		PageSize:       pageSize + 1,
+	})
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
+	}
+
+	res := listEntriesResponse{Entries: []entryResponse{}}
+	entries, res.NextCursor = util.Paginate(entries, pageSize, func(entry db.Entry) util.Cursor {
+		return util.Cursor{CreatedAt: entry.CreatedAt, ID: entry.ID}
+	})
+
+	for _, entry := range entries {
+		res.Entries = append(res.Entries, mapEntryToResponse(entry))
+	}
+
+	ctx.JSON(http.StatusOK, response.Success(res))
+}
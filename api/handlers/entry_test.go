@@ -0,0 +1,339 @@
+package handlers
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockdb "github.com/escalopa/gobank/db/mock"
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/token"
+	"github.com/escalopa/gobank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeposit(t *testing.T) {
+	user, _ := createRandomUser(t)
+	teller, _ := createRandomUser(t)
+	admin, _ := createRandomUser(t)
+	account := createRandomAccount(user.Username)
+
+	arg := entryTxReq{
+		Amount:      util.RandomInteger(1, 1000),
+		ExternalRef: util.RandomString(12),
+	}
+
+	testCases := []struct {
+		name      string
+		accountID int64
+		entryArg  entryTxReq
+		testCaseBase
+	}{
+		{
+			name:      "OK",
+			accountID: account.ID,
+			entryArg:  arg,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, teller.Username, db.UserRoleTeller)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+					store.EXPECT().
+						DepositTx(gomock.Any(), gomock.Eq(db.DepositTxParam{
+							AccountID:   account.ID,
+							Amount:      arg.Amount,
+							ExternalRef: arg.ExternalRef,
+						})).
+						Times(1).
+						Return(db.EntryTxResult{Account: account, Entry: db.Entry{AccountID: account.ID, Amount: arg.Amount, Type: db.EntryTypeDeposit}}, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, teller.Username, db.UserRoleTeller)
+				},
+			},
+		},
+		{
+			name:      "BadRequest-Amount",
+			accountID: account.ID,
+			entryArg:  entryTxReq{Amount: -1},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, teller.Username, db.UserRoleTeller)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+					store.EXPECT().DepositTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, teller.Username, db.UserRoleTeller)
+				},
+			},
+		},
+		{
+			name:      "NotFound",
+			accountID: account.ID,
+			entryArg:  arg,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, teller.Username, db.UserRoleTeller)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(db.Account{}, sql.ErrNoRows)
+					store.EXPECT().DepositTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusNotFound, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, teller.Username, db.UserRoleTeller)
+				},
+			},
+		},
+		{
+			name:      "Admin",
+			accountID: account.ID,
+			entryArg:  arg,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, admin.Username, db.UserRoleAdmin)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+					store.EXPECT().DepositTx(gomock.Any(), gomock.Any()).Times(1).Return(db.EntryTxResult{Account: account}, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, admin.Username, db.UserRoleAdmin)
+				},
+			},
+		},
+		{
+			// Customers can't create money, not even in their own accounts
+			name:      "Forbidden",
+			accountID: account.ID,
+			entryArg:  arg,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, user.Username, db.UserRoleCustomer)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+					store.EXPECT().DepositTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusForbidden, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.entryArg)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/api/accounts/%d/deposits", tc.accountID)
+
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
+
+func TestWithdraw(t *testing.T) {
+	user, _ := createRandomUser(t)
+	teller, _ := createRandomUser(t)
+	account := createRandomAccount(user.Username)
+	deleted := createRandomAccount(user.Username)
+	deleted.IsDeleted = true
+
+	arg := entryTxReq{
+		Amount: util.RandomInteger(1, 1000),
+	}
+
+	testCases := []struct {
+		name      string
+		accountID int64
+		entryArg  entryTxReq
+		testCaseBase
+	}{
+		{
+			name:      "OK",
+			accountID: account.ID,
+			entryArg:  arg,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, teller.Username, db.UserRoleTeller)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+					store.EXPECT().
+						WithdrawTx(gomock.Any(), gomock.Eq(db.WithdrawTxParam{
+							AccountID: account.ID,
+							Amount:    arg.Amount,
+						})).
+						Times(1).
+						Return(db.EntryTxResult{Account: account, Entry: db.Entry{AccountID: account.ID, Amount: -arg.Amount, Type: db.EntryTypeWithdrawal}}, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, teller.Username, db.UserRoleTeller)
+				},
+			},
+		},
+		{
+			name:      "InsufficientFunds",
+			accountID: account.ID,
+			entryArg:  arg,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, teller.Username, db.UserRoleTeller)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+					store.EXPECT().
+						WithdrawTx(gomock.Any(), gomock.Any()).
+						Times(1).
+						Return(db.EntryTxResult{}, db.ErrInsufficientFunds)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, teller.Username, db.UserRoleTeller)
+				},
+			},
+		},
+		{
+			name:      "AccountDeleted",
+			accountID: deleted.ID,
+			entryArg:  arg,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, teller.Username, db.UserRoleTeller)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(deleted.ID)).Times(1).Return(deleted, nil)
+					store.EXPECT().WithdrawTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, teller.Username, db.UserRoleTeller)
+				},
+			},
+		},
+		{
+			name:      "Forbidden",
+			accountID: account.ID,
+			entryArg:  arg,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					expectRole(store, user.Username, db.UserRoleCustomer)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+					store.EXPECT().WithdrawTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusForbidden, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.entryArg)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/api/accounts/%d/withdrawals", tc.accountID)
+
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
+
+func TestListEntries(t *testing.T) {
+	user, _ := createRandomUser(t)
+	other, _ := createRandomUser(t)
+	account := createRandomAccount(user.Username)
+
+	entries := []db.Entry{
+		{ID: 1, AccountID: account.ID, Amount: 10, Type: db.EntryTypeDeposit, BalanceAfter: 10},
+		{ID: 2, AccountID: account.ID, Amount: -5, Type: db.EntryTypeWithdrawal, BalanceAfter: 5},
+	}
+
+	testCases := []struct {
+		name string
+		testCaseBase
+	}{
+		{
+			name: "OK",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+					store.EXPECT().
+						ListEntries(gomock.Any(), gomock.Eq(db.ListEntriesParams{AccountID: account.ID, PageSize: 3})).
+						Times(1).
+						Return(entries, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+
+					var res struct {
+						Data listEntriesResponse `json:"data"`
+					}
+					require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+					require.Len(t, res.Data.Entries, len(entries))
+					require.Empty(t, res.Data.NextCursor)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name: "Unauthorized",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+					store.EXPECT().ListEntries(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, other.Username)
+				},
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			url := fmt.Sprintf("/api/accounts/%d/entries?page_size=2", account.ID)
+
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
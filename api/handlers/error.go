@@ -6,21 +6,28 @@ import (
 )
 
 var (
-	ErrNotAccountOwner         = errors.New("account doesn't belong to authenticated user")
-	ErrEmailSameAsOld          = errors.New("new email is the same as old email")
-	ErrBlockedRefreshToken     = errors.New("refresh token is blocked")
-	ErrMismatchedRefreshTokens = errors.New("refresh token doesn't match with stored refresh token")
-	ErrExpiredRefreshToken     = errors.New("refresh token has expired")
-	ErrPasswordWrong           = errors.New("old password is different from the one stored in the database")
+	ErrNotAccountOwner = errors.New("account doesn't belong to authenticated user")
+	ErrEmailSameAsOld  = errors.New("new email is the same as old email")
+	ErrPasswordWrong   = errors.New("old password is different from the one stored in the database")
+	ErrNotSessionOwner = errors.New("session doesn't belong to authenticated user")
+	ErrUserDeleted     = errors.New("user is deleted")
+	ErrNoPublicKeys    = errors.New("tokens are encrypted with a symmetric key, they have no public keys")
+
+	ErrNotScheduledTransferOwner = errors.New("scheduled transfer doesn't belong to authenticated user")
 
 	ErrSameAccountTransfer = func(from, to int64) error {
 		return fmt.Errorf(fmt.Sprintf("can't transfer to the same account, req.FromAccountId=%d, req.ToAccount=%d", from, to))
 	}
-	ErrCurrencyMismatch = func(from, to string) error {
-		return fmt.Errorf(fmt.Sprintf("currency mismatch account1.currency=%s, account2.currency=%s", from, to))
-	}
 
 	ErrAccountDeleted = func(id int64) error {
 		return fmt.Errorf("account %d is deleted", id)
 	}
+
+	ErrRoleNotAllowed = func(role string) error {
+		return fmt.Errorf("role %q isn't allowed to access this resource", role)
+	}
+
+	ErrScheduledTransferClosed = func(id int64) error {
+		return fmt.Errorf("scheduled transfer %d is closed, only active and paused ones can be updated", id)
+	}
 )
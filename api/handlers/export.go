@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/escalopa/gobank/api/handlers/response"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/exporter"
+	"github.com/gin-gonic/gin"
+)
+
+type exportAccountUri struct {
+	AccountID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type exportAccountQuery struct {
+	Format string    `form:"format" binding:"required"`
+	From   time.Time `form:"from"`
+	To     time.Time `form:"to"`
+}
+
+// ExportAccount godoc
+//
+//	@Summary		exports the entries and transfers of an account
+//	@Description	streams the statement of the period as csv, ofx, qfx or a fixed-width text file, from defaults to the account creation and to defaults to now
+//	@Tags			accounts
+//	@Produce		text/csv,application/x-ofx,application/vnd.intu.qfx,text/plain
+//	@Param			id				path		int64	true	"Account ID"
+//	@Param			format			query		string	true	"Export format"	Enums(csv, ofx, qfx, text)
+//	@Param			from			query		string	false	"Start of the period (inclusive), RFC3339"
+//	@Param			to				query		string	false	"End of the period (exclusive), RFC3339"
+//	@Success		200				{file}		file
+//	@Failure		400,401,404,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/accounts/{id}/export [get]
+func (s *GinServer) exportAccount(ctx *gin.Context) {
+	var uri exportAccountUri
+	if err := parseUri(ctx, &uri); err != nil {
+		return
+	}
+
+	var query exportAccountQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.Err(err))
+		return
+	}
+
+	exp, err := exporter.New(query.Format)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.Err(err))
+		return
+	}
+
+	account, isValid := s.isValidAccount(ctx, uri.AccountID)
+	if !isValid {
+		return
+	}
+
+	if !isUserAccountOwner(ctx, account) {
+		ctx.JSON(http.StatusUnauthorized, response.Err(ErrNotAccountOwner))
+		return
+	}
+
+	if query.From.IsZero() {
+		query.From = account.CreatedAt
+	}
+
+	if query.To.IsZero() {
+		query.To = time.Now()
+	}
+
+	if !query.To.After(query.From) {
+		ctx.JSON(http.StatusBadRequest, response.Err(errors.New("to must be after from")))
+		return
+	}
+
+	filename := fmt.Sprintf("account-%d-%s-%s.%s", account.ID, query.From.UTC().Format("20060102"), query.To.UTC().Format("20060102"), exp.Extension())
+
+	// The lines are written a page at a time while the statement is read, so the status
+	// is already sent once the output starts and a later failure can only be logged
+	started := false
+	err = s.db.StreamAccountStatementTx(ctx, db.StreamAccountStatementParam{
+		AccountStatementParam: db.AccountStatementParam{
+			AccountID: account.ID,
+			From:      query.From,
+			To:        query.To,
+		},
+		PageSize: s.maxPageSize,
+	}, func(stream db.StatementStream) error {
+		ctx.Header("Content-Type", exp.ContentType())
+		ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		ctx.Status(http.StatusOK)
+		started = true
+
+		return exp.Export(ctx.Writer, exporter.Statement{
+			Account:         account,
+			From:            query.From,
+			To:              query.To,
+			StatementStream: stream,
+		})
+	})
+
+	if err != nil {
+		if !started {
+			ctx.JSON(http.StatusInternalServerError, response.Err(err))
+			return
+		}
+		log.Printf("cannot export account %d as %s: %v", account.ID, query.Format, err)
+	}
+}
@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	mockdb "github.com/escalopa/gobank/db/mock"
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/exporter"
+	"github.com/escalopa/gobank/token"
+	"github.com/escalopa/gobank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportAccount(t *testing.T) {
+	user, _ := createRandomUser(t)
+	other, _ := createRandomUser(t)
+	account := createRandomAccount(user.Username)
+
+	from := time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	line := db.StatementLine{
+		StatementEntry: db.StatementEntry{
+			Entry:          db.Entry{ID: 1, AccountID: account.ID, Amount: 20, Type: db.EntryTypeDeposit, BalanceAfter: 20},
+			RunningBalance: 20,
+		},
+	}
+
+	// streamStatement stands in for StreamAccountStatementTx, handing consume a one line statement
+	streamStatement := func(_ context.Context, _ db.StreamAccountStatementParam, consume func(db.StatementStream) error) error {
+		return consume(db.StatementStream{
+			EachLine: func(fn func(db.StatementLine) error) error { return fn(line) },
+		})
+	}
+
+	testCases := []struct {
+		name  string
+		query url.Values
+		testCaseBase
+	}{
+		{
+			name:  "OK",
+			query: url.Values{"format": {exporter.FormatCSV}, "from": {from.Format(time.RFC3339)}, "to": {to.Format(time.RFC3339)}},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+					arg := db.StreamAccountStatementParam{
+						AccountStatementParam: db.AccountStatementParam{AccountID: account.ID, From: from, To: to},
+						PageSize:              util.DefaultMaxPageSize,
+					}
+					store.EXPECT().
+						StreamAccountStatementTx(gomock.Any(), gomock.Eq(arg), gomock.Any()).
+						Times(1).
+						DoAndReturn(streamStatement)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+					require.Equal(t, "text/csv", recorder.Header().Get("Content-Type"))
+					require.Contains(t, recorder.Header().Get("Content-Disposition"), fmt.Sprintf("account-%d-20221101-20221201.csv", account.ID))
+
+					lines := strings.Split(strings.TrimSpace(recorder.Body.String()), "\n")
+					require.Len(t, lines, 2)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name:  "InternalError",
+			query: url.Values{"format": {exporter.FormatText}},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+					store.EXPECT().
+						StreamAccountStatementTx(gomock.Any(), gomock.Any(), gomock.Any()).
+						Times(1).
+						Return(sql.ErrConnDone)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusInternalServerError, recorder.Code)
+					require.Empty(t, recorder.Header().Get("Content-Disposition"))
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name:  "BadRequest-Format",
+			query: url.Values{"format": {"pdf"}},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+					store.EXPECT().StreamAccountStatementTx(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name:  "Unauthorized",
+			query: url.Values{"format": {exporter.FormatOFX}},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+					store.EXPECT().StreamAccountStatementTx(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, other.Username)
+				},
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			url := fmt.Sprintf("/api/accounts/%d/export?%s", account.ID, tc.query.Encode())
+
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
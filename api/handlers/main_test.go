@@ -46,6 +46,8 @@ func runServerTest(t *testing.T, tc testCase, req *http.Request) {
 
 	store := mockdb.NewMockStore(ctrl)
 	tc.buildStubsMethod(store)
+	// Sessions are active unless a test case expects otherwise
+	store.EXPECT().IsSessionBlocked(gomock.Any(), gomock.Any()).AnyTimes().Return(false, nil)
 
 	server := newTestServer(t, store)
 	recorder := httptest.NewRecorder()
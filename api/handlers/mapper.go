@@ -1,43 +1,222 @@
 package handlers
 
-import db "github.com/escalopa/gobank/db/sqlc"
+import (
+	"time"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/fx"
+	"github.com/google/uuid"
+)
 
 func mapUserToResponse(user *db.User) userResponse {
 	return userResponse{
 		Username:          user.Username,
 		FullName:          user.FullName,
 		Email:             user.Email,
+		Role:              string(user.Role),
 		CreatedAt:         user.CreatedAt,
 		PasswordChangedAt: user.PasswordChangedAt,
 	}
 }
 
+// mapSessionToResponse maps session, currentID is the session of the access token of the request
+func mapSessionToResponse(session db.Session, currentID uuid.UUID) sessionResponse {
+	return sessionResponse{
+		ID:        session.ID,
+		UserAgent: session.UserAgent,
+		ClientIP:  session.ClientIp,
+		CreatedAt: session.CreatedAt,
+		ExpiresAt: session.ExpiresAt,
+		Current:   session.ID == currentID,
+	}
+}
+
 func mapAccountToResponse(account db.Account) *accountResponse {
 	return &accountResponse{
-		ID:        account.ID,
-		Balance:   account.Balance,
-		Currency:  account.Currency,
-		CreatedAt: account.CreatedAt,
+		ID:               account.ID,
+		Balance:          account.Balance,
+		AvailableBalance: account.AvailableBalance(),
+		OverdraftLimit:   account.OverdraftLimit,
+		Currency:         account.Currency,
+		Status:           account.Status,
+		CreatedAt:        account.CreatedAt,
+	}
+}
+
+func mapAccountToAdminResponse(account db.Account) adminAccountResponse {
+	return adminAccountResponse{
+		accountResponse: *mapAccountToResponse(account),
+		Owner:           account.Owner,
+		IsDeleted:       account.IsDeleted,
+	}
+}
+
+func mapAccountStatusChangeToResponse(change db.AccountStatusChange) accountStatusChangeResponse {
+	return accountStatusChangeResponse{
+		FromStatus:      change.FromStatus,
+		ToStatus:        change.ToStatus,
+		ChangedBy:       change.ChangedBy,
+		Reason:          change.Reason,
+		SweepTransferID: change.SweepTransferID.Int64,
+		CreatedAt:       change.CreatedAt,
 	}
 }
 
+func mapAuditEventToResponse(event db.AuditEvent) auditEventResponse {
+	res := auditEventResponse{
+		ID:            event.ID,
+		ActorUsername: event.ActorUsername,
+		ClientIP:      event.ClientIp,
+		UserAgent:     event.UserAgent,
+		Action:        event.Action,
+		TargetType:    event.TargetType,
+		TargetID:      event.TargetID,
+		Before:        event.Before,
+		After:         event.After,
+		CreatedAt:     event.CreatedAt,
+	}
+	if event.SessionID.Valid {
+		res.SessionID = event.SessionID.UUID.String()
+	}
+	return res
+}
+
 func mapTransferToResponse(transfer db.Transfer) *transferResponse {
-	return &transferResponse{
-		ID: transfer.ID,
+	res := &transferResponse{
+		ID:            transfer.ID,
+		FromAccountID: transfer.FromAccountID,
 		// FromAccount: transfer.FromAccountID,
 		ToAccountID: transfer.ToAccountID,
 		// FromEntry:   transfer.FromEntryID,
 		Amount:    transfer.Amount,
+		ToAmount:  transfer.ToAmount,
+		FxRate:    fx.ScaledToFloat(transfer.FxRate),
+		FxRateAt:  transfer.FxRateAt,
 		CreatedAt: transfer.CreatedAt,
+		Status:    transfer.Status,
+		Fee:       transfer.FeeAmount,
+
+		ReversalOf:     transfer.ReversalOf.Int64,
+		ReversedAmount: transfer.ReversedAmount,
 	}
+
+	if transfer.ReversedAt.Valid {
+		res.ReversedAt = &transfer.ReversedAt.Time
+	}
+	return res
 }
 
 func fromTransferTxToTransferResponse(result db.TransferTxResult) transferResponse {
-	return transferResponse{
-		ID:          result.Transfer.ID,
-		FromAccount: result.FromAccount,
-		ToAccountID: result.ToAccount.ID,
-		FromEntry:   result.FromEntry,
-		Amount:      result.Transfer.Amount,
+	res := transferResponse{
+		ID:            result.Transfer.ID,
+		FromAccountID: result.Transfer.FromAccountID,
+		FromAccount:   result.FromAccount,
+		ToAccountID:   result.ToAccount.ID,
+		FromEntry:     result.FromEntry,
+		Amount:        result.Transfer.Amount,
+		ToAmount:      result.Transfer.ToAmount,
+		FxRate:        fx.ScaledToFloat(result.Transfer.FxRate),
+		FxRateAt:      result.Transfer.FxRateAt,
+		CreatedAt:     result.Transfer.CreatedAt,
+		Status:        result.Transfer.Status,
+		Fee:           result.Fee.Amount,
+
+		ReversalOf:     result.Transfer.ReversalOf.Int64,
+		ReversedAmount: result.Transfer.ReversedAmount,
+	}
+
+	if result.Transfer.ReversedAt.Valid {
+		res.ReversedAt = &result.Transfer.ReversedAt.Time
+	}
+	if result.FeeEntry.ID != 0 {
+		res.FeeEntry = &result.FeeEntry
+	}
+	return res
+}
+
+// mapTransferReviewToResponse adds the review of transfer to res, its response
+func mapTransferReviewToResponse(res transferResponse, transfer db.Transfer) transferReviewResponse {
+	review := transferReviewResponse{
+		transferResponse: res,
+		RiskRule:         transfer.RiskRule.String,
+		RiskReason:       transfer.RiskReason.String,
+		ReviewedBy:       transfer.ReviewedBy.String,
+		ReviewNote:       transfer.ReviewNote.String,
+	}
+
+	if transfer.ReviewedAt.Valid {
+		review.ReviewedAt = &transfer.ReviewedAt.Time
+	}
+	return review
+}
+
+func mapEntryToResponse(entry db.Entry) entryResponse {
+	return entryResponse{
+		ID:           entry.ID,
+		AccountID:    entry.AccountID,
+		Amount:       entry.Amount,
+		Type:         entry.Type,
+		TransferID:   entry.TransferID.Int64,
+		ExternalRef:  entry.ExternalRef.String,
+		BalanceAfter: entry.BalanceAfter,
+		Description:  entry.Description,
+		CreatedAt:    entry.CreatedAt,
+	}
+}
+
+func mapEntryTxToResponse(result db.EntryTxResult) entryTxResponse {
+	return entryTxResponse{
+		Account: mapAccountToResponse(result.Account),
+		Entry:   mapEntryToResponse(result.Entry),
+	}
+}
+
+func mapStatementToResponse(account db.Account, from, to time.Time, statement db.AccountStatement) statementResponse {
+	res := statementResponse{
+		AccountID:      account.ID,
+		Currency:       account.Currency,
+		From:           from,
+		To:             to,
+		OpeningBalance: statement.OpeningBalance,
+		ClosingBalance: statement.ClosingBalance,
+		Entries:        make([]statementEntryResponse, 0, len(statement.Entries)),
+	}
+
+	for _, entry := range statement.Entries {
+		res.Entries = append(res.Entries, statementEntryResponse{
+			entryResponse:  mapEntryToResponse(entry.Entry),
+			RunningBalance: entry.RunningBalance,
+		})
+	}
+	return res
+}
+
+func mapScheduledTransferToResponse(scheduled db.ScheduledTransfer) scheduledTransferResponse {
+	res := scheduledTransferResponse{
+		ID:            scheduled.ID,
+		FromAccountID: scheduled.FromAccountID,
+		ToAccountID:   scheduled.ToAccountID,
+		Amount:        scheduled.Amount,
+		Frequency:     scheduled.Frequency,
+		StartAt:       scheduled.StartAt,
+		NextRunAt:     scheduled.NextRunAt,
+		Status:        scheduled.Status,
+		CreatedAt:     scheduled.CreatedAt,
+	}
+
+	if scheduled.EndAt.Valid {
+		res.EndAt = &scheduled.EndAt.Time
+	}
+	return res
+}
+
+func mapScheduledTransferAttemptToResponse(attempt db.ScheduledTransferAttempt) scheduledTransferAttemptResponse {
+	return scheduledTransferAttemptResponse{
+		ID:           attempt.ID,
+		Occurrence:   attempt.Occurrence,
+		ScheduledFor: attempt.ScheduledFor,
+		TransferID:   attempt.TransferID.Int64,
+		Error:        attempt.Error.String,
+		CreatedAt:    attempt.CreatedAt,
 	}
 }
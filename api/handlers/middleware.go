@@ -8,8 +8,11 @@ import (
 
 	"github.com/escalopa/gobank/api/handlers/response"
 
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/session"
 	"github.com/escalopa/gobank/token"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 const (
@@ -18,7 +21,8 @@ const (
 	authorizationPayloadKey = "payload"
 )
 
-func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
+// authMiddleware verifies the access token of the request and rejects it when its session was revoked
+func authMiddleware(tokenMaker token.Maker, store db.Store) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 
 		// Get Header
@@ -53,7 +57,51 @@ func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
 			return
 		}
 
+		// Check the session of the token
+		if err = session.CheckAccess(ctx, store, payload); err != nil {
+			if session.IsUnauthorized(err) {
+				ctx.AbortWithStatusJSON(http.StatusUnauthorized, response.Err(err))
+				return
+			}
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, response.Err(err))
+			return
+		}
+
 		ctx.Set(authorizationPayloadKey, payload)
+		setActor(ctx, payload.Username, payload.SessionID)
 		ctx.Next()
 	}
 }
+
+// setActor makes username the actor of the changes made by the request, they are recorded in the audit log
+// along with the session, uuid.Nil when there is none yet, the client ip and user agent of the request
+func setActor(ctx *gin.Context, username string, sessionID uuid.UUID) {
+	actor := db.Actor{Username: username, SessionID: sessionID, ClientIP: ctx.ClientIP(), UserAgent: ctx.Request.UserAgent()}
+	ctx.Request = ctx.Request.WithContext(db.WithActor(ctx.Request.Context(), actor))
+}
+
+// roleMiddleware only lets through users that have one of roles, it must run after authMiddleware. The role is
+// loaded from the database rather than taken from the token, so a demoted or deleted user loses access right away
+func roleMiddleware(store db.Store, roles ...db.UserRole) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		payload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+		current, err := session.CurrentRole(ctx, store, payload)
+		if err != nil {
+			if session.IsUnauthorized(err) {
+				ctx.AbortWithStatusJSON(http.StatusUnauthorized, response.Err(err))
+				return
+			}
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, response.Err(err))
+			return
+		}
+
+		for _, role := range roles {
+			if current == role {
+				ctx.Next()
+				return
+			}
+		}
+
+		ctx.AbortWithStatusJSON(http.StatusForbidden, response.Err(ErrRoleNotAllowed(string(current))))
+	}
+}
@@ -1,14 +1,19 @@
 package handlers
 
 import (
+	"database/sql"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	mockdb "github.com/escalopa/gobank/db/mock"
+	db "github.com/escalopa/gobank/db/sqlc"
 	"github.com/escalopa/gobank/token"
 	"github.com/escalopa/gobank/util"
 	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 )
 
@@ -19,7 +24,23 @@ func addAuthHeader(
 	authHeaderType string,
 	username string,
 ) {
-	token, payload, err := maker.CreateToken(username)
+	addRoleAuthHeader(t, request, maker, authHeaderType, username, db.UserRoleCustomer)
+}
+
+// expectRole stubs the role username has in the database, the routes restricted to some roles check it
+func expectRole(store *mockdb.MockStore, username string, role db.UserRole) {
+	store.EXPECT().GetUser(gomock.Any(), gomock.Eq(username)).Times(1).Return(db.User{Username: username, Role: role}, nil)
+}
+
+func addRoleAuthHeader(
+	t *testing.T,
+	request *http.Request,
+	maker token.Maker,
+	authHeaderType string,
+	username string,
+	role db.UserRole,
+) {
+	token, payload, err := maker.CreateToken(username, string(role), uuid.New())
 	require.NoError(t, err)
 	require.NotEmpty(t, payload)
 
@@ -36,6 +57,9 @@ func TestAuthMiddleware(t *testing.T) {
 		{
 			name: "OK",
 			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().IsSessionBlocked(gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
+				},
 				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
 					addAuthHeader(t, req, maker, authorizationTypeBearer, util.RandomString(6))
 				},
@@ -46,6 +70,9 @@ func TestAuthMiddleware(t *testing.T) {
 		{
 			name: "Unauthorized",
 			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().IsSessionBlocked(gomock.Any(), gomock.Any()).Times(0)
+				},
 				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
 				},
 				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
@@ -53,9 +80,38 @@ func TestAuthMiddleware(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "RevokedSession",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().IsSessionBlocked(gomock.Any(), gomock.Any()).Times(1).Return(true, nil)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, util.RandomString(6))
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				}},
+		},
+		{
+			name: "InternalError",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().IsSessionBlocked(gomock.Any(), gomock.Any()).Times(1).Return(false, sql.ErrConnDone)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, util.RandomString(6))
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				}},
+		},
 		{
 			name: "InvalidHeaderFormat",
 			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().IsSessionBlocked(gomock.Any(), gomock.Any()).Times(0)
+				},
 				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
 					addAuthHeader(t, req, maker, "", util.RandomString(6))
 				},
@@ -66,6 +122,9 @@ func TestAuthMiddleware(t *testing.T) {
 		{
 			name: "UnsupportedAuthType",
 			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().IsSessionBlocked(gomock.Any(), gomock.Any()).Times(0)
+				},
 				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
 					addAuthHeader(t, req, maker, "OAuth", util.RandomString(6))
 				},
@@ -79,11 +138,17 @@ func TestAuthMiddleware(t *testing.T) {
 		tc := testCases[i]
 
 		t.Run(tc.name, func(t *testing.T) {
-			server := newTestServer(t, nil)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
 
 			authPath := "/auth"
 
-			server.router.GET(authPath, authMiddleware(server.tm), func(ctx *gin.Context) {
+			server.router.GET(authPath, authMiddleware(server.tm, server.db), func(ctx *gin.Context) {
 				ctx.JSON(http.StatusOK, gin.H{})
 			})
 
@@ -100,3 +165,127 @@ func TestAuthMiddleware(t *testing.T) {
 
 	}
 }
+
+func TestRoleMiddleware(t *testing.T) {
+	username := util.RandomOwner()
+
+	testCases := []struct {
+		name string
+		testCaseBase
+	}{
+		{
+			name: "OK",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().IsSessionBlocked(gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
+					expectRole(store, username, db.UserRoleAdmin)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, username, db.UserRoleAdmin)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+				}},
+		},
+		{
+			name: "Forbidden",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().IsSessionBlocked(gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
+					expectRole(store, username, db.UserRoleCustomer)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, username)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusForbidden, recorder.Code)
+				}},
+		},
+		{
+			// The token was issued before the admin was demoted
+			name: "Demoted",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().IsSessionBlocked(gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
+					expectRole(store, username, db.UserRoleCustomer)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, username, db.UserRoleAdmin)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusForbidden, recorder.Code)
+				}},
+		},
+		{
+			name: "DeletedUser",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().IsSessionBlocked(gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
+					store.EXPECT().GetUser(gomock.Any(), gomock.Eq(username)).Times(1).
+						Return(db.User{Username: username, Role: db.UserRoleAdmin, IsDeleted: true}, nil)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, username, db.UserRoleAdmin)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				}},
+		},
+		{
+			name: "InternalError",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().IsSessionBlocked(gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
+					store.EXPECT().GetUser(gomock.Any(), gomock.Eq(username)).Times(1).Return(db.User{}, sql.ErrConnDone)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addRoleAuthHeader(t, req, maker, authorizationTypeBearer, username, db.UserRoleAdmin)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				}},
+		},
+		{
+			name: "Unauthorized",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().IsSessionBlocked(gomock.Any(), gomock.Any()).Times(0)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				},
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			adminPath := "/admin"
+
+			server.router.GET(adminPath, authMiddleware(server.tm, server.db), roleMiddleware(server.db, db.UserRoleAdmin), func(ctx *gin.Context) {
+				ctx.JSON(http.StatusOK, gin.H{})
+			})
+
+			req, err := http.NewRequest(http.MethodGet, adminPath, nil)
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+
+			tc.setupAuth(t, req, server.tm)
+			server.router.ServeHTTP(recorder, req)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
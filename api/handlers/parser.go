@@ -2,9 +2,9 @@ package handlers
 
 import (
 	"net/http"
-	"strconv"
 
 	"github.com/escalopa/gobank/api/handlers/response"
+	"github.com/escalopa/gobank/util"
 
 	"github.com/gin-gonic/gin"
 )
@@ -26,30 +26,27 @@ func parseUri(ctx *gin.Context, obj interface{}) error {
 	return nil
 }
 
-type paginationQuery struct {
-	Offset int32 `form:"offset" binding:"required"`
-	Limit  int32 `form:"limit" binding:"required"`
+type pageQuery struct {
+	PageSize int32  `form:"page_size" binding:"min=0"`
+	Cursor   string `form:"cursor"`
 }
 
-func parsePagination(ctx *gin.Context) (*paginationQuery, error) {
-	pgQuery := &paginationQuery{}
-
-	offset := ctx.DefaultQuery("offset", "1")
-	limit := ctx.DefaultQuery("limit", "1")
-
-	limit32, err := strconv.Atoi(limit)
-	if err != nil {
+// parsePage reads the page_size and cursor of a list request, page_size defaults to and is capped by PAGE_SIZE_MAX
+func (s *GinServer) parsePage(ctx *gin.Context) (cursor util.Cursor, pageSize int32, err error) {
+	var req pageQuery
+	if err = ctx.ShouldBindQuery(&req); err != nil {
 		ctx.JSON(http.StatusBadRequest, response.Err(err))
-		return nil, err
+		return
 	}
-	offset32, err := strconv.Atoi(offset)
-	if err != nil {
+
+	if pageSize, err = util.PageSize(req.PageSize, s.maxPageSize); err != nil {
 		ctx.JSON(http.StatusBadRequest, response.Err(err))
-		return nil, err
+		return
 	}
 
-	pgQuery.Limit = int32(limit32)
-	pgQuery.Offset = int32(offset32)
-
-	return pgQuery, nil
+	if cursor, err = util.DecodeCursor(req.Cursor); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.Err(err))
+		return
+	}
+	return
 }
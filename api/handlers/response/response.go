@@ -17,3 +17,8 @@ func Success(data interface{}) JSON {
 func Err(err error) JSON {
 	return JSON{Success: false, Error: &Error{Error: err.Error()}}
 }
+
+// ErrWithData is an error response that also carries the details of the error in Data
+func ErrWithData(err error, data interface{}) JSON {
+	return JSON{Success: false, Data: data, Error: &Error{Error: err.Error()}}
+}
@@ -0,0 +1,334 @@
+package handlers
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/escalopa/gobank/api/handlers/response"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/fx"
+	"github.com/escalopa/gobank/token"
+	"github.com/escalopa/gobank/util"
+	"github.com/gin-gonic/gin"
+)
+
+type scheduledTransferResponse struct {
+	ID            int64                      `json:"id"`
+	FromAccountID int64                      `json:"from_account_id"`
+	ToAccountID   int64                      `json:"to_account_id"`
+	Amount        int64                      `json:"amount"`
+	Frequency     db.TransferFrequency       `json:"frequency"`
+	StartAt       time.Time                  `json:"start_at"`
+	EndAt         *time.Time                 `json:"end_at,omitempty"`
+	NextRunAt     time.Time                  `json:"next_run_at"`
+	Status        db.ScheduledTransferStatus `json:"status"`
+	CreatedAt     time.Time                  `json:"created_at"`
+}
+
+type scheduledTransferAttemptResponse struct {
+	ID           int64     `json:"id"`
+	Occurrence   int32     `json:"occurrence"`
+	ScheduledFor time.Time `json:"scheduled_for"`
+	TransferID   int64     `json:"transfer_id,omitempty"`
+	Error        string    `json:"error,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type createScheduledTransferReq struct {
+	FromAccountID int64      `json:"from_account_id" binding:"required,min=1"`
+	ToAccountID   int64      `json:"to_account_id" binding:"required,min=1"`
+	Amount        int64      `json:"amount" binding:"required,gte=1"`
+	Frequency     string     `json:"frequency" binding:"required,oneof=once daily weekly monthly"`
+	StartAt       time.Time  `json:"start_at" binding:"required"`
+	EndAt         *time.Time `json:"end_at"`
+}
+
+// CreateScheduledTransfer godoc
+//
+//	@Summary		schedules a transfer between two accounts
+//	@Description	schedules a transfer for start_at, recurring ones are repeated every day, week or month until end_at
+//	@Tags			scheduled-transfers
+//	@Accept			json
+//	@Produce		json
+//	@Param			body			body		createScheduledTransferReq	true	"Scheduled transfer to create"
+//	@Success		201				{object}	response.JSON{data=scheduledTransferResponse}
+//	@Failure		400,401,404,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/scheduled-transfers [post]
+func (s *GinServer) createScheduledTransfer(ctx *gin.Context) {
+	var req createScheduledTransferReq
+	if err := parseBody(ctx, &req); err != nil {
+		return
+	}
+
+	arg := db.CreateScheduledTransferParams{
+		FromAccountID: req.FromAccountID,
+		ToAccountID:   req.ToAccountID,
+		Amount:        req.Amount,
+		Frequency:     db.TransferFrequency(req.Frequency),
+		StartAt:       req.StartAt,
+	}
+	if req.EndAt != nil {
+		arg.EndAt = sql.NullTime{Time: *req.EndAt, Valid: true}
+	}
+
+	if err := arg.Validate(time.Now()); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.Err(err))
+		return
+	}
+
+	fromAccount, to, isValid := s.validateTransfer(ctx, req.FromAccountID, req.ToAccountID)
+	if !isValid {
+		return
+	}
+
+	if !isUserAccountOwner(ctx, fromAccount) {
+		ctx.JSON(http.StatusUnauthorized, response.Err(ErrNotAccountOwner))
+		return
+	}
+
+	// The rate is taken when each occurrence runs, the pair must be known upfront though
+	if fromAccount.Currency != to.Currency {
+		if _, err := s.rates.Rate(fromAccount.Currency, to.Currency); err != nil {
+			if errors.Is(err, fx.ErrRateNotFound) {
+				ctx.JSON(http.StatusBadRequest, response.Err(err))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, response.Err(err))
+			return
+		}
+	}
+
+	arg.Owner = fromAccount.Owner
+	scheduled, err := s.db.CreateScheduledTransfer(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.Success(mapScheduledTransferToResponse(scheduled)))
+}
+
+// GetScheduledTransfers godoc
+//
+//	@Summary		gets the scheduled transfers of the currently logged-in user
+//	@Description	gets the scheduled transfers of the currently logged-in user, including the closed ones
+//	@Tags			scheduled-transfers
+//	@Produce		json
+//	@Success		200		{object}	response.JSON{data=[]scheduledTransferResponse}
+//	@Failure		401,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/scheduled-transfers [get]
+func (s *GinServer) getScheduledTransfers(ctx *gin.Context) {
+	payload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	schedules, err := s.db.ListScheduledTransfers(ctx, payload.Username)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
+	}
+
+	res := []scheduledTransferResponse{}
+	for _, scheduled := range schedules {
+		res = append(res, mapScheduledTransferToResponse(scheduled))
+	}
+
+	ctx.JSON(http.StatusOK, response.Success(res))
+}
+
+type scheduledTransferUri struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+// GetScheduledTransfer godoc
+//
+//	@Summary		gets a scheduled transfer by id
+//	@Description	gets a scheduled transfer by id
+//	@Tags			scheduled-transfers
+//	@Produce		json
+//	@Param			id				path		int64	true	"Scheduled Transfer ID"
+//	@Success		200				{object}	response.JSON{data=scheduledTransferResponse}
+//	@Failure		400,401,404,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/scheduled-transfers/{id} [get]
+func (s *GinServer) getScheduledTransfer(ctx *gin.Context) {
+	scheduled, isValid := s.parseScheduledTransfer(ctx)
+	if !isValid {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Success(mapScheduledTransferToResponse(scheduled)))
+}
+
+type updateScheduledTransferReq struct {
+	Amount int64      `json:"amount" binding:"omitempty,gte=1"`
+	EndAt  *time.Time `json:"end_at"`
+	Status string     `json:"status" binding:"omitempty,oneof=active paused"`
+}
+
+// UpdateScheduledTransfer godoc
+//
+//	@Summary		updates a scheduled transfer
+//	@Description	changes the amount or the end of a scheduled transfer, pauses or resumes it. Only active and paused schedules can be updated
+//	@Tags			scheduled-transfers
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		int64						true	"Scheduled Transfer ID"
+//	@Param			body			body		updateScheduledTransferReq	true	"Fields to update"
+//	@Success		200				{object}	response.JSON{data=scheduledTransferResponse}
+//	@Failure		400,401,404,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/scheduled-transfers/{id} [patch]
+func (s *GinServer) updateScheduledTransfer(ctx *gin.Context) {
+	var req updateScheduledTransferReq
+	if err := parseBody(ctx, &req); err != nil {
+		return
+	}
+
+	scheduled, isValid := s.parseScheduledTransfer(ctx)
+	if !isValid {
+		return
+	}
+
+	arg := db.UpdateScheduledTransferParams{
+		ID:     scheduled.ID,
+		Amount: sql.NullInt64{Int64: req.Amount, Valid: req.Amount != 0},
+		Status: db.NullScheduledTransferStatus{ScheduledTransferStatus: db.ScheduledTransferStatus(req.Status), Valid: req.Status != ""},
+	}
+
+	if req.EndAt != nil {
+		if err := scheduled.ValidateEnd(*req.EndAt); err != nil {
+			ctx.JSON(http.StatusBadRequest, response.Err(err))
+			return
+		}
+		arg.EndAt = sql.NullTime{Time: *req.EndAt, Valid: true}
+	}
+
+	s.saveScheduledTransfer(ctx, scheduled, arg)
+}
+
+// CancelScheduledTransfer godoc
+//
+//	@Summary		cancels a scheduled transfer
+//	@Description	cancels a scheduled transfer, none of its occurrences are run anymore
+//	@Tags			scheduled-transfers
+//	@Produce		json
+//	@Param			id				path		int64	true	"Scheduled Transfer ID"
+//	@Success		200				{object}	response.JSON{data=scheduledTransferResponse}
+//	@Failure		400,401,404,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/scheduled-transfers/{id} [delete]
+func (s *GinServer) cancelScheduledTransfer(ctx *gin.Context) {
+	scheduled, isValid := s.parseScheduledTransfer(ctx)
+	if !isValid {
+		return
+	}
+
+	s.saveScheduledTransfer(ctx, scheduled, db.UpdateScheduledTransferParams{
+		ID:     scheduled.ID,
+		Status: db.NullScheduledTransferStatus{ScheduledTransferStatus: db.ScheduledTransferStatusCancelled, Valid: true},
+	})
+}
+
+type listScheduledTransferAttemptsResponse struct {
+	Attempts []scheduledTransferAttemptResponse `json:"attempts"`
+	// NextCursor is empty on the last page
+	NextCursor string `json:"next_cursor"`
+}
+
+// ListScheduledTransferAttempts godoc
+//
+//	@Summary		gets the attempts of a scheduled transfer
+//	@Description	gets a page of the attempts to run the occurrences of a scheduled transfer, successful or not, pass next_cursor as cursor to get the next page
+//	@Tags			scheduled-transfers
+//	@Produce		json
+//	@Param			id				path		int64	true	"Scheduled Transfer ID"
+//	@Param			page_size		query		int32	false	"Page Size, defaults to and is capped by PAGE_SIZE_MAX"
+//	@Param			cursor			query		string	false	"next_cursor of the previous page"
+//	@Success		200				{object}	response.JSON{data=listScheduledTransferAttemptsResponse}
+//	@Failure		400,401,404,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/scheduled-transfers/{id}/attempts [get]
+func (s *GinServer) listScheduledTransferAttempts(ctx *gin.Context) {
+	cursor, pageSize, err := s.parsePage(ctx)
+	if err != nil {
+		return
+	}
+
+	scheduled, isValid := s.parseScheduledTransfer(ctx)
+	if !isValid {
+		return
+	}
+
+	attempts, err := s.db.ListScheduledTransferAttempts(ctx, db.ListScheduledTransferAttemptsParams{
+		ScheduledTransferID: scheduled.ID,
+		AfterCreatedAt:      cursor.CreatedAt,
+		AfterID:             cursor.ID,
+		PageSize:            pageSize + 1,
+	})
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
+	}
+
+	res := listScheduledTransferAttemptsResponse{Attempts: []scheduledTransferAttemptResponse{}}
+	attempts, res.NextCursor = util.Paginate(attempts, pageSize, func(attempt db.ScheduledTransferAttempt) util.Cursor {
+		return util.Cursor{CreatedAt: attempt.CreatedAt, ID: attempt.ID}
+	})
+
+	for _, attempt := range attempts {
+		res.Attempts = append(res.Attempts, mapScheduledTransferAttemptToResponse(attempt))
+	}
+
+	ctx.JSON(http.StatusOK, response.Success(res))
+}
+
+// parseScheduledTransfer loads the scheduled transfer of the uri, as long as it belongs to the authenticated user
+func (s *GinServer) parseScheduledTransfer(ctx *gin.Context) (scheduled db.ScheduledTransfer, isValid bool) {
+	var uri scheduledTransferUri
+	if err := parseUri(ctx, &uri); err != nil {
+		return
+	}
+
+	scheduled, err := s.db.GetScheduledTransfer(ctx, uri.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, response.Err(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
+	}
+
+	payload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if scheduled.Owner != payload.Username {
+		ctx.JSON(http.StatusUnauthorized, response.Err(ErrNotScheduledTransferOwner))
+		return
+	}
+
+	return scheduled, true
+}
+
+// saveScheduledTransfer applies arg to the scheduled transfer, as long as it hasn't been closed in the meantime
+func (s *GinServer) saveScheduledTransfer(ctx *gin.Context, scheduled db.ScheduledTransfer, arg db.UpdateScheduledTransferParams) {
+	if !scheduled.IsOpen() {
+		ctx.JSON(http.StatusBadRequest, response.Err(ErrScheduledTransferClosed(scheduled.ID)))
+		return
+	}
+
+	updated, err := s.db.UpdateScheduledTransfer(ctx, arg)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusBadRequest, response.Err(ErrScheduledTransferClosed(scheduled.ID)))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Success(mapScheduledTransferToResponse(updated)))
+}
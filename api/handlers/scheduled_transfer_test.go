@@ -0,0 +1,489 @@
+package handlers
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/escalopa/gobank/db/mock"
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/token"
+	"github.com/escalopa/gobank/util"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomScheduledTransfer(from, to db.Account) db.ScheduledTransfer {
+	startAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	return db.ScheduledTransfer{
+		ID:            util.RandomInteger(1, 1000),
+		Owner:         from.Owner,
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        util.RandomInteger(1, 1000),
+		Frequency:     db.TransferFrequencyMonthly,
+		StartAt:       startAt,
+		NextRunAt:     startAt,
+		Status:        db.ScheduledTransferStatusActive,
+	}
+}
+
+func TestCreateScheduledTransfer(t *testing.T) {
+	user, _ := createRandomUser(t)
+	other, _ := createRandomUser(t)
+
+	account1 := createRandomAccount(user.Username)
+	account2 := createRandomAccount(other.Username)
+	account2.ID = account1.ID + 1
+	account1.Currency, account2.Currency = util.EGP, util.EGP
+
+	scheduled := createRandomScheduledTransfer(account1, account2)
+	endAt := scheduled.StartAt.AddDate(1, 0, 0)
+	arg := createScheduledTransferReq{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        scheduled.Amount,
+		Frequency:     string(scheduled.Frequency),
+		StartAt:       scheduled.StartAt,
+		EndAt:         &endAt,
+	}
+
+	withArg := func(change func(req *createScheduledTransferReq)) createScheduledTransferReq {
+		req := arg
+		change(&req)
+		return req
+	}
+
+	testCases := []struct {
+		name string
+		body createScheduledTransferReq
+		testCaseBase
+	}{
+		{
+			name: "OK",
+			body: arg,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+					store.EXPECT().
+						CreateScheduledTransfer(gomock.Any(), gomock.Eq(db.CreateScheduledTransferParams{
+							Owner:         user.Username,
+							FromAccountID: account1.ID,
+							ToAccountID:   account2.ID,
+							Amount:        arg.Amount,
+							Frequency:     db.TransferFrequencyMonthly,
+							StartAt:       arg.StartAt,
+							EndAt:         sql.NullTime{Time: endAt, Valid: true},
+						})).
+						Times(1).
+						Return(scheduled, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusCreated, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name: "BadRequest-Frequency",
+			body: withArg(func(req *createScheduledTransferReq) { req.Frequency = "yearly" }),
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name: "BadRequest-StartInPast",
+			body: withArg(func(req *createScheduledTransferReq) { req.StartAt = time.Now().Add(-time.Hour) }),
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+					store.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name: "BadRequest-EndBeforeStart",
+			body: withArg(func(req *createScheduledTransferReq) {
+				end := req.StartAt.Add(-time.Minute)
+				req.EndAt = &end
+			}),
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name: "NotAccountOwner",
+			body: arg,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+					store.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, other.Username)
+				},
+			},
+		},
+		{
+			name: "RateNotFound",
+			body: arg,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					foreign := account2
+					foreign.Currency = util.USD
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(foreign, nil)
+					store.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name: "NoAuthorization",
+			body: arg,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {},
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, "/api/scheduled-transfers", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
+
+func TestUpdateScheduledTransfer(t *testing.T) {
+	user, _ := createRandomUser(t)
+	other, _ := createRandomUser(t)
+
+	account1 := createRandomAccount(user.Username)
+	account2 := createRandomAccount(other.Username)
+	scheduled := createRandomScheduledTransfer(account1, account2)
+
+	paused := scheduled
+	paused.Status = db.ScheduledTransferStatusPaused
+
+	completed := scheduled
+	completed.Status = db.ScheduledTransferStatusCompleted
+
+	testCases := []struct {
+		name   string
+		method string
+		body   gin.H
+		testCaseBase
+	}{
+		{
+			name:   "OK-Pause",
+			method: http.MethodPatch,
+			body:   gin.H{"status": "paused", "amount": 50},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetScheduledTransfer(gomock.Any(), gomock.Eq(scheduled.ID)).Times(1).Return(scheduled, nil)
+					store.EXPECT().
+						UpdateScheduledTransfer(gomock.Any(), gomock.Eq(db.UpdateScheduledTransferParams{
+							ID:     scheduled.ID,
+							Amount: sql.NullInt64{Int64: 50, Valid: true},
+							Status: db.NullScheduledTransferStatus{ScheduledTransferStatus: db.ScheduledTransferStatusPaused, Valid: true},
+						})).
+						Times(1).
+						Return(paused, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+
+					var res struct {
+						Data scheduledTransferResponse `json:"data"`
+					}
+					require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+					require.Equal(t, db.ScheduledTransferStatusPaused, res.Data.Status)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name:   "OK-Cancel",
+			method: http.MethodDelete,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetScheduledTransfer(gomock.Any(), gomock.Eq(scheduled.ID)).Times(1).Return(scheduled, nil)
+					store.EXPECT().
+						UpdateScheduledTransfer(gomock.Any(), gomock.Eq(db.UpdateScheduledTransferParams{
+							ID:     scheduled.ID,
+							Status: db.NullScheduledTransferStatus{ScheduledTransferStatus: db.ScheduledTransferStatusCancelled, Valid: true},
+						})).
+						Times(1).
+						Return(scheduled, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name:   "BadRequest-Status",
+			method: http.MethodPatch,
+			body:   gin.H{"status": "completed"},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().UpdateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name:   "BadRequest-EndBeforeNextRun",
+			method: http.MethodPatch,
+			body:   gin.H{"end_at": scheduled.NextRunAt.Add(-time.Minute)},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetScheduledTransfer(gomock.Any(), gomock.Eq(scheduled.ID)).Times(1).Return(scheduled, nil)
+					store.EXPECT().UpdateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name:   "BadRequest-Closed",
+			method: http.MethodDelete,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetScheduledTransfer(gomock.Any(), gomock.Eq(scheduled.ID)).Times(1).Return(completed, nil)
+					store.EXPECT().UpdateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name:   "BadRequest-ClosedConcurrently",
+			method: http.MethodPatch,
+			body:   gin.H{"status": "active"},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetScheduledTransfer(gomock.Any(), gomock.Eq(scheduled.ID)).Times(1).Return(paused, nil)
+					store.EXPECT().UpdateScheduledTransfer(gomock.Any(), gomock.Any()).Times(1).Return(db.ScheduledTransfer{}, sql.ErrNoRows)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name:   "NotFound",
+			method: http.MethodDelete,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetScheduledTransfer(gomock.Any(), gomock.Eq(scheduled.ID)).Times(1).Return(db.ScheduledTransfer{}, sql.ErrNoRows)
+					store.EXPECT().UpdateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusNotFound, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name:   "NotScheduledTransferOwner",
+			method: http.MethodPatch,
+			body:   gin.H{"status": "paused"},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetScheduledTransfer(gomock.Any(), gomock.Eq(scheduled.ID)).Times(1).Return(scheduled, nil)
+					store.EXPECT().UpdateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, other.Username)
+				},
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			var body bytes.Buffer
+			if tc.body != nil {
+				require.NoError(t, json.NewEncoder(&body).Encode(tc.body))
+			}
+
+			url := fmt.Sprintf("/api/scheduled-transfers/%d", scheduled.ID)
+			req, err := http.NewRequest(tc.method, url, &body)
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
+
+func TestListScheduledTransferAttempts(t *testing.T) {
+	user, _ := createRandomUser(t)
+	other, _ := createRandomUser(t)
+
+	account1 := createRandomAccount(user.Username)
+	account2 := createRandomAccount(other.Username)
+	scheduled := createRandomScheduledTransfer(account1, account2)
+
+	n := 3
+	attempts := make([]db.ScheduledTransferAttempt, n)
+	for i := range attempts {
+		attempts[i] = db.ScheduledTransferAttempt{
+			ID:                  int64(i + 1),
+			ScheduledTransferID: scheduled.ID,
+			Occurrence:          int32(i),
+			ScheduledFor:        scheduled.RunAt(int32(i)),
+			TransferID:          sql.NullInt64{Int64: int64(i + 1), Valid: i != 1},
+			Error:               sql.NullString{String: db.ErrInsufficientFunds.Error(), Valid: i == 1},
+			CreatedAt:           time.Now().Add(time.Duration(i) * time.Second),
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		pageSize int32
+		testCaseBase
+	}{
+		{
+			name:     "OK",
+			pageSize: 2,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetScheduledTransfer(gomock.Any(), gomock.Eq(scheduled.ID)).Times(1).Return(scheduled, nil)
+					store.EXPECT().
+						ListScheduledTransferAttempts(gomock.Any(), gomock.Eq(db.ListScheduledTransferAttemptsParams{
+							ScheduledTransferID: scheduled.ID,
+							PageSize:            3,
+						})).
+						Times(1).
+						Return(attempts, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+
+					var res struct {
+						Data listScheduledTransferAttemptsResponse `json:"data"`
+					}
+					require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+					require.Len(t, res.Data.Attempts, 2)
+					require.NotZero(t, res.Data.Attempts[0].TransferID)
+					require.Equal(t, db.ErrInsufficientFunds.Error(), res.Data.Attempts[1].Error)
+					require.NotEmpty(t, res.Data.NextCursor)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name:     "NotScheduledTransferOwner",
+			pageSize: 2,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetScheduledTransfer(gomock.Any(), gomock.Eq(scheduled.ID)).Times(1).Return(scheduled, nil)
+					store.EXPECT().ListScheduledTransferAttempts(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, other.Username)
+				},
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			url := fmt.Sprintf("/api/scheduled-transfers/%d/attempts?page_size=%d", scheduled.ID, tc.pageSize)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
@@ -2,10 +2,13 @@ package handlers
 
 import (
 	"fmt"
+	"time"
+
 	"github.com/escalopa/gobank/api/docs"
 
 	_ "github.com/escalopa/gobank/api/docs"
 	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/fx"
 	"github.com/escalopa/gobank/token"
 	"github.com/escalopa/gobank/util"
 	"github.com/gin-gonic/gin"
@@ -20,15 +23,52 @@ type GinServer struct {
 	db     db.Store
 	tm     token.Maker
 	router *gin.Engine
+	rates  fx.RateProvider
+
+	idempotencyRetention time.Duration
+	// refundWindow is how long after a transfer its recipient can refund it
+	refundWindow time.Duration
+	// startingBalance is credited to every new account
+	startingBalance int64
+	// maxPageSize caps the page_size of list requests
+	maxPageSize int32
 }
 
 func NewServer(config *util.Config, store db.Store) (*GinServer, error) {
-	maker, err := token.NewPasetoMaker(config.Get("SYMMETRIC_KEY"))
+	maker, err := token.NewMaker(config)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create tokenMaker, %w", err)
 	}
 
-	s := &GinServer{config: config, tm: maker, db: store}
+	retention, err := config.GetDuration("IDEMPOTENCY_KEY_RETENTION", 24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	refundWindow, err := config.GetDuration("TRANSFER_REFUND_WINDOW", 7*24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	startingBalance, err := config.GetInt64("ACCOUNT_STARTING_BALANCE", 0)
+	if err != nil {
+		return nil, err
+	}
+	if startingBalance < 0 {
+		return nil, fmt.Errorf("ACCOUNT_STARTING_BALANCE must not be negative, provided: %d", startingBalance)
+	}
+
+	maxPageSize, err := util.LoadMaxPageSize(config)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := fx.NewProvider(config)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create rate provider, %w", err)
+	}
+
+	s := &GinServer{config: config, tm: maker, db: store, rates: rates, idempotencyRetention: retention, refundWindow: refundWindow, startingBalance: startingBalance, maxPageSize: maxPageSize}
 
 	gin.SetMode(gin.ReleaseMode)
 	s.setupValidator()
@@ -53,8 +93,10 @@ func (s *GinServer) setupValidator() {
 
 func (s *GinServer) setupRouter() {
 	router := gin.Default()
+	// Lets the store read the audit actor that setActor puts in the context of the request
+	router.ContextWithFallback = true
 
-	auth := router.Group("/").Use(authMiddleware(s.tm))
+	auth := router.Group("/").Use(authMiddleware(s.tm, s.db))
 
 	// Account Routes
 	auth.POST("/api/accounts", s.createAccount)
@@ -63,19 +105,58 @@ func (s *GinServer) setupRouter() {
 	auth.GET("/api/accounts/del", s.getDeletedAccounts)
 	auth.PATCH("/api/accounts/res/:id", s.restoreAccount)
 	auth.DELETE("/api/accounts/:id", s.deleteAccount)
+	auth.GET("/api/accounts/:id/entries", s.listEntries)
+	auth.GET("/api/accounts/:id/statement", s.getStatement)
+	auth.GET("/api/accounts/:id/export", s.exportAccount)
+	auth.POST("/api/accounts/:id/close", s.closeAccount)
 
 	// Transfer Routes
+	auth.GET("/api/transfers/quote", s.quoteTransfer)
 	auth.GET("/api/transfers/:id", s.getTransfers)
 	auth.POST("/api/transfers", s.createTransfer)
+	auth.POST("/api/transfers/:id/refund", s.refundTransfer)
+
+	// Scheduled Transfer Routes
+	auth.POST("/api/scheduled-transfers", s.createScheduledTransfer)
+	auth.GET("/api/scheduled-transfers", s.getScheduledTransfers)
+	auth.GET("/api/scheduled-transfers/:id", s.getScheduledTransfer)
+	auth.PATCH("/api/scheduled-transfers/:id", s.updateScheduledTransfer)
+	auth.DELETE("/api/scheduled-transfers/:id", s.cancelScheduledTransfer)
+	auth.GET("/api/scheduled-transfers/:id/attempts", s.listScheduledTransferAttempts)
 
 	// User Routes
 	auth.GET("api/users", s.getUser)
 	auth.PATCH("api/users", s.updateUser)
+	auth.DELETE("api/users", s.deleteUser)
+	auth.POST("api/users/logout", s.logoutUser)
+	auth.GET("api/users/sessions", s.listSessions)
+	auth.DELETE("api/users/sessions/:id", s.revokeSession)
+	auth.DELETE("api/users/sessions", s.revokeOtherSessions)
+
+	// Teller Routes, the cash of deposits and withdrawals is handled at the counter
+	teller := router.Group("/api/accounts").Use(authMiddleware(s.tm, s.db), roleMiddleware(s.db, db.UserRoleTeller, db.UserRoleAdmin))
+	teller.POST("/:id/deposits", s.deposit)
+	teller.POST("/:id/withdrawals", s.withdraw)
+
+	// Admin Routes
+	admin := router.Group("/api/admin").Use(authMiddleware(s.tm, s.db), roleMiddleware(s.db, db.UserRoleAdmin))
+	admin.GET("/users", s.listUsers)
+	admin.GET("/accounts/:id", s.adminGetAccount)
+	admin.POST("/accounts/:id/freeze", s.freezeAccount)
+	admin.POST("/accounts/:id/unfreeze", s.unfreezeAccount)
+	admin.GET("/accounts/:id/status-changes", s.listAccountStatusChanges)
+	admin.POST("/transfers/:id/reverse", s.reverseTransfer)
+	admin.GET("/transfers/pending", s.listPendingTransfers)
+	admin.POST("/transfers/:id/approve", s.approveTransfer)
+	admin.POST("/transfers/:id/reject", s.rejectTransfer)
+	admin.POST("/users/:username/sessions/block", s.blockUserSessions)
+	admin.GET("/audit-events", s.listAuditEvents)
 
 	// Unauthenticated Routes
 	router.POST("api/users/register", s.register)
 	router.POST("api/users/login", s.loginUser)
 	router.POST("api/users/renew", s.renewAccessToken)
+	router.GET("api/.well-known/jwks.json", s.getTokenKeys)
 
 	s.router = router
 }
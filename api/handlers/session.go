@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/escalopa/gobank/api/handlers/response"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/token"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type sessionResponse struct {
+	ID        uuid.UUID `json:"id"`
+	UserAgent string    `json:"user_agent"`
+	ClientIP  string    `json:"client_ip"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	// Current is true for the session of the access token of the request
+	Current bool `json:"current"`
+}
+
+type sessionUri struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+// ListSessions godoc
+//
+//	@Summary		lists the active sessions of the user
+//	@Description	lists the sessions of the user that can still be renewed from the newest, one per login
+//	@Tags			users
+//	@Produce		json
+//	@Success		200		{object}	response.JSON{data=[]sessionResponse}
+//	@Failure		401,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/users/sessions [get]
+func (s *GinServer) listSessions(ctx *gin.Context) {
+	payload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	sessions, err := s.db.ListActiveUserSessions(ctx, payload.Username)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
+	}
+
+	res := []sessionResponse{}
+	for _, session := range sessions {
+		res = append(res, mapSessionToResponse(session, payload.SessionID))
+	}
+
+	ctx.JSON(http.StatusOK, response.Success(res))
+}
+
+// RevokeSession godoc
+//
+//	@Summary		revokes a session of the user
+//	@Description	blocks the session along with the sessions renewed from the same login, their refresh and access tokens are rejected right away
+//	@Tags			users
+//	@Produce		json
+//	@Param			id				path		string	true	"Session ID"
+//	@Success		200				{object}	response.JSON{}
+//	@Failure		400,401,404,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/users/sessions/{id} [delete]
+func (s *GinServer) revokeSession(ctx *gin.Context) {
+	var uri sessionUri
+	if err := parseUri(ctx, &uri); err != nil {
+		return
+	}
+
+	// Binding already validated the uuid format
+	session, err := s.db.GetSession(ctx, uuid.MustParse(uri.ID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, response.Err(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
+	}
+
+	payload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if session.Username != payload.Username {
+		ctx.JSON(http.StatusUnauthorized, response.Err(ErrNotSessionOwner))
+		return
+	}
+
+	if err = s.db.BlockSessionFamily(ctx, session.FamilyID); err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Success(session.ID))
+}
+
+// RevokeOtherSessions godoc
+//
+//	@Summary		revokes all the other sessions of the user
+//	@Description	blocks every session of the user but the one of the access token of the request, their refresh and access tokens are rejected right away
+//	@Tags			users
+//	@Produce		json
+//	@Success		200		{object}	response.JSON{}
+//	@Failure		401,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/users/sessions [delete]
+func (s *GinServer) revokeOtherSessions(ctx *gin.Context) {
+	payload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	// authMiddleware already checked that the session exists
+	current, err := s.db.GetSession(ctx, payload.SessionID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
+	}
+
+	err = s.db.BlockOtherUserSessions(ctx, db.BlockOtherUserSessionsParams{
+		Username: payload.Username,
+		FamilyID: current.FamilyID,
+	})
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Success(current.ID))
+}
@@ -0,0 +1,253 @@
+package handlers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/escalopa/gobank/db/mock"
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/token"
+	"github.com/escalopa/gobank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// addSessionAuthHeader adds an access token of the session sessionID to request
+func addSessionAuthHeader(t *testing.T, request *http.Request, maker token.Maker, username string, sessionID uuid.UUID) {
+	token, _, err := maker.CreateToken(username, string(db.UserRoleCustomer), sessionID)
+	require.NoError(t, err)
+
+	request.Header.Add(authorizationHeaderKey, fmt.Sprintf("%s %s", authorizationTypeBearer, token))
+}
+
+func randomSession(username string) db.Session {
+	id := uuid.New()
+	return db.Session{
+		ID:        id,
+		Username:  username,
+		UserAgent: "go-test",
+		ClientIp:  "127.0.0.1",
+		ExpiresAt: time.Now().Add(time.Hour),
+		CreatedAt: time.Now(),
+		FamilyID:  id,
+	}
+}
+
+func TestListSessions(t *testing.T) {
+	user, _ := createRandomUser(t)
+	current := randomSession(user.Username)
+	other := randomSession(user.Username)
+
+	testCases := []struct {
+		name string
+		testCaseBase
+	}{
+		{
+			name: "OK",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().
+						ListActiveUserSessions(gomock.Any(), gomock.Eq(user.Username)).
+						Times(1).
+						Return([]db.Session{other, current}, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+
+					var res struct {
+						Data []sessionResponse `json:"data"`
+					}
+					require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+					require.Len(t, res.Data, 2)
+					require.Equal(t, other.ID, res.Data[0].ID)
+					require.False(t, res.Data[0].Current)
+					require.Equal(t, current.ID, res.Data[1].ID)
+					require.True(t, res.Data[1].Current)
+				},
+				setupAuth: func(t *testing.T, request *http.Request, maker token.Maker) {
+					addSessionAuthHeader(t, request, maker, user.Username, current.ID)
+				},
+			},
+		},
+		{
+			name: "RevokedSession",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().IsSessionBlocked(gomock.Any(), gomock.Eq(current.ID)).Times(1).Return(true, nil)
+					store.EXPECT().ListActiveUserSessions(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, request *http.Request, maker token.Maker) {
+					addSessionAuthHeader(t, request, maker, user.Username, current.ID)
+				},
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, "/api/users/sessions", nil)
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
+
+func TestRevokeSession(t *testing.T) {
+	user, _ := createRandomUser(t)
+	session := randomSession(user.Username)
+	// A session renewed from the login, revoking it blocks the whole family
+	session.FamilyID = uuid.New()
+
+	testCases := []struct {
+		name      string
+		sessionID string
+		testCaseBase
+	}{
+		{
+			name:      "OK",
+			sessionID: session.ID.String(),
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+					store.EXPECT().BlockSessionFamily(gomock.Any(), gomock.Eq(session.FamilyID)).Times(1).Return(nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, request *http.Request, maker token.Maker) {
+					addAuthHeader(t, request, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name:      "NotOwner",
+			sessionID: session.ID.String(),
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+					store.EXPECT().BlockSessionFamily(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, request *http.Request, maker token.Maker) {
+					addAuthHeader(t, request, maker, authorizationTypeBearer, util.RandomOwner())
+				},
+			},
+		},
+		{
+			name:      "NotFound",
+			sessionID: session.ID.String(),
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(db.Session{}, sql.ErrNoRows)
+					store.EXPECT().BlockSessionFamily(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusNotFound, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, request *http.Request, maker token.Maker) {
+					addAuthHeader(t, request, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name:      "InvalidID",
+			sessionID: "invalid",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetSession(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, request *http.Request, maker token.Maker) {
+					addAuthHeader(t, request, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			url := fmt.Sprintf("/api/users/sessions/%s", tc.sessionID)
+			req, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
+
+func TestRevokeOtherSessions(t *testing.T) {
+	user, _ := createRandomUser(t)
+	current := randomSession(user.Username)
+	current.FamilyID = uuid.New()
+
+	testCases := []struct {
+		name string
+		testCaseBase
+	}{
+		{
+			name: "OK",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetSession(gomock.Any(), gomock.Eq(current.ID)).Times(1).Return(current, nil)
+					store.EXPECT().
+						BlockOtherUserSessions(gomock.Any(), gomock.Eq(db.BlockOtherUserSessionsParams{
+							Username: user.Username,
+							FamilyID: current.FamilyID,
+						})).
+						Times(1).
+						Return(nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, request *http.Request, maker token.Maker) {
+					addSessionAuthHeader(t, request, maker, user.Username, current.ID)
+				},
+			},
+		},
+		{
+			name: "InternalError",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetSession(gomock.Any(), gomock.Eq(current.ID)).Times(1).Return(current, nil)
+					store.EXPECT().BlockOtherUserSessions(gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrConnDone)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, request *http.Request, maker token.Maker) {
+					addSessionAuthHeader(t, request, maker, user.Username, current.ID)
+				},
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodDelete, "/api/users/sessions", nil)
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/escalopa/gobank/api/handlers/response"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/gin-gonic/gin"
+)
+
+type statementEntryResponse struct {
+	entryResponse
+	RunningBalance int64 `json:"running_balance"`
+}
+
+type statementResponse struct {
+	AccountID      int64                    `json:"account_id"`
+	Currency       string                   `json:"currency"`
+	From           time.Time                `json:"from"`
+	To             time.Time                `json:"to"`
+	OpeningBalance int64                    `json:"opening_balance"`
+	ClosingBalance int64                    `json:"closing_balance"`
+	Entries        []statementEntryResponse `json:"entries"`
+}
+
+type getStatementUri struct {
+	AccountID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type getStatementQuery struct {
+	From time.Time `form:"from" binding:"required"`
+	To   time.Time `form:"to"`
+}
+
+// GetStatement godoc
+//
+//	@Summary		gets the statement of an account for a period
+//	@Description	gets the opening balance, every entry with the running balance and the closing balance of the period, to defaults to now
+//	@Tags			accounts
+//	@Produce		json
+//	@Param			id				path		int64	true	"Account ID"
+//	@Param			from			query		string	true	"Start of the period (inclusive), RFC3339"
+//	@Param			to				query		string	false	"End of the period (exclusive), RFC3339"
+//	@Success		200				{object}	response.JSON{data=statementResponse}
+//	@Failure		400,401,404,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/accounts/{id}/statement [get]
+func (s *GinServer) getStatement(ctx *gin.Context) {
+	var uri getStatementUri
+	if err := parseUri(ctx, &uri); err != nil {
+		return
+	}
+
+	var query getStatementQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.Err(err))
+		return
+	}
+
+	if query.To.IsZero() {
+		query.To = time.Now()
+	}
+
+	if !query.To.After(query.From) {
+		ctx.JSON(http.StatusBadRequest, response.Err(errors.New("to must be after from")))
+		return
+	}
+
+	account, isValid := s.isValidAccount(ctx, uri.AccountID)
+	if !isValid {
+		return
+	}
+
+	if !isUserAccountOwner(ctx, account) {
+		ctx.JSON(http.StatusUnauthorized, response.Err(ErrNotAccountOwner))
+		return
+	}
+
+	statement, err := s.db.AccountStatementTx(ctx, db.AccountStatementParam{
+		AccountID: account.ID,
+		From:      query.From,
+		To:        query.To,
+	})
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Success(mapStatementToResponse(account, query.From, query.To, statement)))
+}
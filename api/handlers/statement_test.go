@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	mockdb "github.com/escalopa/gobank/db/mock"
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/token"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetStatement(t *testing.T) {
+	user, _ := createRandomUser(t)
+	other, _ := createRandomUser(t)
+	account := createRandomAccount(user.Username)
+
+	from := time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	statement := db.AccountStatement{
+		OpeningBalance: 100,
+		ClosingBalance: 70,
+		Entries: []db.StatementEntry{
+			{Entry: db.Entry{ID: 1, AccountID: account.ID, Amount: -50, Type: db.EntryTypeWithdrawal, BalanceAfter: 50}, RunningBalance: 50},
+			{Entry: db.Entry{ID: 2, AccountID: account.ID, Amount: 20, Type: db.EntryTypeDeposit, BalanceAfter: 70}, RunningBalance: 70},
+		},
+	}
+
+	testCases := []struct {
+		name  string
+		query url.Values
+		testCaseBase
+	}{
+		{
+			name:  "OK",
+			query: url.Values{"from": {from.Format(time.RFC3339)}, "to": {to.Format(time.RFC3339)}},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+					store.EXPECT().
+						AccountStatementTx(gomock.Any(), gomock.Eq(db.AccountStatementParam{
+							AccountID: account.ID,
+							From:      from,
+							To:        to,
+						})).
+						Times(1).
+						Return(statement, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+					requireBodyMatchStatement(t, recorder.Body, statement)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name:  "BadRequest-MissingFrom",
+			query: url.Values{"to": {to.Format(time.RFC3339)}},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().AccountStatementTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name:  "BadRequest-InvertedPeriod",
+			query: url.Values{"from": {to.Format(time.RFC3339)}, "to": {from.Format(time.RFC3339)}},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().AccountStatementTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name:  "Unauthorized",
+			query: url.Values{"from": {from.Format(time.RFC3339)}},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+					store.EXPECT().AccountStatementTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, other.Username)
+				},
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			url := fmt.Sprintf("/api/accounts/%d/statement?%s", account.ID, tc.query.Encode())
+
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
+
+func requireBodyMatchStatement(t *testing.T, b *bytes.Buffer, statement db.AccountStatement) {
+	data, err := io.ReadAll(b)
+	require.NoError(t, err)
+
+	var res struct {
+		Data statementResponse `json:"data"`
+	}
+	err = json.Unmarshal(data, &res)
+	require.NoError(t, err)
+
+	require.Equal(t, statement.OpeningBalance, res.Data.OpeningBalance)
+	require.Equal(t, statement.ClosingBalance, res.Data.ClosingBalance)
+	require.Len(t, res.Data.Entries, len(statement.Entries))
+	for i, entry := range statement.Entries {
+		require.Equal(t, entry.ID, res.Data.Entries[i].ID)
+		require.Equal(t, entry.RunningBalance, res.Data.Entries[i].RunningBalance)
+	}
+}
@@ -6,8 +6,11 @@ import (
 	"time"
 
 	"github.com/escalopa/gobank/api/handlers/response"
+	"github.com/escalopa/gobank/session"
+	"github.com/escalopa/gobank/token"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 type renewAccessTokenReq struct {
@@ -15,19 +18,23 @@ type renewAccessTokenReq struct {
 }
 
 type renewAccessTokenRes struct {
-	AccessToken          string    `json:"access_token"`
-	AccessTokenExpiresAt time.Time `json:"access_expires_at"`
+	// SessionID is the session of the new refresh token
+	SessionID             uuid.UUID `json:"session_id"`
+	AccessToken           string    `json:"access_token"`
+	AccessTokenExpiresAt  time.Time `json:"access_expires_at"`
+	RefreshToken          string    `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_expires_at"`
 }
 
 // RenewAccessToken godoc
 //
 //	@Summary		renews an access token
-//	@Description	renews an access token
+//	@Description	renews an access token and rotates the refresh token, the new refresh token must be used for the next renewal, renewing a refresh token that was already renewed blocks every session created from the same login
 //	@Tags			users
 //	@Produce		json
-//	@Param			body	body		renewAccessTokenReq	true	"Refresh token"
-//	@Success		200		{object}	response.JSON{data=renewAccessTokenRes}
-//	@Failure		400,500	{object}	response.JSON{}
+//	@Param			body			body		renewAccessTokenReq	true	"Refresh token"
+//	@Success		200				{object}	response.JSON{data=renewAccessTokenRes}
+//	@Failure		400,401,404,500	{object}	response.JSON{}
 //	@Router			/users/renew [post]
 func (s *GinServer) renewAccessToken(ctx *gin.Context) {
 	var req renewAccessTokenReq
@@ -35,50 +42,49 @@ func (s *GinServer) renewAccessToken(ctx *gin.Context) {
 		return
 	}
 
-	refreshPayload, err := s.tm.VerifyToken(req.RefreshToken)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, response.Err(err))
-		return
-	}
+	renewal, err := session.Renew(ctx, s.db, s.tm, req.RefreshToken, session.Client{
+		UserAgent: ctx.Request.UserAgent(),
+		ClientIP:  ctx.ClientIP(),
+	})
 
-	session, err := s.db.GetSession(ctx, refreshPayload.ID)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			ctx.JSON(http.StatusNotFound, response.Err(err))
 			return
 		}
-
+		if session.IsUnauthorized(err) {
+			ctx.JSON(http.StatusUnauthorized, response.Err(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, response.Err(err))
 		return
 	}
 
-	if session.IsBlocked {
-		ctx.JSON(http.StatusUnauthorized, response.Err(ErrBlockedRefreshToken))
-		return
-	}
-
-	if time.Now().After(session.ExpiresAt) {
-		ctx.JSON(http.StatusUnauthorized, response.Err(ErrExpiredRefreshToken))
-		return
-	}
-
-	if session.Username != refreshPayload.Username {
-		ctx.JSON(http.StatusUnauthorized, response.Err(ErrMismatchedRefreshTokens))
-		return
-	}
-
-	if session.RefreshToken != req.RefreshToken {
-		ctx.JSON(http.StatusUnauthorized, response.Err(ErrMismatchedRefreshTokens))
-		return
+	res := renewAccessTokenRes{
+		SessionID:             renewal.SessionID,
+		AccessToken:           renewal.AccessToken,
+		AccessTokenExpiresAt:  renewal.AccessPayload.ExpireAt,
+		RefreshToken:          renewal.RefreshToken,
+		RefreshTokenExpiresAt: renewal.RefreshPayload.ExpireAt,
 	}
+	ctx.JSON(http.StatusAccepted, response.JSON{Data: res})
+}
 
-	// Generate New Access Token for User
-	accessToken, accessPayload, err := s.tm.CreateToken(session.Username)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+// GetTokenKeys godoc
+//
+//	@Summary		gets the public keys of the tokens
+//	@Description	gets the JSON Web Key Set that verifies the tokens so that other services can verify them themselves, retired keys are left out, 404 when tokens are encrypted with a symmetric key
+//	@Tags			users
+//	@Produce		json
+//	@Success		200	{object}	token.JWKS
+//	@Failure		404	{object}	response.JSON{}
+//	@Router			/.well-known/jwks.json [get]
+func (s *GinServer) getTokenKeys(ctx *gin.Context) {
+	maker, ok := s.tm.(token.PublicKeyMaker)
+	if !ok {
+		ctx.JSON(http.StatusNotFound, response.Err(ErrNoPublicKeys))
 		return
 	}
 
-	res := renewAccessTokenRes{AccessToken: accessToken, AccessTokenExpiresAt: accessPayload.ExpireAt}
-	ctx.JSON(http.StatusAccepted, response.JSON{Data: res})
+	ctx.JSON(http.StatusOK, maker.PublicKeys())
 }
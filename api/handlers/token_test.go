@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	mockdb "github.com/escalopa/gobank/db/mock"
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/token"
+	"github.com/escalopa/gobank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenewAccessToken(t *testing.T) {
+	user, _ := createRandomUser(t)
+
+	// Same key as newTestServer, so that the server accepts the refresh token
+	maker, err := token.NewPasetoMaker("12345678901234567890123456789012")
+	require.NoError(t, err)
+
+	refreshToken, refreshPayload, err := maker.CreateRefreshToken(user.Username, string(db.UserRoleCustomer))
+	require.NoError(t, err)
+
+	session := db.Session{
+		ID:           refreshPayload.ID,
+		Username:     user.Username,
+		RefreshToken: refreshToken,
+		ExpiresAt:    refreshPayload.ExpireAt,
+		FamilyID:     refreshPayload.ID,
+	}
+
+	noAuth := func(t *testing.T, req *http.Request, maker token.Maker) {}
+
+	testCases := []struct {
+		name string
+		testCaseBase
+	}{
+		{
+			name: "OK",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+					store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+					store.EXPECT().
+						RenewSessionTx(gomock.Any(), gomock.Any()).
+						Times(1).
+						DoAndReturn(func(_ interface{}, arg db.RenewSessionTxParam) (db.RenewSessionTxResult, error) {
+							require.Equal(t, session.ID, arg.SessionID)
+							require.Equal(t, user.Username, arg.Session.Username)
+							require.NotEqual(t, refreshToken, arg.Session.RefreshToken)
+
+							created := db.Session{ID: arg.Session.ID, Username: arg.Session.Username, FamilyID: session.FamilyID}
+							return db.RenewSessionTxResult{Session: created, FamilyID: session.FamilyID}, nil
+						})
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusAccepted, recorder.Code)
+
+					var res struct {
+						Data renewAccessTokenRes `json:"data"`
+					}
+					require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+					require.NotEmpty(t, res.Data.AccessToken)
+					require.NotEqual(t, refreshToken, res.Data.RefreshToken)
+					require.NotEqual(t, session.ID, res.Data.SessionID)
+					require.True(t, res.Data.RefreshTokenExpiresAt.After(res.Data.AccessTokenExpiresAt))
+
+					payload, err := maker.VerifyToken(res.Data.RefreshToken)
+					require.NoError(t, err)
+					require.Equal(t, res.Data.SessionID, payload.ID)
+
+					// The new access token belongs to the new session
+					payload, err = maker.VerifyToken(res.Data.AccessToken)
+					require.NoError(t, err)
+					require.Equal(t, res.Data.SessionID, payload.SessionID)
+				},
+				setupAuth: noAuth,
+			},
+		},
+		{
+			name: "Reused",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+					store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+					store.EXPECT().
+						RenewSessionTx(gomock.Any(), gomock.Any()).
+						Times(1).
+						Return(db.RenewSessionTxResult{FamilyID: session.FamilyID, Reused: true}, db.ErrRefreshTokenReused)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				},
+				setupAuth: noAuth,
+			},
+		},
+		{
+			name: "Blocked",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					blocked := session
+					blocked.IsBlocked = true
+					store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(blocked, nil)
+					store.EXPECT().RenewSessionTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				},
+				setupAuth: noAuth,
+			},
+		},
+		{
+			name: "MismatchedToken",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					other := session
+					other.RefreshToken = "other"
+					store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(other, nil)
+					store.EXPECT().RenewSessionTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				},
+				setupAuth: noAuth,
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			body, err := json.Marshal(renewAccessTokenReq{RefreshToken: refreshToken})
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, "/api/users/renew", bytes.NewReader(body))
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
+
+func TestGetTokenKeys(t *testing.T) {
+	// Tokens of the test server are encrypted with a symmetric key
+	server := newTestServer(t, nil)
+	recorder := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, "/api/.well-known/jwks.json", nil)
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusNotFound, recorder.Code)
+
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	err = os.WriteFile(filepath.Join(dir, "2023-01.pem"), pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600)
+	require.NoError(t, err)
+
+	config := util.NewConfig()
+	config.Set("TOKEN_KEYRING_DIR", dir)
+	config.Set("TOKEN_SIGNING_KEY_ID", "2023-01")
+	server, err = NewServer(config, nil)
+	require.NoError(t, err)
+
+	recorder = httptest.NewRecorder()
+	server.router.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var jwks token.JWKS
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&jwks))
+	require.Len(t, jwks.Keys, 1)
+	require.Equal(t, "2023-01", jwks.Keys[0].KeyID)
+	require.Equal(t, "EdDSA", jwks.Keys[0].Algorithm)
+}
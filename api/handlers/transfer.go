@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"database/sql"
 	"errors"
 	"net/http"
 	"time"
@@ -8,18 +9,37 @@ import (
 	"github.com/escalopa/gobank/api/handlers/response"
 
 	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/fx"
+	"github.com/escalopa/gobank/util"
 	"github.com/gin-gonic/gin"
 )
 
 type transferResponse struct {
-	ID          int64      `json:"id"`
-	FromAccount db.Account `json:"from_account"`
-	FromEntry   db.Entry   `json:"from_entry"`
-	ToAccountID int64      `json:"to_account_id"`
-	Amount      int64      `json:"amount"`
-	CreatedAt   time.Time  `json:"created_at"`
+	ID            int64      `json:"id"`
+	FromAccountID int64      `json:"from_account_id"`
+	FromAccount   db.Account `json:"from_account"`
+	FromEntry     db.Entry   `json:"from_entry"`
+	ToAccountID   int64      `json:"to_account_id"`
+	Amount        int64      `json:"amount"`
+	ToAmount      int64      `json:"to_amount"`
+	FxRate        float64    `json:"fx_rate"`
+	FxRateAt      time.Time  `json:"fx_rate_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+	// Status is pending_review while the transfer waits for an admin, no money is moved until it is approved
+	Status db.TransferStatus `json:"status"`
+	// Fee is debited from the from account on top of Amount, in its currency
+	Fee int64 `json:"fee"`
+	// FeeEntry is the debit of the fee, omitted when it isn't charged yet
+	FeeEntry *db.Entry `json:"fee_entry,omitempty"`
+	// ReversalOf is the transfer reversed by this one, 0 for other transfers
+	ReversalOf int64 `json:"reversal_of,omitempty"`
+	// ReversedAmount is the part of Amount given back to the sender so far
+	ReversedAmount int64      `json:"reversed_amount"`
+	ReversedAt     *time.Time `json:"reversed_at,omitempty"`
 }
 
+const idempotencyKeyHeader = "Idempotency-Key"
+
 type createTransferReq struct {
 	FromAccountID int64 `json:"from_account_id" binding:"required,min=1"`
 	ToAccountID   int64 `json:"to_account_id" binding:"required,min=1"`
@@ -29,13 +49,17 @@ type createTransferReq struct {
 // CreateTransfer godoc
 //
 //	@Summary		creates a new transfer between two accounts
-//	@Description	creates a new transfer between two accounts
+//	@Description	creates a new transfer between two accounts, the amount is converted when the currencies differ.
+//	@Description	The fee of the fee schedule of the from account is debited on top of the amount, see GET /transfers/quote.
+//	@Description	A transfer breaching a limit of the account fails with 422 and the limit, with its remaining allowance, in data.
+//	@Description	A transfer denied by a risk rule fails with 403 and the rule in data, one put into review by a rule is accepted with 202 and moves no money until an admin approves it
 //	@Tags			transfers
 //	@Accept			json
 //	@Produce		json
-//	@Param			body	body		createTransferReq	true	"Transfer to create"
-//	@Success		200		{object}	response.JSON{data=transferResponse}
-//	@Failure		400,500	{object}	response.JSON{}
+//	@Param			body				body		createTransferReq	true	"Transfer to create"
+//	@Param			Idempotency-Key		header		string				false	"Replaying a key returns the original transfer"
+//	@Success		200,202				{object}	response.JSON{data=transferResponse}
+//	@Failure		400,403,409,422,500	{object}	response.JSON{}
 //	@Security		bearerAuth
 //	@Router			/transfers [post]
 func (s *GinServer) createTransfer(ctx *gin.Context) {
@@ -49,7 +73,6 @@ func (s *GinServer) createTransfer(ctx *gin.Context) {
 		return
 	}
 
-	_ = to
 	if !isUserAccountOwner(ctx, fromAccount) {
 		ctx.JSON(http.StatusUnauthorized, ErrNotAccountOwner)
 		return
@@ -61,14 +84,55 @@ func (s *GinServer) createTransfer(ctx *gin.Context) {
 		Amount:        req.Amount,
 	}
 
+	rate, isValid := s.transferRate(ctx, fromAccount, to)
+	if !isValid {
+		return
+	}
+	arg.Rate = rate
+
+	if key := ctx.GetHeader(idempotencyKeyHeader); key != "" {
+		arg.Idempotency = &db.IdempotencyParam{
+			Key:       key,
+			Username:  fromAccount.Owner,
+			Retention: s.idempotencyRetention,
+		}
+	}
+
 	result, err := s.db.TransferTx(ctx, arg)
 
 	if err != nil {
+		var limitErr *db.TransferLimitError
+		if errors.As(err, &limitErr) {
+			ctx.JSON(http.StatusUnprocessableEntity, response.ErrWithData(err, limitErr))
+			return
+		}
+
+		var deniedErr *db.RiskDeniedError
+		if errors.As(err, &deniedErr) {
+			ctx.JSON(http.StatusForbidden, response.ErrWithData(err, deniedErr))
+			return
+		}
+
+		switch err {
+		case db.ErrIdempotencyKeyReused:
+			ctx.JSON(http.StatusConflict, response.Err(err))
+			return
+		case db.ErrInsufficientFunds:
+			ctx.JSON(http.StatusUnprocessableEntity, response.Err(err))
+			return
+		case db.ErrConvertedAmountTooSmall, db.ErrAccountFrozen, db.ErrAccountClosed:
+			ctx.JSON(http.StatusBadRequest, response.Err(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, response.Err(err))
 		return
 	}
 
 	res := fromTransferTxToTransferResponse(result)
+	if result.Transfer.Status == db.TransferStatusPendingReview {
+		ctx.JSON(http.StatusAccepted, res)
+		return
+	}
 	ctx.JSON(http.StatusOK, res)
 
 }
@@ -89,12 +153,6 @@ func (s *GinServer) validateTransfer(ctx *gin.Context, accountID1, accountID2 in
 		return
 	}
 
-	isValid = from.Currency == to.Currency
-	if !isValid {
-		ctx.JSON(http.StatusBadRequest, response.Err(ErrCurrencyMismatch(from.Currency, to.Currency)))
-		return
-	}
-
 	if from.IsDeleted {
 		isValid = false
 		ctx.JSON(http.StatusBadRequest, response.Err(ErrAccountDeleted(from.ID)))
@@ -107,44 +165,189 @@ func (s *GinServer) validateTransfer(ctx *gin.Context, accountID1, accountID2 in
 		return
 	}
 
+	// TransferTx checks the statuses again, in case they change before the transfer is made
+	if err := from.CanDebit(); err != nil {
+		isValid = false
+		ctx.JSON(http.StatusBadRequest, response.Err(err))
+		return
+	}
+
+	if err := to.CanCredit(); err != nil {
+		isValid = false
+		ctx.JSON(http.StatusBadRequest, response.Err(err))
+		return
+	}
+
 	return
 }
 
+// transferRate gets the rate converting the currency of from into the one of to, nil when they are the same
+func (s *GinServer) transferRate(ctx *gin.Context, from, to db.Account) (*fx.Rate, bool) {
+	if from.Currency == to.Currency {
+		return nil, true
+	}
+
+	rate, err := s.rates.Rate(from.Currency, to.Currency)
+	if err != nil {
+		if errors.Is(err, fx.ErrRateNotFound) {
+			ctx.JSON(http.StatusBadRequest, response.Err(err))
+			return nil, false
+		}
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return nil, false
+	}
+	return &rate, true
+}
+
+type quoteTransferQuery struct {
+	FromAccountID int64 `form:"from_account_id" binding:"required,min=1"`
+	ToAccountID   int64 `form:"to_account_id" binding:"required,min=1"`
+	Amount        int64 `form:"amount" binding:"required,gte=1"`
+}
+
+type transferQuoteResponse struct {
+	FromAccountID int64 `json:"from_account_id"`
+	ToAccountID   int64 `json:"to_account_id"`
+	// Currency is the one of the from account, in which Amount, Fee and Total are
+	Currency string `json:"currency"`
+	Amount   int64  `json:"amount"`
+	Fee      int64  `json:"fee"`
+	// Total is debited from the from account, Amount and Fee
+	Total int64 `json:"total"`
+	// ToAmount is credited to the to account, in ToCurrency
+	ToCurrency string    `json:"to_currency"`
+	ToAmount   int64     `json:"to_amount"`
+	FxRate     float64   `json:"fx_rate"`
+	FxRateAt   time.Time `json:"fx_rate_at"`
+}
+
+// QuoteTransfer godoc
+//
+//	@Summary		previews a transfer between two accounts
+//	@Description	gets the fee and the converted amount of a transfer without making it, the rate may change before the transfer is created
+//	@Tags			transfers
+//	@Accept			json
+//	@Produce		json
+//	@Param			from_account_id	query		int64	true	"Account to debit"
+//	@Param			to_account_id	query		int64	true	"Account to credit"
+//	@Param			amount			query		int64	true	"Amount to transfer, in the currency of the from account"
+//	@Success		200				{object}	response.JSON{data=transferQuoteResponse}
+//	@Failure		400,401,404,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/transfers/quote [get]
+func (s *GinServer) quoteTransfer(ctx *gin.Context) {
+	var query quoteTransferQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.Err(err))
+		return
+	}
+
+	from, to, isValid := s.validateTransfer(ctx, query.FromAccountID, query.ToAccountID)
+	if !isValid {
+		return
+	}
+
+	if !isUserAccountOwner(ctx, from) {
+		ctx.JSON(http.StatusUnauthorized, response.Err(ErrNotAccountOwner))
+		return
+	}
+
+	rate, isValid := s.transferRate(ctx, from, to)
+	if !isValid {
+		return
+	}
+	if rate == nil {
+		rate = &fx.Rate{From: from.Currency, To: to.Currency, Value: fx.RateScale, UpdatedAt: time.Now()}
+	}
+
+	toAmount := rate.Convert(query.Amount)
+	if toAmount < 1 {
+		ctx.JSON(http.StatusBadRequest, response.Err(db.ErrConvertedAmountTooSmall))
+		return
+	}
+
+	fee, err := s.db.QuoteTransferFee(ctx, from, to, query.Amount)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Success(transferQuoteResponse{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Currency:      from.Currency,
+		Amount:        query.Amount,
+		Fee:           fee.Amount,
+		Total:         query.Amount + fee.Amount,
+		ToCurrency:    to.Currency,
+		ToAmount:      toAmount,
+		FxRate:        rate.Float64(),
+		FxRateAt:      rate.UpdatedAt,
+	}))
+}
+
 type getTransferReq struct {
 	AccountID int64 `uri:"id" binding:"required,min=1"`
 }
 
+type searchTransfersQuery struct {
+	Direction      string    `form:"direction" binding:"omitempty,oneof=in out"`
+	CounterpartyID int64     `form:"counterparty_id" binding:"omitempty,min=1"`
+	MinAmount      int64     `form:"min_amount" binding:"omitempty,min=1"`
+	MaxAmount      int64     `form:"max_amount" binding:"omitempty,min=1"`
+	From           time.Time `form:"from"`
+	To             time.Time `form:"to"`
+	Sort           string    `form:"sort" binding:"omitempty,oneof=date amount"`
+	Order          string    `form:"order" binding:"omitempty,oneof=asc desc"`
+}
+
+type listTransfersResponse struct {
+	Transfers []*transferResponse `json:"transfers"`
+	// NextCursor is empty on the last page
+	NextCursor string `json:"next_cursor"`
+}
+
 // GetTransfers godoc
 //
-//	@Summary		gets all transfers for an account
-//	@Description	gets all transfers for an account
+//	@Summary		searches the transfers of an account
+//	@Description	gets a page of the transfers of an account matching the filters, pass next_cursor as cursor to get the next page
 //	@Tags			transfers
 //	@Accept			json
 //	@Produce		json
-//	@Param			id			path		int64	true	"Account ID"
-//	@Param			page_id		query		int32	true	"Page ID"
-//	@Param			page_size	query		int32	true	"Page Size"
-//	@Success		200			{object}	response.JSON{data=transferResponse}
-//	@Failure		400,500		{object}	response.JSON{}
+//	@Param			id				path		int64	true	"Account ID"
+//	@Param			direction		query		string	false	"Incoming or outgoing transfers only"	Enums(in, out)
+//	@Param			counterparty_id	query		int64	false	"Other account of the transfer"
+//	@Param			min_amount		query		int64	false	"Minimum amount (inclusive)"
+//	@Param			max_amount		query		int64	false	"Maximum amount (inclusive)"
+//	@Param			from			query		string	false	"Created at or after, RFC3339"
+//	@Param			to				query		string	false	"Created before, RFC3339"
+//	@Param			sort			query		string	false	"Sort by, defaults to date"		Enums(date, amount)
+//	@Param			order			query		string	false	"Sort order, defaults to asc"	Enums(asc, desc)
+//	@Param			page_size		query		int32	false	"Page Size, defaults to and is capped by PAGE_SIZE_MAX"
+//	@Param			cursor			query		string	false	"next_cursor of the previous page"
+//	@Success		200				{object}	response.JSON{data=listTransfersResponse}
+//	@Failure		400,401,404,500	{object}	response.JSON{}
 //	@Security		bearerAuth
 //	@Router			/transfers/{id} [get]
 func (s *GinServer) getTransfers(ctx *gin.Context) {
 	var req getTransferReq
-	var pgQuery *paginationQuery
-	var err error
-
 	if err := parseUri(ctx, &req); err != nil {
 		return
 	}
 
-	if pgQuery, err = parsePagination(ctx); err != nil {
+	var query searchTransfersQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
 		ctx.JSON(http.StatusBadRequest, response.Err(err))
 		return
 	}
 
+	cursor, pageSize, err := s.parsePage(ctx)
+	if err != nil {
+		return
+	}
+
 	account, ok := s.isValidAccount(ctx, req.AccountID)
 	if !ok {
-		ctx.JSON(http.StatusBadRequest, response.Err(errors.New("invalid account id")))
 		return
 	}
 
@@ -153,19 +356,143 @@ func (s *GinServer) getTransfers(ctx *gin.Context) {
 		return
 	}
 
-	transfers, err := s.db.ListTransfers(ctx, db.ListTransfersParams{
-		AccountID: req.AccountID, PageSize: pgQuery.Limit, PageID: (pgQuery.Offset - 1) * pgQuery.Offset,
-	})
+	arg := db.SearchTransfersParam{
+		AccountID:      account.ID,
+		Direction:      db.TransferDirection(query.Direction),
+		CounterpartyID: sql.NullInt64{Int64: query.CounterpartyID, Valid: query.CounterpartyID != 0},
+		MinAmount:      sql.NullInt64{Int64: query.MinAmount, Valid: query.MinAmount != 0},
+		MaxAmount:      sql.NullInt64{Int64: query.MaxAmount, Valid: query.MaxAmount != 0},
+		From:           sql.NullTime{Time: query.From, Valid: !query.From.IsZero()},
+		To:             sql.NullTime{Time: query.To, Valid: !query.To.IsZero()},
+		Sort:           db.TransferSort(query.Sort),
+		Descending:     query.Order == "desc",
+		After:          cursor,
+		PageSize:       pageSize + 1,
+	}
 
+	transfers, err := s.db.SearchTransfers(ctx, arg)
 	if err != nil {
+		if errors.Is(err, db.ErrInvalidTransferSearch) {
+			ctx.JSON(http.StatusBadRequest, response.Err(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, response.Err(err))
 		return
 	}
 
-	var responsesTransfer []*transferResponse
+	res := listTransfersResponse{Transfers: []*transferResponse{}}
+	transfers, res.NextCursor = util.Paginate(transfers, pageSize, arg.NextCursor)
+
 	for _, transfer := range transfers {
-		responsesTransfer = append(responsesTransfer, mapTransferToResponse(transfer))
+		res.Transfers = append(res.Transfers, mapTransferToResponse(transfer))
+	}
+
+	ctx.JSON(http.StatusOK, response.Success(res))
+}
+
+type transferUri struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type reverseTransferReq struct {
+	// Amount is the part of the transfer to give back, all that is left when omitted
+	Amount int64 `json:"amount" binding:"omitempty,min=1"`
+}
+
+type reverseTransferResponse struct {
+	// Original is the reversed transfer, with the amount reversed so far
+	Original *transferResponse `json:"original"`
+	Reversal transferResponse  `json:"reversal"`
+}
+
+// RefundTransfer godoc
+//
+//	@Summary		refunds a transfer received by the user
+//	@Description	gives the amount, or all that is left of the transfer when omitted, back to its sender with a reversal transfer. Only the recipient can refund a transfer, within TRANSFER_REFUND_WINDOW after it was made
+//	@Tags			transfers
+//	@Accept			json
+//	@Produce		json
+//	@Param			id							path		int64				true	"Transfer ID"
+//	@Param			body						body		reverseTransferReq	false	"Part of the transfer to refund"
+//	@Success		200							{object}	response.JSON{data=reverseTransferResponse}
+//	@Failure		400,401,403,404,409,422,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/transfers/{id}/refund [post]
+func (s *GinServer) refundTransfer(ctx *gin.Context) {
+	var uri transferUri
+	if err := parseUri(ctx, &uri); err != nil {
+		return
+	}
+
+	req, err := parseReverseTransferReq(ctx)
+	if err != nil {
+		return
+	}
+
+	transfer, err := s.db.GetTransfer(ctx, uri.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, response.Err(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
+	}
+
+	to, isValid := s.isValidAccount(ctx, transfer.ToAccountID)
+	if !isValid {
+		return
+	}
+
+	if !isUserAccountOwner(ctx, to) {
+		ctx.JSON(http.StatusUnauthorized, response.Err(ErrNotAccountOwner))
+		return
+	}
+
+	if err = transfer.CanBeRefunded(time.Now(), s.refundWindow); err != nil {
+		ctx.JSON(http.StatusForbidden, response.Err(err))
+		return
+	}
+
+	s.reverseTransferTx(ctx, transfer.ID, req.Amount)
+}
+
+// parseReverseTransferReq parses the body of a reversal, which can be left out to reverse all that is left
+func parseReverseTransferReq(ctx *gin.Context) (req reverseTransferReq, err error) {
+	if ctx.Request.ContentLength == 0 {
+		return
+	}
+	err = parseBody(ctx, &req)
+	return
+}
+
+func (s *GinServer) reverseTransferTx(ctx *gin.Context, transferID, amount int64) {
+	result, err := s.db.ReverseTransferTx(ctx, db.ReverseTransferTxParam{
+		TransferID: transferID,
+		Amount:     amount,
+	})
+
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			ctx.JSON(http.StatusNotFound, response.Err(err))
+			return
+		case db.ErrTransferReversed, db.ErrTransferNotCompleted:
+			ctx.JSON(http.StatusConflict, response.Err(err))
+			return
+		case db.ErrInsufficientFunds:
+			ctx.JSON(http.StatusUnprocessableEntity, response.Err(err))
+			return
+		case db.ErrTransferIsReversal, db.ErrReversalExceedsTransfer, db.ErrConvertedAmountTooSmall, db.ErrAccountFrozen, db.ErrAccountClosed:
+			ctx.JSON(http.StatusBadRequest, response.Err(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
 	}
 
-	ctx.JSON(http.StatusOK, response.Success(responsesTransfer))
+	ctx.JSON(http.StatusOK, response.Success(reverseTransferResponse{
+		Original: mapTransferToResponse(result.Original),
+		Reversal: fromTransferTxToTransferResponse(result.Reversal),
+	}))
 }
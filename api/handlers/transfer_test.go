@@ -4,12 +4,15 @@ import (
 	"bytes"
 	"database/sql"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	mockdb "github.com/escalopa/gobank/db/mock"
 	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/fx"
 	"github.com/escalopa/gobank/token"
 	"github.com/escalopa/gobank/util"
 	"github.com/golang/mock/gomock"
@@ -63,6 +66,280 @@ func TestCreateTransfer(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:        "IdempotencyKey",
+			transferArg: arg,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().
+						TransferTx(gomock.Any(), gomock.Eq(db.TransferTxParam{
+							FromAccountID: arg.FromAccountID,
+							ToAccountID:   arg.ToAccountID,
+							Amount:        arg.Amount,
+							Idempotency: &db.IdempotencyParam{
+								Key:       "transfer-key",
+								Username:  user1.Username,
+								Retention: 24 * time.Hour,
+							},
+						})).
+						Times(1)
+
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(account1, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(account2, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user1.Username)
+					req.Header.Set(idempotencyKeyHeader, "transfer-key")
+				},
+			},
+		},
+		{
+			name:        "IdempotencyKeyReused",
+			transferArg: arg,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().
+						TransferTx(gomock.Any(), gomock.Any()).
+						Times(1).Return(db.TransferTxResult{}, db.ErrIdempotencyKeyReused)
+
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(account1, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(account2, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusConflict, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user1.Username)
+					req.Header.Set(idempotencyKeyHeader, "transfer-key")
+				},
+			},
+		},
+		{
+			name:        "InsufficientFunds",
+			transferArg: arg,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().
+						TransferTx(gomock.Any(), gomock.Any()).
+						Times(1).Return(db.TransferTxResult{}, db.ErrInsufficientFunds)
+
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(account1, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(account2, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user1.Username)
+				},
+			},
+		},
+		{
+			name:        "TransferLimitExceeded",
+			transferArg: arg,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().
+						TransferTx(gomock.Any(), gomock.Any()).
+						Times(1).Return(db.TransferTxResult{}, &db.TransferLimitError{
+						Limit:     db.TransferLimitDailyAmount,
+						Max:       100,
+						Remaining: 40,
+					})
+
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(account1, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(account2, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+
+					var res struct {
+						Data db.TransferLimitError `json:"data"`
+					}
+					require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+					require.Equal(t, db.TransferLimitDailyAmount, res.Data.Limit)
+					require.Equal(t, int64(40), res.Data.Remaining)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user1.Username)
+				},
+			},
+		},
+		{
+			name:        "PendingReview",
+			transferArg: arg,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().
+						TransferTx(gomock.Any(), gomock.Any()).
+						Times(1).Return(db.TransferTxResult{
+						Transfer:    db.Transfer{ID: 1, Amount: amount, Status: db.TransferStatusPendingReview},
+						FromAccount: account1,
+						ToAccount:   account2,
+					}, nil)
+
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(account1, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(account2, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusAccepted, recorder.Code)
+
+					var res transferResponse
+					require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+					require.Equal(t, db.TransferStatusPendingReview, res.Status)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user1.Username)
+				},
+			},
+		},
+		{
+			name:        "Fee",
+			transferArg: arg,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().
+						TransferTx(gomock.Any(), gomock.Any()).
+						Times(1).Return(db.TransferTxResult{
+						Transfer:    db.Transfer{ID: 1, Amount: amount, FeeAmount: 5, Status: db.TransferStatusCompleted},
+						FromAccount: account1,
+						ToAccount:   account2,
+						Fee:         db.TransferFee{Amount: 5, AccountID: 10},
+						FeeEntry:    db.Entry{ID: 3, AccountID: account1.ID, Amount: -5, Type: db.EntryTypeFee},
+					}, nil)
+
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(account1, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(account2, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+
+					var res transferResponse
+					require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+					require.Equal(t, int64(5), res.Fee)
+					require.NotNil(t, res.FeeEntry)
+					require.Equal(t, int64(-5), res.FeeEntry.Amount)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user1.Username)
+				},
+			},
+		},
+		{
+			name:        "RiskDenied",
+			transferArg: arg,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().
+						TransferTx(gomock.Any(), gomock.Any()).
+						Times(1).Return(db.TransferTxResult{}, &db.RiskDeniedError{Rule: "burst", Reason: "too many transfers"})
+
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(account1, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(account2, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusForbidden, recorder.Code)
+
+					var res struct {
+						Data db.RiskDeniedError `json:"data"`
+					}
+					require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+					require.Equal(t, "burst", res.Data.Rule)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user1.Username)
+				},
+			},
+		},
+		{
+			name:        "BadRequest-NoExchangeRate",
+			transferArg: arg,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().
+						TransferTx(gomock.Any(), gomock.Any()).
+						Times(0)
+
+					account2 := account2
+					account2.Currency = util.USD
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(account1, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(account2, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user1.Username)
+				},
+			},
+		},
+		{
+			name:        "BadRequest-FromAccountFrozen",
+			transferArg: arg,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().
+						TransferTx(gomock.Any(), gomock.Any()).
+						Times(0)
+
+					account1 := account1
+					account1.Status = db.AccountStatusFrozen
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(account1, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(account2, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user1.Username)
+				},
+			},
+		},
+		{
+			name:        "BadRequest-ToAccountClosed",
+			transferArg: arg,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().
+						TransferTx(gomock.Any(), gomock.Any()).
+						Times(0)
+
+					account2 := account2
+					account2.Status = db.AccountStatusClosed
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(account1, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(account2, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user1.Username)
+				},
+			},
+		},
+		{
+			name:        "BadRequest-FrozenMeanwhile",
+			transferArg: arg,
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().
+						TransferTx(gomock.Any(), gomock.Any()).
+						Times(1).Return(db.TransferTxResult{}, db.ErrAccountFrozen)
+
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(account1, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(account2, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user1.Username)
+				},
+			},
+		},
 		{
 			name: "BadRequest-Eq(IDS)",
 			transferArg: createTransferReq{
@@ -136,3 +413,514 @@ func TestCreateTransfer(t *testing.T) {
 		})
 	}
 }
+
+func TestQuoteTransfer(t *testing.T) {
+	user1, _ := createRandomUser(t)
+	user2, _ := createRandomUser(t)
+
+	account1 := createRandomAccount(user1.Username)
+	account2 := createRandomAccount(user2.Username)
+	account1.Currency, account2.Currency = util.EGP, util.EGP
+
+	testCases := []struct {
+		name  string
+		query string
+		testCaseBase
+	}{
+		{
+			name:  "OK",
+			query: fmt.Sprintf("from_account_id=%d&to_account_id=%d&amount=100", account1.ID, account2.ID),
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Return(account1, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Return(account2, nil)
+					store.EXPECT().
+						QuoteTransferFee(gomock.Any(), gomock.Eq(account1), gomock.Eq(account2), gomock.Eq(int64(100))).
+						Times(1).Return(db.TransferFee{Amount: 5, AccountID: 10}, nil)
+					store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+
+					var res struct {
+						Data transferQuoteResponse `json:"data"`
+					}
+					require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+					require.Equal(t, util.EGP, res.Data.Currency)
+					require.Equal(t, int64(100), res.Data.Amount)
+					require.Equal(t, int64(5), res.Data.Fee)
+					require.Equal(t, int64(105), res.Data.Total)
+					require.Equal(t, int64(100), res.Data.ToAmount)
+					require.Equal(t, float64(1), res.Data.FxRate)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user1.Username)
+				},
+			},
+		},
+		{
+			name:  "NotAccountOwner",
+			query: fmt.Sprintf("from_account_id=%d&to_account_id=%d&amount=100", account1.ID, account2.ID),
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Return(account1, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Return(account2, nil)
+					store.EXPECT().QuoteTransferFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user2.Username)
+				},
+			},
+		},
+		{
+			name:  "BadRequest-Binding",
+			query: fmt.Sprintf("from_account_id=%d&to_account_id=%d&amount=0", account1.ID, account2.ID),
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+					store.EXPECT().QuoteTransferFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user1.Username)
+				},
+			},
+		},
+		{
+			name:  "InternalError",
+			query: fmt.Sprintf("from_account_id=%d&to_account_id=%d&amount=100", account1.ID, account2.ID),
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Return(account1, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Return(account2, nil)
+					store.EXPECT().
+						QuoteTransferFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+						Times(1).Return(db.TransferFee{}, sql.ErrConnDone)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user1.Username)
+				},
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, "/api/transfers/quote?"+tc.query, nil)
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
+
+func TestGetTransfers(t *testing.T) {
+	user, _ := createRandomUser(t)
+	account := createRandomAccount(user.Username)
+
+	pageSize := int32(3)
+	transfers := make([]db.Transfer, pageSize+1)
+	for i := range transfers {
+		transfers[i] = db.Transfer{
+			ID:            int64(i + 1),
+			FromAccountID: account.ID,
+			ToAccountID:   util.RandomInteger(1, 1000),
+			Amount:        util.RandomMoney(),
+			CreatedAt:     time.Date(2022, time.November, 1, 0, i, 0, 0, time.UTC),
+		}
+	}
+	nextCursor := db.SearchTransfersParam{}.NextCursor(transfers[pageSize-1])
+
+	testCases := []struct {
+		name  string
+		query string
+		testCaseBase
+	}{
+		{
+			name:  "OK-NextPage",
+			query: fmt.Sprintf("page_size=%d", pageSize),
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+					store.EXPECT().
+						SearchTransfers(gomock.Any(), gomock.Eq(db.SearchTransfersParam{AccountID: account.ID, PageSize: pageSize + 1})).
+						Times(1).
+						Return(transfers, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+
+					var res struct {
+						Data listTransfersResponse `json:"data"`
+					}
+					require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+					require.Len(t, res.Data.Transfers, int(pageSize))
+					require.Equal(t, nextCursor.Encode(), res.Data.NextCursor)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name:  "OK-LastPage",
+			query: fmt.Sprintf("page_size=%d&cursor=%s", pageSize, nextCursor.Encode()),
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+					store.EXPECT().
+						SearchTransfers(gomock.Any(), gomock.Any()).
+						Times(1).
+						DoAndReturn(func(_ interface{}, arg db.SearchTransfersParam) ([]db.Transfer, error) {
+							require.True(t, nextCursor.CreatedAt.Equal(arg.After.CreatedAt))
+							require.Equal(t, nextCursor.ID, arg.After.ID)
+							require.Equal(t, nextCursor.Sort, arg.After.Sort)
+							return transfers[pageSize:], nil
+						})
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+
+					var res struct {
+						Data listTransfersResponse `json:"data"`
+					}
+					require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+					require.Len(t, res.Data.Transfers, 1)
+					require.Empty(t, res.Data.NextCursor)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name:  "PageSizeCapped",
+			query: "page_size=100000",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+					store.EXPECT().
+						SearchTransfers(gomock.Any(), gomock.Eq(db.SearchTransfersParam{AccountID: account.ID, PageSize: util.DefaultMaxPageSize + 1})).
+						Times(1).
+						Return([]db.Transfer{}, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name:  "BadRequest-InvalidCursor",
+			query: "cursor=invalid",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().SearchTransfers(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name:  "OK-Filters",
+			query: "direction=in&counterparty_id=5&min_amount=10&max_amount=100&from=2022-11-01T00:00:00Z&sort=amount&order=desc",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+					store.EXPECT().
+						SearchTransfers(gomock.Any(), gomock.Eq(db.SearchTransfersParam{
+							AccountID:      account.ID,
+							Direction:      db.TransferDirectionIn,
+							CounterpartyID: sql.NullInt64{Int64: 5, Valid: true},
+							MinAmount:      sql.NullInt64{Int64: 10, Valid: true},
+							MaxAmount:      sql.NullInt64{Int64: 100, Valid: true},
+							From:           sql.NullTime{Time: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+							Sort:           db.TransferSortAmount,
+							Descending:     true,
+							PageSize:       util.DefaultMaxPageSize + 1,
+						})).
+						Times(1).
+						Return([]db.Transfer{}, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name:  "BadRequest-Direction",
+			query: "direction=sideways",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().SearchTransfers(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name:  "BadRequest-InvalidSearch",
+			query: "min_amount=100&max_amount=10",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+					store.EXPECT().
+						SearchTransfers(gomock.Any(), gomock.Any()).
+						Times(1).
+						Return(nil, db.ErrInvalidTransferSearch)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			url := fmt.Sprintf("/api/transfers/%d?%s", account.ID, tc.query)
+
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
+
+func TestRefundTransfer(t *testing.T) {
+	sender, _ := createRandomUser(t)
+	recipient, _ := createRandomUser(t)
+
+	from := createRandomAccount(sender.Username)
+	to := createRandomAccount(recipient.Username)
+
+	transfer := db.Transfer{
+		ID:            util.RandomInteger(1, 1000),
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        100,
+		ToAmount:      100,
+		FxRate:        fx.RateScale,
+		CreatedAt:     time.Now().Add(-time.Hour),
+	}
+
+	reversed := transfer
+	reversed.ReversedAmount = 40
+	reversed.ReversedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	testCases := []struct {
+		name string
+		body *reverseTransferReq
+		testCaseBase
+	}{
+		{
+			name: "OK",
+			body: &reverseTransferReq{Amount: 40},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(to.ID)).Times(1).Return(to, nil)
+					store.EXPECT().
+						ReverseTransferTx(gomock.Any(), gomock.Eq(db.ReverseTransferTxParam{TransferID: transfer.ID, Amount: 40})).
+						Times(1).
+						Return(db.ReverseTransferTxResult{
+							Original: reversed,
+							Reversal: db.TransferTxResult{Transfer: db.Transfer{
+								ID:            transfer.ID + 1,
+								FromAccountID: to.ID,
+								ToAccountID:   from.ID,
+								Amount:        40,
+								ToAmount:      40,
+								ReversalOf:    sql.NullInt64{Int64: transfer.ID, Valid: true},
+							}},
+						}, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+
+					var res struct {
+						Data reverseTransferResponse `json:"data"`
+					}
+					require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+					require.Equal(t, int64(40), res.Data.Original.ReversedAmount)
+					require.NotNil(t, res.Data.Original.ReversedAt)
+					require.Equal(t, transfer.ID, res.Data.Reversal.ReversalOf)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, recipient.Username)
+				},
+			},
+		},
+		{
+			name: "AllThatIsLeft",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(to.ID)).Times(1).Return(to, nil)
+					store.EXPECT().
+						ReverseTransferTx(gomock.Any(), gomock.Eq(db.ReverseTransferTxParam{TransferID: transfer.ID})).
+						Times(1).
+						Return(db.ReverseTransferTxResult{Original: transfer}, nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, recipient.Username)
+				},
+			},
+		},
+		{
+			name: "NotRecipient",
+			body: &reverseTransferReq{Amount: 40},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(to.ID)).Times(1).Return(to, nil)
+					store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, sender.Username)
+				},
+			},
+		},
+		{
+			name: "WindowClosed",
+			body: &reverseTransferReq{Amount: 40},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					old := transfer
+					old.CreatedAt = time.Now().Add(-30 * 24 * time.Hour)
+
+					store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(old, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(to.ID)).Times(1).Return(to, nil)
+					store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusForbidden, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, recipient.Username)
+				},
+			},
+		},
+		{
+			name: "AlreadyReversed",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(to.ID)).Times(1).Return(to, nil)
+					store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Any()).Times(1).
+						Return(db.ReverseTransferTxResult{}, db.ErrTransferReversed)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusConflict, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, recipient.Username)
+				},
+			},
+		},
+		{
+			name: "ExceedsTransfer",
+			body: &reverseTransferReq{Amount: 101},
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(to.ID)).Times(1).Return(to, nil)
+					store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Any()).Times(1).
+						Return(db.ReverseTransferTxResult{}, db.ErrReversalExceedsTransfer)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, recipient.Username)
+				},
+			},
+		},
+		{
+			name: "InsufficientFunds",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(to.ID)).Times(1).Return(to, nil)
+					store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Any()).Times(1).
+						Return(db.ReverseTransferTxResult{}, db.ErrInsufficientFunds)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, recipient.Username)
+				},
+			},
+		},
+		{
+			name: "NotFound",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(db.Transfer{}, sql.ErrNoRows)
+					store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusNotFound, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, req *http.Request, maker token.Maker) {
+					addAuthHeader(t, req, maker, authorizationTypeBearer, recipient.Username)
+				},
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			var body []byte
+			if tc.body != nil {
+				var err error
+				body, err = json.Marshal(tc.body)
+				require.NoError(t, err)
+			}
+
+			url := fmt.Sprintf("/api/transfers/%d/refund", transfer.ID)
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
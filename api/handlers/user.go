@@ -20,6 +20,7 @@ type userResponse struct {
 	Username          string    `json:"username"`
 	FullName          string    `json:"full_name"`
 	Email             string    `json:"email"`
+	Role              string    `json:"role"`
 	CreatedAt         time.Time `json:"created_at"`
 	PasswordChangedAt time.Time `json:"password_changed_at"`
 }
@@ -61,6 +62,11 @@ func (s *GinServer) loginUser(ctx *gin.Context) {
 		return
 	}
 
+	if user.IsDeleted {
+		ctx.JSON(http.StatusNotFound, response.Err(ErrUserDeleted))
+		return
+	}
+
 	// Check User's Password
 	err := util.CheckHashedPassword(user.HashedPassword, req.Password)
 	if err != nil {
@@ -68,21 +74,22 @@ func (s *GinServer) loginUser(ctx *gin.Context) {
 		return
 	}
 
-	// Generate New Access Token for User
-	accessToken, accessPayload, err := s.tm.CreateToken(user.Username)
+	// Generate New Refresh Token for User
+	refreshToken, refreshPayload, err := s.tm.CreateRefreshToken(user.Username, string(user.Role))
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, response.Err(err))
 		return
 	}
 
-	// Generate New Refresh Token for User
-	refreshToken, refreshPayload, err := s.tm.CreateRefreshToken(user.Username)
+	// Generate New Access Token for User, for the session of the refresh token
+	accessToken, accessPayload, err := s.tm.CreateToken(user.Username, string(user.Role), refreshPayload.ID)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, response.Err(err))
 		return
 	}
 
 	// Create new session for User
+	setActor(ctx, user.Username, refreshPayload.ID)
 	session, err := s.db.CreateSession(ctx, db.CreateSessionParams{
 		ID:           refreshPayload.ID,
 		Username:     req.Username,
@@ -90,6 +97,8 @@ func (s *GinServer) loginUser(ctx *gin.Context) {
 		UserAgent:    ctx.Request.UserAgent(),
 		ClientIp:     ctx.ClientIP(),
 		ExpiresAt:    refreshPayload.ExpireAt,
+		// Every login starts a new family of sessions
+		FamilyID: refreshPayload.ID,
 	})
 
 	if err != nil {
@@ -108,6 +117,36 @@ func (s *GinServer) loginUser(ctx *gin.Context) {
 	ctx.JSON(http.StatusAccepted, res)
 }
 
+// Logout godoc
+//
+//	@Summary		Logout user by blocking the session
+//	@Description	Logout user by blocking the session of the access token, along with the sessions renewed from the same login.
+//	@Description	Use DELETE /users/sessions/{id} to revoke another session
+//	@Tags			users
+//	@Produce		json
+//	@Success		200		{object}	response.JSON{}
+//	@Failure		401,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/users/logout [post]
+func (s *GinServer) logoutUser(ctx *gin.Context) {
+	payload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	// authMiddleware already checked that the session exists
+	session, err := s.db.GetSession(ctx, payload.SessionID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
+	}
+
+	err = s.db.BlockSessionFamily(ctx, session.FamilyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Success(session.ID))
+}
+
 type createUserReq struct {
 	Username        string `json:"username"  binding:"required,min=6,max=16,alphanum"`
 	FullName        string `json:"full_name" binding:"required"`
@@ -139,6 +178,7 @@ func (s *GinServer) register(ctx *gin.Context) {
 		return
 	}
 
+	setActor(ctx, req.Username, uuid.Nil)
 	user, err := s.db.CreateUser(ctx, db.CreateUserParams{
 		Username:       req.Username,
 		HashedPassword: hashPassword,
@@ -253,3 +293,29 @@ func (s *GinServer) updateUser(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, response.Success(mapUserToResponse(&dbUser)))
 }
+
+// DeleteUser godoc
+//
+//	@Summary		Delete current user
+//	@Description	Delete current user, all accounts must have zero balance
+//	@Tags			users
+//	@Produce		json
+//	@Success		200		{object}	response.JSON{}
+//	@Failure		400,500	{object}	response.JSON{}
+//	@Security		bearerAuth
+//	@Router			/users [delete]
+func (s *GinServer) deleteUser(ctx *gin.Context) {
+	payload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	err := s.db.DeleteUserTx(ctx, payload.Username)
+	if err != nil {
+		if err == db.ErrNonZeroBalance {
+			ctx.JSON(http.StatusBadRequest, response.Err(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, response.Err(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Success(payload.Username))
+}
@@ -239,3 +239,167 @@ func TestLoginUser(t *testing.T) {
 
 	_ = testCases
 }
+
+func TestLogoutUser(t *testing.T) {
+	user, _ := createRandomUser(t)
+	session := randomSession(user.Username)
+
+	testCases := []struct {
+		name string
+		testCaseBase
+	}{
+		{
+			name: "OK",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().
+						GetSession(gomock.Any(), gomock.Eq(session.ID)).
+						Times(1).
+						Return(session, nil)
+					store.EXPECT().
+						BlockSessionFamily(gomock.Any(), gomock.Eq(session.FamilyID)).
+						Times(1).
+						Return(nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, request *http.Request, maker token.Maker) {
+					addSessionAuthHeader(t, request, maker, user.Username, session.ID)
+				},
+			},
+		},
+		{
+			name: "InternalError",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().
+						GetSession(gomock.Any(), gomock.Eq(session.ID)).
+						Times(1).
+						Return(session, nil)
+					store.EXPECT().
+						BlockSessionFamily(gomock.Any(), gomock.Any()).
+						Times(1).
+						Return(sql.ErrConnDone)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, request *http.Request, maker token.Maker) {
+					addSessionAuthHeader(t, request, maker, user.Username, session.ID)
+				},
+			},
+		},
+		{
+			name: "NoAuthorization",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().
+						GetSession(gomock.Any(), gomock.Any()).
+						Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, request *http.Request, maker token.Maker) {},
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, "/api/users/logout", nil)
+			require.NoError(t, err)
+
+			runServerTest(t, tc, req)
+		})
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	user, _ := createRandomUser(t)
+
+	testCases := []struct {
+		name string
+		testCaseBase
+	}{
+		{
+			name: "OK",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().
+						DeleteUserTx(gomock.Any(), gomock.Eq(user.Username)).
+						Times(1).
+						Return(nil)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusOK, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, request *http.Request, maker token.Maker) {
+					addAuthHeader(t, request, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name: "NonZeroBalance",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().
+						DeleteUserTx(gomock.Any(), gomock.Eq(user.Username)).
+						Times(1).
+						Return(db.ErrNonZeroBalance)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusBadRequest, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, request *http.Request, maker token.Maker) {
+					addAuthHeader(t, request, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name: "InternalErrorDB",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().
+						DeleteUserTx(gomock.Any(), gomock.Any()).
+						Times(1).
+						Return(sql.ErrConnDone)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, request *http.Request, maker token.Maker) {
+					addAuthHeader(t, request, maker, authorizationTypeBearer, user.Username)
+				},
+			},
+		},
+		{
+			name: "NoAuthorization",
+			testCaseBase: testCaseBase{
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().
+						DeleteUserTx(gomock.Any(), gomock.Any()).
+						Times(0)
+				},
+				checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+					require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				},
+				setupAuth: func(t *testing.T, request *http.Request, maker token.Maker) {},
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		tc := testCases[i]
+
+		url := "/api/users"
+
+		req, err := http.NewRequest(http.MethodDelete, url, nil)
+		require.NoError(t, err)
+
+		runServerTest(t, tc, req)
+	}
+}
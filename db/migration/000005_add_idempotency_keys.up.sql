@@ -0,0 +1,13 @@
+CREATE TABLE "idempotency_keys" (
+    "key" varchar NOT NULL,
+    "username" varchar NOT NULL,
+    "fingerprint" varchar NOT NULL,
+    "response" jsonb NOT NULL,
+    "expires_at" timestamptz NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    PRIMARY KEY ("username", "key")
+);
+ALTER TABLE "idempotency_keys"
+ADD FOREIGN KEY ("username") REFERENCES "users" ("username") ON DELETE CASCADE;
+CREATE INDEX ON "idempotency_keys" ("expires_at");
+COMMENT ON COLUMN "idempotency_keys"."fingerprint" IS 'sha256 of the request the key was first used with';
@@ -0,0 +1,3 @@
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "fx_rate_at";
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "fx_rate";
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "to_amount";
\ No newline at end of file
@@ -0,0 +1,13 @@
+ALTER TABLE "transfers"
+ADD COLUMN "to_amount" bigint;
+UPDATE "transfers"
+SET "to_amount" = "amount";
+ALTER TABLE "transfers"
+ALTER COLUMN "to_amount" SET NOT NULL;
+ALTER TABLE "transfers"
+ADD COLUMN "fx_rate" bigint NOT NULL DEFAULT 100000000;
+ALTER TABLE "transfers"
+ADD COLUMN "fx_rate_at" timestamptz NOT NULL DEFAULT (now());
+COMMENT ON COLUMN "transfers"."to_amount" IS 'amount credited in the currency of the destination account';
+COMMENT ON COLUMN "transfers"."fx_rate" IS 'exchange rate used to convert amount into to_amount, scaled by 1e8';
+COMMENT ON COLUMN "transfers"."fx_rate_at" IS 'when the exchange rate was published';
@@ -0,0 +1,3 @@
+ALTER TABLE "entries" DROP COLUMN IF EXISTS "external_ref";
+ALTER TABLE "entries" DROP COLUMN IF EXISTS "type";
+DROP TYPE IF EXISTS "entry_type";
\ No newline at end of file
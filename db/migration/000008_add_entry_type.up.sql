@@ -0,0 +1,6 @@
+CREATE TYPE "entry_type" AS ENUM ('transfer', 'deposit', 'withdrawal');
+ALTER TABLE "entries"
+ADD COLUMN "type" entry_type NOT NULL DEFAULT 'transfer';
+ALTER TABLE "entries"
+ADD COLUMN "external_ref" varchar;
+COMMENT ON COLUMN "entries"."external_ref" IS 'reference of the deposit or withdrawal in the external system (teller receipt, payment id)';
@@ -0,0 +1,3 @@
+ALTER TABLE "entries" DROP COLUMN IF EXISTS "description";
+ALTER TABLE "entries" DROP COLUMN IF EXISTS "balance_after";
+ALTER TABLE "entries" DROP COLUMN IF EXISTS "transfer_id";
\ No newline at end of file
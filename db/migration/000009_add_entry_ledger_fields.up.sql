@@ -0,0 +1,31 @@
+ALTER TABLE "entries"
+ADD COLUMN "transfer_id" bigint;
+ALTER TABLE "entries"
+ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+CREATE INDEX ON "entries" ("transfer_id");
+ALTER TABLE "entries"
+ADD COLUMN "balance_after" bigint;
+UPDATE "entries"
+SET "balance_after" = "ledger"."balance_after"
+FROM (
+        SELECT "entries"."id",
+            "accounts"."balance" - COALESCE(
+                SUM("entries"."amount") OVER (
+                    PARTITION BY "entries"."account_id"
+                    ORDER BY "entries"."id" DESC ROWS BETWEEN UNBOUNDED PRECEDING AND 1 PRECEDING
+                ),
+                0
+            ) AS "balance_after"
+        FROM "entries"
+            JOIN "accounts" ON "accounts"."id" = "entries"."account_id"
+    ) AS "ledger"
+WHERE "ledger"."id" = "entries"."id";
+UPDATE "entries"
+SET "balance_after" = 0
+WHERE "balance_after" IS NULL;
+ALTER TABLE "entries"
+ALTER COLUMN "balance_after" SET NOT NULL;
+ALTER TABLE "entries"
+ADD COLUMN "description" varchar NOT NULL DEFAULT '';
+COMMENT ON COLUMN "entries"."transfer_id" IS 'transfer that produced the entry, null for deposits and withdrawals';
+COMMENT ON COLUMN "entries"."balance_after" IS 'balance of the account right after the entry was applied';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "entries_account_id_created_at_id_idx";
+DROP INDEX IF EXISTS "transfers_to_account_id_created_at_id_idx";
+DROP INDEX IF EXISTS "transfers_from_account_id_created_at_id_idx";
\ No newline at end of file
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "scheduled_transfer_attempts";
+DROP TABLE IF EXISTS "scheduled_transfers";
+DROP TYPE IF EXISTS "scheduled_transfer_status";
+DROP TYPE IF EXISTS "transfer_frequency";
@@ -0,0 +1,50 @@
+CREATE TYPE "transfer_frequency" AS ENUM ('once', 'daily', 'weekly', 'monthly');
+CREATE TYPE "scheduled_transfer_status" AS ENUM (
+    'active',
+    'paused',
+    'completed',
+    'failed',
+    'cancelled'
+);
+CREATE TABLE "scheduled_transfers" (
+    "id" bigserial PRIMARY KEY,
+    "owner" varchar NOT NULL,
+    "from_account_id" bigint NOT NULL,
+    "to_account_id" bigint NOT NULL,
+    "amount" bigint NOT NULL,
+    "frequency" transfer_frequency NOT NULL,
+    "start_at" timestamptz NOT NULL,
+    "end_at" timestamptz,
+    "next_run_at" timestamptz NOT NULL,
+    "occurrence" integer NOT NULL DEFAULT 0,
+    "status" scheduled_transfer_status NOT NULL DEFAULT 'active',
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+ALTER TABLE "scheduled_transfers"
+ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+ALTER TABLE "scheduled_transfers"
+ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+ALTER TABLE "scheduled_transfers"
+ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+ALTER TABLE "scheduled_transfers"
+ADD CONSTRAINT "scheduled_transfers_amount_positive" CHECK ("amount" > 0);
+CREATE INDEX ON "scheduled_transfers" ("owner");
+CREATE INDEX ON "scheduled_transfers" ("status", "next_run_at");
+COMMENT ON COLUMN "scheduled_transfers"."end_at" IS 'no occurrence is run after it, null repeats forever';
+COMMENT ON COLUMN "scheduled_transfers"."occurrence" IS 'index of next_run_at in the schedule, 0 is start_at';
+CREATE TABLE "scheduled_transfer_attempts" (
+    "id" bigserial PRIMARY KEY,
+    "scheduled_transfer_id" bigint NOT NULL,
+    "occurrence" integer NOT NULL,
+    "scheduled_for" timestamptz NOT NULL,
+    "transfer_id" bigint,
+    "error" varchar,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+ALTER TABLE "scheduled_transfer_attempts"
+ADD FOREIGN KEY ("scheduled_transfer_id") REFERENCES "scheduled_transfers" ("id");
+ALTER TABLE "scheduled_transfer_attempts"
+ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+CREATE INDEX ON "scheduled_transfer_attempts" ("scheduled_transfer_id", "created_at", "id");
+COMMENT ON COLUMN "scheduled_transfer_attempts"."transfer_id" IS 'transfer made by the attempt, null when it failed';
+COMMENT ON COLUMN "scheduled_transfer_attempts"."error" IS 'why the attempt failed, null when it succeeded';
@@ -0,0 +1,6 @@
+CREATE TYPE "user_role" AS ENUM ('customer', 'teller', 'admin');
+
+ALTER TABLE "users"
+ADD COLUMN "role" user_role NOT NULL DEFAULT 'customer';
+
+COMMENT ON COLUMN "users"."role" IS 'admins can view any account and block sessions of any user, tellers and admins make deposits and withdrawals';
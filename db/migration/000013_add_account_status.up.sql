@@ -0,0 +1,23 @@
+CREATE TYPE "account_status" AS ENUM ('active', 'frozen', 'closed');
+ALTER TABLE "accounts"
+ADD COLUMN "status" account_status NOT NULL DEFAULT 'active';
+COMMENT ON COLUMN "accounts"."status" IS 'frozen accounts can be credited but not debited, closed ones can be neither';
+CREATE TABLE "account_status_changes" (
+    "id" bigserial PRIMARY KEY,
+    "account_id" bigint NOT NULL,
+    "from_status" account_status NOT NULL,
+    "to_status" account_status NOT NULL,
+    "changed_by" varchar NOT NULL,
+    "reason" varchar NOT NULL DEFAULT '',
+    "sweep_transfer_id" bigint,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+ALTER TABLE "account_status_changes"
+ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+ALTER TABLE "account_status_changes"
+ADD FOREIGN KEY ("changed_by") REFERENCES "users" ("username");
+ALTER TABLE "account_status_changes"
+ADD FOREIGN KEY ("sweep_transfer_id") REFERENCES "transfers" ("id");
+CREATE INDEX ON "account_status_changes" ("account_id", "created_at", "id");
+COMMENT ON COLUMN "account_status_changes"."changed_by" IS 'user who made the change, the owner or an admin';
+COMMENT ON COLUMN "account_status_changes"."sweep_transfer_id" IS 'transfer that moved the balance out of the account when it was closed';
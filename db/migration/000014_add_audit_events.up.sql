@@ -0,0 +1,24 @@
+CREATE TABLE "audit_events" (
+    "id" bigserial PRIMARY KEY,
+    "actor_username" varchar NOT NULL,
+    "session_id" uuid,
+    "client_ip" varchar NOT NULL DEFAULT '',
+    "user_agent" varchar NOT NULL DEFAULT '',
+    "action" varchar NOT NULL,
+    "target_type" varchar NOT NULL,
+    "target_id" varchar NOT NULL,
+    "before" jsonb NOT NULL DEFAULT 'null',
+    "after" jsonb NOT NULL DEFAULT 'null',
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+CREATE INDEX ON "audit_events" ("actor_username", "id");
+CREATE INDEX ON "audit_events" ("target_type", "target_id", "id");
+COMMENT ON COLUMN "audit_events"."session_id" IS 'session of the access token the change was made with, null when the actor has none';
+COMMENT ON COLUMN "audit_events"."before" IS 'target before the change, null when the change created it';
+COMMENT ON COLUMN "audit_events"."after" IS 'target after the change';
+CREATE FUNCTION "reject_audit_event_change"() RETURNS trigger AS $$ BEGIN RAISE EXCEPTION 'audit events are append-only';
+END;
+$$ LANGUAGE plpgsql;
+CREATE TRIGGER "audit_events_append_only" BEFORE
+UPDATE
+  OR DELETE ON "audit_events" FOR EACH ROW EXECUTE FUNCTION "reject_audit_event_change"();
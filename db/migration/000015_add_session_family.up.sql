@@ -0,0 +1,11 @@
+ALTER TABLE "sessions"
+ADD COLUMN "family_id" uuid;
+UPDATE "sessions"
+SET "family_id" = "id";
+ALTER TABLE "sessions"
+ALTER COLUMN "family_id" SET NOT NULL;
+ALTER TABLE "sessions"
+ADD COLUMN "rotated_at" timestamptz;
+CREATE INDEX ON "sessions" ("family_id");
+COMMENT ON COLUMN "sessions"."family_id" IS 'id of the session created on login, shared by every session rotated out of it';
+COMMENT ON COLUMN "sessions"."rotated_at" IS 'when the refresh token was renewed, using it again blocks the whole family';
@@ -0,0 +1,4 @@
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "reversed_at";
+ALTER TABLE "transfers" DROP CONSTRAINT IF EXISTS "transfers_reversed_amount_check";
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "reversed_amount";
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "reversal_of";
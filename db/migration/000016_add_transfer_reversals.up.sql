@@ -0,0 +1,17 @@
+ALTER TABLE "transfers"
+ADD COLUMN "reversal_of" bigint;
+ALTER TABLE "transfers"
+ADD COLUMN "reversed_amount" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "transfers"
+ADD COLUMN "reversed_at" timestamptz;
+ALTER TABLE "transfers"
+ADD FOREIGN KEY ("reversal_of") REFERENCES "transfers" ("id");
+ALTER TABLE "transfers"
+ADD CONSTRAINT "transfers_reversed_amount_check" CHECK (
+    "reversed_amount" >= 0
+    AND "reversed_amount" <= "amount"
+  );
+CREATE INDEX ON "transfers" ("reversal_of");
+COMMENT ON COLUMN "transfers"."reversal_of" IS 'transfer this one reverses, it moves the money back from its recipient to its sender';
+COMMENT ON COLUMN "transfers"."reversed_amount" IS 'part of amount given back to the sender by reversals so far, the transfer is fully reversed once it reaches amount';
+COMMENT ON COLUMN "transfers"."reversed_at" IS 'when the transfer was last reversed';
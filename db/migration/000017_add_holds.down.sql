@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "holds";
+ALTER TABLE "accounts" DROP CONSTRAINT IF EXISTS "accounts_held_amount_not_negative";
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "held_amount";
+DROP TYPE IF EXISTS "hold_status";
@@ -0,0 +1,41 @@
+CREATE TYPE "hold_status" AS ENUM ('active', 'captured', 'released', 'expired');
+ALTER TABLE "accounts"
+ADD COLUMN "held_amount" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "accounts"
+ADD CONSTRAINT "accounts_held_amount_not_negative" CHECK ("held_amount" >= 0);
+COMMENT ON COLUMN "accounts"."held_amount" IS 'sum of the active holds of the account, the available balance is balance - held_amount';
+CREATE TABLE "holds" (
+    "id" bigserial PRIMARY KEY,
+    "account_id" bigint NOT NULL,
+    "to_account_id" bigint NOT NULL,
+    "amount" bigint NOT NULL,
+    "description" varchar NOT NULL DEFAULT '',
+    "status" hold_status NOT NULL DEFAULT 'active',
+    "expires_at" timestamptz NOT NULL,
+    "captured_amount" bigint NOT NULL DEFAULT 0,
+    "transfer_id" bigint,
+    "closed_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+ALTER TABLE "holds"
+ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+ALTER TABLE "holds"
+ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+ALTER TABLE "holds"
+ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+ALTER TABLE "holds"
+ADD CONSTRAINT "holds_amount_positive" CHECK ("amount" > 0);
+ALTER TABLE "holds"
+ADD CONSTRAINT "holds_captured_amount_check" CHECK (
+    "captured_amount" >= 0
+    AND "captured_amount" <= "amount"
+  );
+CREATE INDEX ON "holds" ("account_id");
+CREATE INDEX ON "holds" ("expires_at")
+WHERE "status" = 'active';
+COMMENT ON COLUMN "holds"."account_id" IS 'account the amount is reserved on';
+COMMENT ON COLUMN "holds"."to_account_id" IS 'account credited when the hold is captured';
+COMMENT ON COLUMN "holds"."expires_at" IS 'active holds are released once it passes';
+COMMENT ON COLUMN "holds"."captured_amount" IS 'part of amount transferred by the capture, the rest is released';
+COMMENT ON COLUMN "holds"."transfer_id" IS 'transfer made by the capture';
+COMMENT ON COLUMN "holds"."closed_at" IS 'when the hold was captured, released or expired';
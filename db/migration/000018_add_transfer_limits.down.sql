@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "transfer_limits";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "tier";
+DROP TYPE IF EXISTS "user_tier";
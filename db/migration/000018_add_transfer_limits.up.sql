@@ -0,0 +1,58 @@
+CREATE TYPE "user_tier" AS ENUM ('new', 'verified');
+ALTER TABLE "users"
+ADD COLUMN "tier" user_tier NOT NULL DEFAULT 'new';
+COMMENT ON COLUMN "users"."tier" IS 'picks the default transfer limits of the accounts of the user';
+CREATE TABLE "transfer_limits" (
+    "id" bigserial PRIMARY KEY,
+    "tier" user_tier,
+    "username" varchar,
+    "account_id" bigint,
+    "currency" varchar,
+    "max_amount" bigint,
+    "daily_amount" bigint,
+    "monthly_amount" bigint,
+    "daily_count" integer,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+ALTER TABLE "transfer_limits"
+ADD FOREIGN KEY ("username") REFERENCES "users" ("username") ON DELETE CASCADE;
+ALTER TABLE "transfer_limits"
+ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON DELETE CASCADE;
+ALTER TABLE "transfer_limits"
+ADD CONSTRAINT "transfer_limits_one_scope" CHECK (
+    num_nonnulls("tier", "username", "account_id") = 1
+  );
+ALTER TABLE "transfer_limits"
+ADD CONSTRAINT "transfer_limits_currency" CHECK (
+    ("account_id" IS NULL) = ("currency" IS NOT NULL)
+  );
+ALTER TABLE "transfer_limits"
+ADD CONSTRAINT "transfer_limits_not_negative" CHECK (
+    "max_amount" >= 0
+    AND "daily_amount" >= 0
+    AND "monthly_amount" >= 0
+    AND "daily_count" >= 0
+  );
+CREATE UNIQUE INDEX ON "transfer_limits" ("tier", "currency");
+CREATE UNIQUE INDEX ON "transfer_limits" ("username", "currency");
+CREATE UNIQUE INDEX ON "transfer_limits" ("account_id");
+COMMENT ON TABLE "transfer_limits" IS 'velocity limits of the transfers out of an account, the ones of the account override the ones of its owner, which override the ones of their tier';
+COMMENT ON COLUMN "transfer_limits"."currency" IS 'currency of the amounts of the limits of a tier or a user, they only count the accounts in that currency. The limits of an account are in its currency';
+COMMENT ON COLUMN "transfer_limits"."max_amount" IS 'maximum amount of a single transfer, null inherits it';
+COMMENT ON COLUMN "transfer_limits"."daily_amount" IS 'maximum total amount per UTC day, null inherits it';
+COMMENT ON COLUMN "transfer_limits"."monthly_amount" IS 'maximum total amount per UTC month, null inherits it';
+COMMENT ON COLUMN "transfer_limits"."daily_count" IS 'maximum number of transfers per UTC day, null inherits it';
+INSERT INTO "transfer_limits" (
+    "tier",
+    "currency",
+    "max_amount",
+    "daily_amount",
+    "monthly_amount",
+    "daily_count"
+  )
+VALUES ('new', 'USD', 100000, 200000, 1000000, 20),
+  ('new', 'EGP', 3000000, 6000000, 30000000, 20),
+  ('new', 'RUB', 7500000, 15000000, 75000000, 20),
+  ('verified', 'USD', 1000000, 5000000, 50000000, 200),
+  ('verified', 'EGP', 30000000, 150000000, 1500000000, 200),
+  ('verified', 'RUB', 75000000, 375000000, 3750000000, 200);
@@ -0,0 +1,7 @@
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "reviewed_at";
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "review_note";
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "reviewed_by";
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "risk_reason";
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "risk_rule";
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "status";
+DROP TYPE IF EXISTS "transfer_status";
@@ -0,0 +1,23 @@
+CREATE TYPE "transfer_status" AS ENUM ('completed', 'pending_review', 'rejected');
+ALTER TABLE "transfers"
+ADD COLUMN "status" transfer_status NOT NULL DEFAULT 'completed';
+ALTER TABLE "transfers"
+ADD COLUMN "risk_rule" varchar;
+ALTER TABLE "transfers"
+ADD COLUMN "risk_reason" varchar;
+ALTER TABLE "transfers"
+ADD COLUMN "reviewed_by" varchar;
+ALTER TABLE "transfers"
+ADD COLUMN "review_note" varchar;
+ALTER TABLE "transfers"
+ADD COLUMN "reviewed_at" timestamptz;
+ALTER TABLE "transfers"
+ADD FOREIGN KEY ("reviewed_by") REFERENCES "users" ("username");
+CREATE INDEX ON "transfers" ("id")
+WHERE "status" = 'pending_review';
+COMMENT ON COLUMN "transfers"."status" IS 'pending_review transfers are held by a risk rule and move no money until an admin approves them, rejected ones never do';
+COMMENT ON COLUMN "transfers"."risk_rule" IS 'risk rule that put the transfer into review';
+COMMENT ON COLUMN "transfers"."risk_reason" IS 'why the risk rule put the transfer into review';
+COMMENT ON COLUMN "transfers"."reviewed_by" IS 'admin who approved or rejected the transfer';
+COMMENT ON COLUMN "transfers"."review_note" IS 'why the admin approved or rejected the transfer';
+COMMENT ON COLUMN "transfers"."reviewed_at" IS 'when the transfer was approved or rejected';
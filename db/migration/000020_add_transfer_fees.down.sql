@@ -0,0 +1,21 @@
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "fee_account_id";
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "fee_amount";
+DROP TABLE IF EXISTS "fee_schedules";
+DROP TYPE IF EXISTS "fee_type";
+-- Enum values can't be dropped, the fee entries are kept as transfer entries since they belong to one
+ALTER TYPE "entry_type"
+RENAME TO "entry_type_old";
+CREATE TYPE "entry_type" AS ENUM ('transfer', 'deposit', 'withdrawal');
+ALTER TABLE "entries"
+ALTER COLUMN "type" DROP DEFAULT;
+ALTER TABLE "entries"
+ALTER COLUMN "type" TYPE entry_type USING (
+    CASE
+      WHEN "type" = 'fee' THEN 'transfer'
+      ELSE "type"::text
+    END
+  )::entry_type;
+ALTER TABLE "entries"
+ALTER COLUMN "type"
+SET DEFAULT 'transfer';
+DROP TYPE "entry_type_old";
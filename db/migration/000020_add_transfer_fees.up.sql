@@ -0,0 +1,41 @@
+ALTER TYPE "entry_type" ADD VALUE 'fee';
+CREATE TYPE "fee_type" AS ENUM ('flat', 'percentage', 'tiered');
+CREATE TABLE "fee_schedules" (
+    "id" bigserial PRIMARY KEY,
+    "currency" varchar NOT NULL,
+    "cross_currency" boolean NOT NULL,
+    "type" fee_type NOT NULL,
+    "flat_amount" bigint NOT NULL DEFAULT 0,
+    "basis_points" bigint NOT NULL DEFAULT 0,
+    "tiers" jsonb NOT NULL DEFAULT '[]',
+    "min_amount" bigint NOT NULL DEFAULT 0,
+    "max_amount" bigint,
+    "revenue_account_id" bigint NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+ALTER TABLE "fee_schedules"
+ADD FOREIGN KEY ("revenue_account_id") REFERENCES "accounts" ("id");
+ALTER TABLE "fee_schedules"
+ADD CONSTRAINT "fee_schedules_not_negative" CHECK (
+    "flat_amount" >= 0
+    AND "basis_points" >= 0
+    AND "min_amount" >= 0
+    AND "max_amount" >= "min_amount"
+  );
+CREATE UNIQUE INDEX ON "fee_schedules" ("currency", "cross_currency");
+COMMENT ON TABLE "fee_schedules" IS 'fees charged to the sender of a transfer on top of its amount, in the currency of the sender account';
+COMMENT ON COLUMN "fee_schedules"."cross_currency" IS 'whether the schedule applies to the transfers into an account of another currency or of the same one';
+COMMENT ON COLUMN "fee_schedules"."flat_amount" IS 'fee of the flat schedules';
+COMMENT ON COLUMN "fee_schedules"."basis_points" IS 'fee of the percentage schedules, in hundredths of a percent of the amount rounded down';
+COMMENT ON COLUMN "fee_schedules"."tiers" IS 'bands of the tiered schedules ordered by up_to, [{"up_to": 100000, "flat_amount": 100, "basis_points": 0}, {"up_to": null, ...}], an amount is charged the flat_amount and the basis_points of the first band it fits in';
+COMMENT ON COLUMN "fee_schedules"."min_amount" IS 'lowest fee of the percentage and tiered schedules';
+COMMENT ON COLUMN "fee_schedules"."max_amount" IS 'highest fee of the percentage and tiered schedules, null for no cap';
+COMMENT ON COLUMN "fee_schedules"."revenue_account_id" IS 'house account credited with the fees, it must be in the currency of the schedule';
+ALTER TABLE "transfers"
+ADD COLUMN "fee_amount" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "transfers"
+ADD COLUMN "fee_account_id" bigint;
+ALTER TABLE "transfers"
+ADD FOREIGN KEY ("fee_account_id") REFERENCES "accounts" ("id");
+COMMENT ON COLUMN "transfers"."fee_amount" IS 'fee debited from the sender on top of amount, in the currency of the sender account';
+COMMENT ON COLUMN "transfers"."fee_account_id" IS 'house account credited with the fee, null when there is no fee';
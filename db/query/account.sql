@@ -20,6 +20,26 @@ WHERE owner = $1
 -- name: UpdateAccountBalance :one
 UPDATE accounts
 SET balance = balance + sqlc.arg(amount)
+WHERE id = sqlc.arg(id)
+  AND status <> 'closed'
+RETURNING *;
+-- name: DebitAccountBalance :one
+UPDATE accounts
+SET balance = balance - sqlc.arg(amount)
+WHERE id = sqlc.arg(id)
+  AND balance - held_amount - sqlc.arg(amount) >= -overdraft_limit
+  AND status = 'active'
+RETURNING *;
+-- name: HoldAccountFunds :one
+UPDATE accounts
+SET held_amount = held_amount + sqlc.arg(amount)
+WHERE id = sqlc.arg(id)
+  AND balance - held_amount - sqlc.arg(amount) >= -overdraft_limit
+  AND status = 'active'
+RETURNING *;
+-- name: ReleaseAccountFunds :one
+UPDATE accounts
+SET held_amount = held_amount - sqlc.arg(amount)
 WHERE id = sqlc.arg(id)
 RETURNING *;
 -- name: DeleteAccount :exec
@@ -29,4 +49,40 @@ WHERE id = $1;
 -- name: RestoreAccount :exec
 UPDATE accounts
 SET is_deleted = false
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1;
+-- name: GetUserAccountsForUpdate :many
+SELECT *
+FROM accounts
+WHERE owner = $1
+FOR NO KEY UPDATE;
+-- name: DeleteUserAccounts :exec
+UPDATE accounts
+SET is_deleted = true
+WHERE owner = $1;
+-- name: GetAccountForUpdate :one
+SELECT *
+FROM accounts
+WHERE id = $1
+LIMIT 1 FOR NO KEY UPDATE;
+-- name: UpdateAccountStatus :one
+UPDATE accounts
+SET status = sqlc.arg(status)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+-- name: CreateAccountStatusChange :one
+INSERT INTO account_status_changes (
+    account_id,
+    from_status,
+    to_status,
+    changed_by,
+    reason,
+    sweep_transfer_id
+  )
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+-- name: ListAccountStatusChanges :many
+SELECT *
+FROM account_status_changes
+WHERE account_id = $1
+ORDER BY created_at,
+  id;
\ No newline at end of file
@@ -0,0 +1,44 @@
+-- name: CreateAuditEvent :one
+INSERT INTO audit_events (
+    actor_username,
+    session_id,
+    client_ip,
+    user_agent,
+    action,
+    target_type,
+    target_id,
+    before,
+    after
+  )
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+-- name: ListAuditEvents :many
+SELECT *
+FROM audit_events
+WHERE id > sqlc.arg(after_id)
+  AND (
+    sqlc.narg(actor_username)::varchar IS NULL
+    OR actor_username = sqlc.narg(actor_username)
+  )
+  AND (
+    sqlc.narg(action)::varchar IS NULL
+    OR action = sqlc.narg(action)
+  )
+  AND (
+    sqlc.narg(target_type)::varchar IS NULL
+    OR target_type = sqlc.narg(target_type)
+  )
+  AND (
+    sqlc.narg(target_id)::varchar IS NULL
+    OR target_id = sqlc.narg(target_id)
+  )
+  AND (
+    sqlc.narg(from_time)::timestamptz IS NULL
+    OR created_at >= sqlc.narg(from_time)
+  )
+  AND (
+    sqlc.narg(to_time)::timestamptz IS NULL
+    OR created_at < sqlc.narg(to_time)
+  )
+ORDER BY id
+LIMIT sqlc.arg(page_size);
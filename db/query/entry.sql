@@ -1,6 +1,14 @@
 -- name: CreateEntry :one
-INSERT INTO entries (account_id, amount)
-VALUES ($1, $2)
+INSERT INTO entries (
+        account_id,
+        amount,
+        type,
+        external_ref,
+        transfer_id,
+        balance_after,
+        description
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING *;
 -- name: GetEntry :one
 SELECT *
@@ -10,6 +18,48 @@ LIMIT 1;
 -- name: ListEntries :many
 SELECT *
 FROM entries
-WHERE account_id = $1
-ORDER BY id
-LIMIT $2 OFFSET $3;
\ No newline at end of file
+WHERE account_id = sqlc.arg(account_id)
+    AND (
+        created_at > sqlc.arg(after_created_at)
+        OR (
+            created_at = sqlc.arg(after_created_at)
+            AND id > sqlc.arg(after_id)
+        )
+    )
+ORDER BY created_at,
+    id
+LIMIT sqlc.arg(page_size);
+
+-- name: ListEntriesBetween :many
+SELECT *
+FROM entries
+WHERE account_id = sqlc.arg(account_id)
+    AND created_at >= sqlc.arg(from_time)
+    AND created_at < sqlc.arg(to_time)
+ORDER BY id;
+-- name: ListStatementLines :many
+SELECT e.*,
+    COALESCE(
+        CASE
+            WHEN t.to_account_id = e.account_id THEN t.from_account_id
+            ELSE t.to_account_id
+        END,
+        0
+    )::bigint AS counterparty
+FROM entries e
+    LEFT JOIN transfers t ON t.id = e.transfer_id
+WHERE e.account_id = sqlc.arg(account_id)
+    AND e.created_at >= sqlc.arg(from_time)
+    AND e.created_at < sqlc.arg(to_time)
+    AND e.id > sqlc.arg(after_id)
+ORDER BY e.id
+LIMIT sqlc.arg(page_size);
+-- name: GetAccountBalanceAt :one
+SELECT (
+        accounts.balance - COALESCE(SUM(entries.amount), 0)
+    )::bigint AS balance
+FROM accounts
+    LEFT JOIN entries ON entries.account_id = accounts.id
+    AND entries.created_at >= sqlc.arg(at)
+WHERE accounts.id = sqlc.arg(account_id)
+GROUP BY accounts.id;
\ No newline at end of file
@@ -0,0 +1,22 @@
+-- name: GetFeeSchedule :one
+SELECT *
+FROM fee_schedules
+WHERE currency = sqlc.arg(currency)
+  AND cross_currency = sqlc.arg(cross_currency);
+-- name: CreateFeeSchedule :one
+INSERT INTO fee_schedules (
+    currency,
+    cross_currency,
+    type,
+    flat_amount,
+    basis_points,
+    tiers,
+    min_amount,
+    max_amount,
+    revenue_account_id
+  )
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+-- name: DeleteFeeSchedule :exec
+DELETE FROM fee_schedules
+WHERE id = $1;
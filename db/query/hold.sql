@@ -0,0 +1,43 @@
+-- name: CreateHold :one
+INSERT INTO holds (
+    account_id,
+    to_account_id,
+    amount,
+    description,
+    expires_at
+  )
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+-- name: GetHold :one
+SELECT *
+FROM holds
+WHERE id = $1
+LIMIT 1;
+-- name: GetHoldForUpdate :one
+SELECT *
+FROM holds
+WHERE id = $1
+LIMIT 1 FOR NO KEY UPDATE;
+-- name: GetExpiredHoldForUpdate :one
+SELECT *
+FROM holds
+WHERE status = 'active'
+  AND expires_at <= sqlc.arg(now)
+ORDER BY expires_at
+LIMIT 1 FOR NO KEY UPDATE SKIP LOCKED;
+-- name: ListAccountHolds :many
+SELECT *
+FROM holds
+WHERE account_id = $1
+  AND status = 'active'
+ORDER BY expires_at,
+  id;
+-- name: CloseHold :one
+UPDATE holds
+SET status = sqlc.arg(status),
+  captured_amount = sqlc.arg(captured_amount),
+  transfer_id = sqlc.narg(transfer_id),
+  closed_at = now()
+WHERE id = sqlc.arg(id)
+  AND status = 'active'
+RETURNING *;
@@ -0,0 +1,14 @@
+-- name: CreateIdempotencyKey :one
+INSERT INTO idempotency_keys (key, username, fingerprint, response, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+-- name: GetIdempotencyKey :one
+SELECT *
+FROM idempotency_keys
+WHERE username = $1
+  AND key = $2
+LIMIT 1;
+-- name: DeleteExpiredIdempotencyKeys :exec
+DELETE FROM idempotency_keys
+WHERE username = $1
+  AND expires_at < now();
\ No newline at end of file
@@ -0,0 +1,78 @@
+-- name: CreateScheduledTransfer :one
+INSERT INTO scheduled_transfers (
+    owner,
+    from_account_id,
+    to_account_id,
+    amount,
+    frequency,
+    start_at,
+    end_at,
+    next_run_at
+  )
+VALUES (
+    sqlc.arg(owner),
+    sqlc.arg(from_account_id),
+    sqlc.arg(to_account_id),
+    sqlc.arg(amount),
+    sqlc.arg(frequency),
+    sqlc.arg(start_at),
+    sqlc.narg(end_at),
+    sqlc.arg(start_at)
+  )
+RETURNING *;
+-- name: GetScheduledTransfer :one
+SELECT *
+FROM scheduled_transfers
+WHERE id = $1
+LIMIT 1;
+-- name: ListScheduledTransfers :many
+SELECT *
+FROM scheduled_transfers
+WHERE owner = $1
+ORDER BY id;
+-- name: UpdateScheduledTransfer :one
+UPDATE scheduled_transfers
+SET amount = COALESCE(sqlc.narg(amount), amount),
+  end_at = COALESCE(sqlc.narg(end_at), end_at),
+  status = COALESCE(sqlc.narg(status), status)
+WHERE id = sqlc.arg(id)
+  AND status IN ('active', 'paused')
+RETURNING *;
+-- name: GetDueScheduledTransferForUpdate :one
+SELECT *
+FROM scheduled_transfers
+WHERE status = 'active'
+  AND next_run_at <= sqlc.arg(now)
+ORDER BY next_run_at
+LIMIT 1 FOR UPDATE SKIP LOCKED;
+-- name: AdvanceScheduledTransfer :one
+UPDATE scheduled_transfers
+SET next_run_at = sqlc.arg(next_run_at),
+  occurrence = sqlc.arg(occurrence),
+  status = sqlc.arg(status)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+-- name: CreateScheduledTransferAttempt :one
+INSERT INTO scheduled_transfer_attempts (
+    scheduled_transfer_id,
+    occurrence,
+    scheduled_for,
+    transfer_id,
+    error
+  )
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+-- name: ListScheduledTransferAttempts :many
+SELECT *
+FROM scheduled_transfer_attempts
+WHERE scheduled_transfer_id = sqlc.arg(scheduled_transfer_id)
+  AND (
+    created_at > sqlc.arg(after_created_at)
+    OR (
+      created_at = sqlc.arg(after_created_at)
+      AND id > sqlc.arg(after_id)
+    )
+  )
+ORDER BY created_at,
+  id
+LIMIT sqlc.arg(page_size);
@@ -5,12 +5,75 @@ INSERT INTO "sessions" (
     refresh_token,
     user_agent,
     client_ip,
-    expires_at
+    expires_at,
+    family_id
   )
-VALUES ($1, $2, $3, $4, $5, $6)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING *;
 -- name: GetSession :one
 SELECT *
 FROM "sessions"
 WHERE id = $1
+LIMIT 1;
+-- name: GetSessionForUpdate :one
+SELECT *
+FROM "sessions"
+WHERE id = $1
+LIMIT 1 FOR NO KEY UPDATE;
+-- name: BlockSession :exec
+UPDATE "sessions"
+SET is_blocked = true
+WHERE id = $1;
+-- name: BlockUserSessions :exec
+UPDATE "sessions"
+SET is_blocked = true
+WHERE username = $1;
+-- name: RotateSession :one
+UPDATE "sessions"
+SET rotated_at = now()
+WHERE id = $1
+RETURNING *;
+-- name: BlockSessionFamily :exec
+UPDATE "sessions"
+SET is_blocked = true
+WHERE family_id = $1;
+-- name: IsSessionBlocked :one
+SELECT is_blocked
+FROM "sessions"
+WHERE id = $1
+LIMIT 1;
+-- name: ListActiveUserSessions :many
+SELECT *
+FROM "sessions"
+WHERE username = $1
+  AND is_blocked = false
+  AND rotated_at IS NULL
+  AND expires_at > now()
+ORDER BY created_at DESC;
+-- name: BlockOtherUserSessions :exec
+UPDATE "sessions"
+SET is_blocked = true
+WHERE username = $1
+  AND family_id <> $2;
+-- name: GetNewIPSession :one
+SELECT s.id,
+  s.client_ip,
+  s.created_at
+FROM "sessions" AS s
+WHERE s.username = sqlc.arg(username)
+  AND s.created_at >= sqlc.arg(since)
+  AND EXISTS (
+    SELECT 1
+    FROM "sessions" AS o
+    WHERE o.username = s.username
+      AND o.created_at < s.created_at
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM "sessions" AS o
+    WHERE o.username = s.username
+      AND o.client_ip = s.client_ip
+      AND o.created_at < s.created_at
+  )
+ORDER BY s.created_at DESC
 LIMIT 1;
\ No newline at end of file
@@ -2,19 +2,111 @@
 INSERT INTO transfers (
     from_account_id,
     to_account_id,
-    amount
+    amount,
+    to_amount,
+    fx_rate,
+    fx_rate_at,
+    fee_amount,
+    fee_account_id
   )
-VALUES ($1, $2, $3)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+-- name: CreatePendingTransfer :one
+INSERT INTO transfers (
+    from_account_id,
+    to_account_id,
+    amount,
+    to_amount,
+    fx_rate,
+    fx_rate_at,
+    status,
+    risk_rule,
+    risk_reason,
+    fee_amount,
+    fee_account_id
+  )
+VALUES ($1, $2, $3, $4, $5, $6, 'pending_review', $7, $8, $9, $10)
 RETURNING *;
 -- name: GetTransfer :one
 SELECT *
 FROM transfers
 WHERE id = $1
 LIMIT 1;
+-- name: GetTransferForUpdate :one
+SELECT *
+FROM transfers
+WHERE id = $1
+LIMIT 1 FOR NO KEY UPDATE;
+-- name: CreateReversalTransfer :one
+INSERT INTO transfers (
+    from_account_id,
+    to_account_id,
+    amount,
+    to_amount,
+    fx_rate,
+    fx_rate_at,
+    reversal_of
+  )
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+-- name: AddTransferReversedAmount :one
+UPDATE transfers
+SET reversed_amount = reversed_amount + sqlc.arg(amount),
+  reversed_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+-- name: ListTransferReversals :many
+SELECT *
+FROM transfers
+WHERE reversal_of = $1
+ORDER BY id;
 -- name: ListTransfers :many
 SELECT *
 FROM transfers
-WHERE from_account_id = sqlc.arg(account_id)
-  OR to_account_id = sqlc.arg(account_id)
+WHERE (
+    from_account_id = sqlc.arg(account_id)
+    OR to_account_id = sqlc.arg(account_id)
+  )
+  AND (
+    created_at > sqlc.arg(after_created_at)
+    OR (
+      created_at = sqlc.arg(after_created_at)
+      AND id > sqlc.arg(after_id)
+    )
+  )
+ORDER BY created_at,
+  id
+LIMIT sqlc.arg(page_size);
+
+-- name: ReviewTransfer :one
+UPDATE transfers
+SET status = sqlc.arg(status),
+  reviewed_by = sqlc.arg(reviewed_by),
+  review_note = sqlc.arg(review_note),
+  reviewed_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+-- name: ListPendingTransfers :many
+SELECT *
+FROM transfers
+WHERE status = 'pending_review'
+  AND id > sqlc.arg(after_id)
 ORDER BY id
-LIMIT sqlc.arg(page_size) OFFSET sqlc.arg(page_id);
\ No newline at end of file
+LIMIT sqlc.arg(page_size);
+-- name: CountOwnerTransfersTo :one
+SELECT COUNT(*)::integer
+FROM transfers
+WHERE to_account_id = sqlc.arg(to_account_id)
+  AND status = 'completed'
+  AND from_account_id IN (
+    SELECT id
+    FROM accounts
+    WHERE owner = sqlc.arg(owner)
+  );
+-- name: CountTransfersSince :one
+SELECT COUNT(*)::integer
+FROM transfers
+WHERE from_account_id = sqlc.arg(account_id)
+  AND reversal_of IS NULL
+  AND status <> 'rejected'
+  AND created_at >= sqlc.arg(since);
\ No newline at end of file
@@ -0,0 +1,59 @@
+-- name: ListApplicableTransferLimits :many
+SELECT *
+FROM transfer_limits
+WHERE account_id = sqlc.arg(account_id)
+  OR (
+    (
+      username = sqlc.arg(username)
+      OR tier = sqlc.arg(tier)
+    )
+    AND currency = sqlc.arg(currency)::varchar
+  );
+-- name: GetTransferUsage :one
+SELECT COUNT(*) FILTER (
+    WHERE t.from_account_id = sqlc.arg(account_id)
+      AND t.created_at >= sqlc.arg(day_start)
+  )::integer AS daily_count,
+  COALESCE(
+    SUM(t.amount) FILTER (
+      WHERE t.from_account_id = sqlc.arg(account_id)
+        AND t.created_at >= sqlc.arg(day_start)
+    ),
+    0
+  )::bigint AS daily_amount,
+  COALESCE(
+    SUM(t.amount) FILTER (
+      WHERE t.from_account_id = sqlc.arg(account_id)
+    ),
+    0
+  )::bigint AS monthly_amount,
+  COUNT(*) FILTER (
+    WHERE t.created_at >= sqlc.arg(day_start)
+  )::integer AS owner_daily_count,
+  COALESCE(
+    SUM(t.amount) FILTER (
+      WHERE t.created_at >= sqlc.arg(day_start)
+    ),
+    0
+  )::bigint AS owner_daily_amount,
+  COALESCE(SUM(t.amount), 0)::bigint AS owner_monthly_amount
+FROM transfers t
+  JOIN accounts a ON a.id = t.from_account_id
+WHERE a.owner = sqlc.arg(owner)
+  AND a.currency = sqlc.arg(currency)
+  AND t.reversal_of IS NULL
+  AND t.status <> 'rejected'
+  AND t.created_at >= sqlc.arg(month_start);
+-- name: CreateTransferLimit :one
+INSERT INTO transfer_limits (
+    tier,
+    username,
+    account_id,
+    currency,
+    max_amount,
+    daily_amount,
+    monthly_amount,
+    daily_count
+  )
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
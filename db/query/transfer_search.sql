@@ -0,0 +1,180 @@
+-- name: SearchTransfersByDateAsc :many
+SELECT *
+FROM transfers
+WHERE (
+    (
+      from_account_id = sqlc.arg(account_id)
+      AND sqlc.arg(outgoing)::boolean
+    )
+    OR (
+      to_account_id = sqlc.arg(account_id)
+      AND sqlc.arg(incoming)::boolean
+    )
+  )
+  AND (
+    sqlc.narg(counterparty_id)::bigint IS NULL
+    OR from_account_id = sqlc.narg(counterparty_id)
+    OR to_account_id = sqlc.narg(counterparty_id)
+  )
+  AND (
+    sqlc.narg(min_amount)::bigint IS NULL
+    OR amount >= sqlc.narg(min_amount)
+  )
+  AND (
+    sqlc.narg(max_amount)::bigint IS NULL
+    OR amount <= sqlc.narg(max_amount)
+  )
+  AND (
+    sqlc.narg(from_time)::timestamptz IS NULL
+    OR created_at >= sqlc.narg(from_time)
+  )
+  AND (
+    sqlc.narg(to_time)::timestamptz IS NULL
+    OR created_at < sqlc.narg(to_time)
+  )
+  AND (
+    sqlc.arg(after_id)::bigint = 0
+    OR created_at > sqlc.arg(after_created_at)
+    OR (
+      created_at = sqlc.arg(after_created_at)
+      AND id > sqlc.arg(after_id)
+    )
+  )
+ORDER BY created_at ASC,
+  id ASC
+LIMIT sqlc.arg(page_size);
+-- name: SearchTransfersByDateDesc :many
+SELECT *
+FROM transfers
+WHERE (
+    (
+      from_account_id = sqlc.arg(account_id)
+      AND sqlc.arg(outgoing)::boolean
+    )
+    OR (
+      to_account_id = sqlc.arg(account_id)
+      AND sqlc.arg(incoming)::boolean
+    )
+  )
+  AND (
+    sqlc.narg(counterparty_id)::bigint IS NULL
+    OR from_account_id = sqlc.narg(counterparty_id)
+    OR to_account_id = sqlc.narg(counterparty_id)
+  )
+  AND (
+    sqlc.narg(min_amount)::bigint IS NULL
+    OR amount >= sqlc.narg(min_amount)
+  )
+  AND (
+    sqlc.narg(max_amount)::bigint IS NULL
+    OR amount <= sqlc.narg(max_amount)
+  )
+  AND (
+    sqlc.narg(from_time)::timestamptz IS NULL
+    OR created_at >= sqlc.narg(from_time)
+  )
+  AND (
+    sqlc.narg(to_time)::timestamptz IS NULL
+    OR created_at < sqlc.narg(to_time)
+  )
+  AND (
+    sqlc.arg(after_id)::bigint = 0
+    OR created_at < sqlc.arg(after_created_at)
+    OR (
+      created_at = sqlc.arg(after_created_at)
+      AND id < sqlc.arg(after_id)
+    )
+  )
+ORDER BY created_at DESC,
+  id DESC
+LIMIT sqlc.arg(page_size);
+-- name: SearchTransfersByAmountAsc :many
+SELECT *
+FROM transfers
+WHERE (
+    (
+      from_account_id = sqlc.arg(account_id)
+      AND sqlc.arg(outgoing)::boolean
+    )
+    OR (
+      to_account_id = sqlc.arg(account_id)
+      AND sqlc.arg(incoming)::boolean
+    )
+  )
+  AND (
+    sqlc.narg(counterparty_id)::bigint IS NULL
+    OR from_account_id = sqlc.narg(counterparty_id)
+    OR to_account_id = sqlc.narg(counterparty_id)
+  )
+  AND (
+    sqlc.narg(min_amount)::bigint IS NULL
+    OR amount >= sqlc.narg(min_amount)
+  )
+  AND (
+    sqlc.narg(max_amount)::bigint IS NULL
+    OR amount <= sqlc.narg(max_amount)
+  )
+  AND (
+    sqlc.narg(from_time)::timestamptz IS NULL
+    OR created_at >= sqlc.narg(from_time)
+  )
+  AND (
+    sqlc.narg(to_time)::timestamptz IS NULL
+    OR created_at < sqlc.narg(to_time)
+  )
+  AND (
+    sqlc.arg(after_id)::bigint = 0
+    OR amount > sqlc.arg(after_amount)
+    OR (
+      amount = sqlc.arg(after_amount)
+      AND id > sqlc.arg(after_id)
+    )
+  )
+ORDER BY amount ASC,
+  id ASC
+LIMIT sqlc.arg(page_size);
+-- name: SearchTransfersByAmountDesc :many
+SELECT *
+FROM transfers
+WHERE (
+    (
+      from_account_id = sqlc.arg(account_id)
+      AND sqlc.arg(outgoing)::boolean
+    )
+    OR (
+      to_account_id = sqlc.arg(account_id)
+      AND sqlc.arg(incoming)::boolean
+    )
+  )
+  AND (
+    sqlc.narg(counterparty_id)::bigint IS NULL
+    OR from_account_id = sqlc.narg(counterparty_id)
+    OR to_account_id = sqlc.narg(counterparty_id)
+  )
+  AND (
+    sqlc.narg(min_amount)::bigint IS NULL
+    OR amount >= sqlc.narg(min_amount)
+  )
+  AND (
+    sqlc.narg(max_amount)::bigint IS NULL
+    OR amount <= sqlc.narg(max_amount)
+  )
+  AND (
+    sqlc.narg(from_time)::timestamptz IS NULL
+    OR created_at >= sqlc.narg(from_time)
+  )
+  AND (
+    sqlc.narg(to_time)::timestamptz IS NULL
+    OR created_at < sqlc.narg(to_time)
+  )
+  AND (
+    sqlc.arg(after_id)::bigint = 0
+    OR amount < sqlc.arg(after_amount)
+    OR (
+      amount = sqlc.arg(after_amount)
+      AND id < sqlc.arg(after_id)
+    )
+  )
+ORDER BY amount DESC,
+  id DESC
+LIMIT sqlc.arg(page_size);
\ No newline at end of file
@@ -7,6 +7,11 @@ SELECT *
 FROM "users"
 WHERE username = $1
 LIMIT 1;
+-- name: GetUserForUpdate :one
+SELECT *
+FROM "users"
+WHERE username = $1
+LIMIT 1 FOR NO KEY UPDATE;
 -- name: UpdateUser :one
 UPDATE "users"
 SET hashed_password = coalesce(sqlc.narg('hashed_password'), hashed_password),
@@ -14,4 +19,14 @@ SET hashed_password = coalesce(sqlc.narg('hashed_password'), hashed_password),
   email = coalesce(sqlc.narg('email'), email)
 WHERE username = sqlc.arg('username')
   AND coalesce(@hashed_password, @full_name, @email) IS NOT NULL
-RETURNING *;
\ No newline at end of file
+RETURNING *;
+-- name: DeleteUser :exec
+UPDATE "users"
+SET is_deleted = true
+WHERE username = $1;
+-- name: ListUsers :many
+SELECT *
+FROM "users"
+WHERE username > sqlc.arg(after_username)
+ORDER BY username
+LIMIT sqlc.arg(page_size);
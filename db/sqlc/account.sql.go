@@ -7,12 +7,13 @@ package db
 
 import (
 	"context"
+	"database/sql"
 )
 
 const createAccount = `-- name: CreateAccount :one
 INSERT INTO accounts (owner, balance, currency)
 VALUES ($1, $2, $3)
-RETURNING id, owner, balance, currency, created_at, is_deleted
+RETURNING id, owner, balance, currency, created_at, is_deleted, overdraft_limit, status, held_amount
 `
 
 type CreateAccountParams struct {
@@ -31,6 +32,85 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (A
 		&i.Currency,
 		&i.CreatedAt,
 		&i.IsDeleted,
+		&i.OverdraftLimit,
+		&i.Status,
+		&i.HeldAmount,
+	)
+	return i, err
+}
+
+const createAccountStatusChange = `-- name: CreateAccountStatusChange :one
+INSERT INTO account_status_changes (
+    account_id,
+    from_status,
+    to_status,
+    changed_by,
+    reason,
+    sweep_transfer_id
+  )
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, account_id, from_status, to_status, changed_by, reason, sweep_transfer_id, created_at
+`
+
+type CreateAccountStatusChangeParams struct {
+	AccountID       int64         `json:"account_id"`
+	FromStatus      AccountStatus `json:"from_status"`
+	ToStatus        AccountStatus `json:"to_status"`
+	ChangedBy       string        `json:"changed_by"`
+	Reason          string        `json:"reason"`
+	SweepTransferID sql.NullInt64 `json:"sweep_transfer_id"`
+}
+
+func (q *Queries) CreateAccountStatusChange(ctx context.Context, arg CreateAccountStatusChangeParams) (AccountStatusChange, error) {
+	row := q.db.QueryRowContext(ctx, createAccountStatusChange,
+		arg.AccountID,
+		arg.FromStatus,
+		arg.ToStatus,
+		arg.ChangedBy,
+		arg.Reason,
+		arg.SweepTransferID,
+	)
+	var i AccountStatusChange
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.FromStatus,
+		&i.ToStatus,
+		&i.ChangedBy,
+		&i.Reason,
+		&i.SweepTransferID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const debitAccountBalance = `-- name: DebitAccountBalance :one
+UPDATE accounts
+SET balance = balance - $1
+WHERE id = $2
+  AND balance - held_amount - $1 >= -overdraft_limit
+  AND status = 'active'
+RETURNING id, owner, balance, currency, created_at, is_deleted, overdraft_limit, status, held_amount
+`
+
+type DebitAccountBalanceParams struct {
+	Amount int64 `json:"amount"`
+	ID     int64 `json:"id"`
+}
+
+func (q *Queries) DebitAccountBalance(ctx context.Context, arg DebitAccountBalanceParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, debitAccountBalance, arg.Amount, arg.ID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.IsDeleted,
+		&i.OverdraftLimit,
+		&i.Status,
+		&i.HeldAmount,
 	)
 	return i, err
 }
@@ -46,8 +126,19 @@ func (q *Queries) DeleteAccount(ctx context.Context, id int64) error {
 	return err
 }
 
+const deleteUserAccounts = `-- name: DeleteUserAccounts :exec
+UPDATE accounts
+SET is_deleted = true
+WHERE owner = $1
+`
+
+func (q *Queries) DeleteUserAccounts(ctx context.Context, owner string) error {
+	_, err := q.db.ExecContext(ctx, deleteUserAccounts, owner)
+	return err
+}
+
 const getAccount = `-- name: GetAccount :one
-SELECT id, owner, balance, currency, created_at, is_deleted
+SELECT id, owner, balance, currency, created_at, is_deleted, overdraft_limit, status, held_amount
 FROM accounts
 WHERE id = $1
 LIMIT 1
@@ -63,12 +154,39 @@ func (q *Queries) GetAccount(ctx context.Context, id int64) (Account, error) {
 		&i.Currency,
 		&i.CreatedAt,
 		&i.IsDeleted,
+		&i.OverdraftLimit,
+		&i.Status,
+		&i.HeldAmount,
+	)
+	return i, err
+}
+
+const getAccountForUpdate = `-- name: GetAccountForUpdate :one
+SELECT id, owner, balance, currency, created_at, is_deleted, overdraft_limit, status, held_amount
+FROM accounts
+WHERE id = $1
+LIMIT 1 FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetAccountForUpdate(ctx context.Context, id int64) (Account, error) {
+	row := q.db.QueryRowContext(ctx, getAccountForUpdate, id)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.IsDeleted,
+		&i.OverdraftLimit,
+		&i.Status,
+		&i.HeldAmount,
 	)
 	return i, err
 }
 
 const getAccounts = `-- name: GetAccounts :many
-SELECT id, owner, balance, currency, created_at, is_deleted
+SELECT id, owner, balance, currency, created_at, is_deleted, overdraft_limit, status, held_amount
 FROM accounts
 WHERE owner = $1
   AND is_deleted = false
@@ -90,6 +208,9 @@ func (q *Queries) GetAccounts(ctx context.Context, owner string) ([]Account, err
 			&i.Currency,
 			&i.CreatedAt,
 			&i.IsDeleted,
+			&i.OverdraftLimit,
+			&i.Status,
+			&i.HeldAmount,
 		); err != nil {
 			return nil, err
 		}
@@ -105,7 +226,7 @@ func (q *Queries) GetAccounts(ctx context.Context, owner string) ([]Account, err
 }
 
 const getDeletedAccounts = `-- name: GetDeletedAccounts :many
-SELECT id, owner, balance, currency, created_at, is_deleted
+SELECT id, owner, balance, currency, created_at, is_deleted, overdraft_limit, status, held_amount
 FROM accounts
 WHERE owner = $1
   AND is_deleted = true
@@ -127,6 +248,49 @@ func (q *Queries) GetDeletedAccounts(ctx context.Context, owner string) ([]Accou
 			&i.Currency,
 			&i.CreatedAt,
 			&i.IsDeleted,
+			&i.OverdraftLimit,
+			&i.Status,
+			&i.HeldAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserAccountsForUpdate = `-- name: GetUserAccountsForUpdate :many
+SELECT id, owner, balance, currency, created_at, is_deleted, overdraft_limit, status, held_amount
+FROM accounts
+WHERE owner = $1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetUserAccountsForUpdate(ctx context.Context, owner string) ([]Account, error) {
+	rows, err := q.db.QueryContext(ctx, getUserAccountsForUpdate, owner)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.IsDeleted,
+			&i.OverdraftLimit,
+			&i.Status,
+			&i.HeldAmount,
 		); err != nil {
 			return nil, err
 		}
@@ -141,6 +305,106 @@ func (q *Queries) GetDeletedAccounts(ctx context.Context, owner string) ([]Accou
 	return items, nil
 }
 
+const holdAccountFunds = `-- name: HoldAccountFunds :one
+UPDATE accounts
+SET held_amount = held_amount + $1
+WHERE id = $2
+  AND balance - held_amount - $1 >= -overdraft_limit
+  AND status = 'active'
+RETURNING id, owner, balance, currency, created_at, is_deleted, overdraft_limit, status, held_amount
+`
+
+type HoldAccountFundsParams struct {
+	Amount int64 `json:"amount"`
+	ID     int64 `json:"id"`
+}
+
+func (q *Queries) HoldAccountFunds(ctx context.Context, arg HoldAccountFundsParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, holdAccountFunds, arg.Amount, arg.ID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.IsDeleted,
+		&i.OverdraftLimit,
+		&i.Status,
+		&i.HeldAmount,
+	)
+	return i, err
+}
+
+const listAccountStatusChanges = `-- name: ListAccountStatusChanges :many
+SELECT id, account_id, from_status, to_status, changed_by, reason, sweep_transfer_id, created_at
+FROM account_status_changes
+WHERE account_id = $1
+ORDER BY created_at,
+  id
+`
+
+func (q *Queries) ListAccountStatusChanges(ctx context.Context, accountID int64) ([]AccountStatusChange, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountStatusChanges, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AccountStatusChange{}
+	for rows.Next() {
+		var i AccountStatusChange
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.FromStatus,
+			&i.ToStatus,
+			&i.ChangedBy,
+			&i.Reason,
+			&i.SweepTransferID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const releaseAccountFunds = `-- name: ReleaseAccountFunds :one
+UPDATE accounts
+SET held_amount = held_amount - $1
+WHERE id = $2
+RETURNING id, owner, balance, currency, created_at, is_deleted, overdraft_limit, status, held_amount
+`
+
+type ReleaseAccountFundsParams struct {
+	Amount int64 `json:"amount"`
+	ID     int64 `json:"id"`
+}
+
+func (q *Queries) ReleaseAccountFunds(ctx context.Context, arg ReleaseAccountFundsParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, releaseAccountFunds, arg.Amount, arg.ID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.IsDeleted,
+		&i.OverdraftLimit,
+		&i.Status,
+		&i.HeldAmount,
+	)
+	return i, err
+}
+
 const restoreAccount = `-- name: RestoreAccount :exec
 UPDATE accounts
 SET is_deleted = false
@@ -156,7 +420,8 @@ const updateAccountBalance = `-- name: UpdateAccountBalance :one
 UPDATE accounts
 SET balance = balance + $1
 WHERE id = $2
-RETURNING id, owner, balance, currency, created_at, is_deleted
+  AND status <> 'closed'
+RETURNING id, owner, balance, currency, created_at, is_deleted, overdraft_limit, status, held_amount
 `
 
 type UpdateAccountBalanceParams struct {
@@ -174,6 +439,38 @@ func (q *Queries) UpdateAccountBalance(ctx context.Context, arg UpdateAccountBal
 		&i.Currency,
 		&i.CreatedAt,
 		&i.IsDeleted,
+		&i.OverdraftLimit,
+		&i.Status,
+		&i.HeldAmount,
+	)
+	return i, err
+}
+
+const updateAccountStatus = `-- name: UpdateAccountStatus :one
+UPDATE accounts
+SET status = $1
+WHERE id = $2
+RETURNING id, owner, balance, currency, created_at, is_deleted, overdraft_limit, status, held_amount
+`
+
+type UpdateAccountStatusParams struct {
+	Status AccountStatus `json:"status"`
+	ID     int64         `json:"id"`
+}
+
+func (q *Queries) UpdateAccountStatus(ctx context.Context, arg UpdateAccountStatusParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, updateAccountStatus, arg.Status, arg.ID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.IsDeleted,
+		&i.OverdraftLimit,
+		&i.Status,
+		&i.HeldAmount,
 	)
 	return i, err
 }
@@ -0,0 +1,45 @@
+package db
+
+import "errors"
+
+var (
+	ErrAccountFrozen           = errors.New("account is frozen, it can be credited but not debited")
+	ErrAccountClosed           = errors.New("account is closed")
+	ErrInvalidStatusTransition = errors.New("account can't move to the requested status")
+	ErrAccountBalanceNotZero   = errors.New("account balance must be zero to close it, or swept to another account")
+)
+
+// accountStatusTransitions lists the statuses every status can move to, closed accounts stay closed
+var accountStatusTransitions = map[AccountStatus][]AccountStatus{
+	AccountStatusActive: {AccountStatusFrozen, AccountStatusClosed},
+	AccountStatusFrozen: {AccountStatusActive, AccountStatusClosed},
+}
+
+// CanDebit tells whether money can be taken out of the account
+func (a Account) CanDebit() error {
+	switch a.Status {
+	case AccountStatusFrozen:
+		return ErrAccountFrozen
+	case AccountStatusClosed:
+		return ErrAccountClosed
+	}
+	return nil
+}
+
+// CanCredit tells whether money can be put into the account, frozen accounts still receive money
+func (a Account) CanCredit() error {
+	if a.Status == AccountStatusClosed {
+		return ErrAccountClosed
+	}
+	return nil
+}
+
+// CanMoveTo tells whether the account can move from its status to status
+func (a Account) CanMoveTo(status AccountStatus) bool {
+	for _, next := range accountStatusTransitions[a.Status] {
+		if next == status {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,480 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Actions of the audit events
+const (
+	AuditActionUserCreate              = "user.create"
+	AuditActionUserUpdate              = "user.update"
+	AuditActionUserDelete              = "user.delete"
+	AuditActionUserLogin               = "user.login"
+	AuditActionUserLogout              = "user.logout"
+	AuditActionUserSessionsBlock       = "user.sessions_block"
+	AuditActionUserSessionRevoke       = "user.session_revoke"
+	AuditActionUserSessionsRevoke      = "user.sessions_revoke"
+	AuditActionTokenRenew              = "token.renew"
+	AuditActionTokenReuse              = "token.reuse"
+	AuditActionAccountCreate           = "account.create"
+	AuditActionAccountDelete           = "account.delete"
+	AuditActionAccountRestore          = "account.restore"
+	AuditActionAccountStatusChange     = "account.status_change"
+	AuditActionAccountClose            = "account.close"
+	AuditActionAccountDeposit          = "account.deposit"
+	AuditActionAccountWithdraw         = "account.withdraw"
+	AuditActionTransferCreate          = "transfer.create"
+	AuditActionTransferReverse         = "transfer.reverse"
+	AuditActionTransferApprove         = "transfer.approve"
+	AuditActionTransferReject          = "transfer.reject"
+	AuditActionHoldPlace               = "hold.place"
+	AuditActionHoldCapture             = "hold.capture"
+	AuditActionHoldRelease             = "hold.release"
+	AuditActionHoldExpire              = "hold.expire"
+	AuditActionScheduledTransferCreate = "scheduled_transfer.create"
+	AuditActionScheduledTransferUpdate = "scheduled_transfer.update"
+)
+
+// Types of the targets of the audit events
+const (
+	AuditTargetUser              = "user"
+	AuditTargetSession           = "session"
+	AuditTargetAccount           = "account"
+	AuditTargetTransfer          = "transfer"
+	AuditTargetScheduledTransfer = "scheduled_transfer"
+	AuditTargetHold              = "hold"
+)
+
+// Actor is who makes a change, it is recorded with every audit event
+type Actor struct {
+	Username string
+	// SessionID is the session of the access token of the actor, uuid.Nil when unknown
+	SessionID uuid.UUID
+	ClientIP  string
+	UserAgent string
+}
+
+type actorKey struct{}
+
+// WithActor returns a copy of ctx that carries the actor of the changes made with it
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor stored in ctx by WithActor, the zero actor when there is none
+func ActorFromContext(ctx context.Context) Actor {
+	actor, _ := ctx.Value(actorKey{}).(Actor)
+	return actor
+}
+
+// NewAuditEvent builds the audit event of a change made by the actor of ctx, before and after are marshaled
+// to json and nil is stored as null
+func NewAuditEvent(ctx context.Context, action, targetType, targetID string, before, after interface{}) (CreateAuditEventParams, error) {
+	actor := ActorFromContext(ctx)
+
+	beforeJSON, err := json.Marshal(before)
+	if err != nil {
+		return CreateAuditEventParams{}, err
+	}
+
+	afterJSON, err := json.Marshal(after)
+	if err != nil {
+		return CreateAuditEventParams{}, err
+	}
+
+	return CreateAuditEventParams{
+		ActorUsername: actor.Username,
+		SessionID:     uuid.NullUUID{UUID: actor.SessionID, Valid: actor.SessionID != uuid.Nil},
+		ClientIp:      actor.ClientIP,
+		UserAgent:     actor.UserAgent,
+		Action:        action,
+		TargetType:    targetType,
+		TargetID:      targetID,
+		Before:        beforeJSON,
+		After:         afterJSON,
+	}, nil
+}
+
+func recordAuditEvent(ctx context.Context, q *Queries, action, targetType, targetID string, before, after interface{}) error {
+	arg, err := NewAuditEvent(ctx, action, targetType, targetID, before, after)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.CreateAuditEvent(ctx, arg)
+	return err
+}
+
+// txHook runs inside a transaction of SQLStore with its result right before it commits
+type txHook func(q *Queries, result interface{}) error
+
+type txHookKey struct{}
+
+func withTxHook(ctx context.Context, hook txHook) context.Context {
+	return context.WithValue(ctx, txHookKey{}, hook)
+}
+
+// runTxHook runs the hook stored in ctx by withTxHook, if any, the transactions of SQLStore call it once their
+// change is made so that AuditedStore records it in the same transaction
+func runTxHook(ctx context.Context, q *Queries, result interface{}) error {
+	if hook, ok := ctx.Value(txHookKey{}).(txHook); ok {
+		return hook(q, result)
+	}
+	return nil
+}
+
+// AuditedStore records an audit event for every change made through it in the same transaction as the change,
+// so a change is never committed without its event. The actor of the event is taken from the context with ActorFromContext
+type AuditedStore struct {
+	*SQLStore
+}
+
+func NewAuditedStore(db *sql.DB, opts ...StoreOption) Store {
+	return &AuditedStore{SQLStore: NewStore(db, opts...).(*SQLStore)}
+}
+
+// withAuditHook returns a copy of ctx whose transaction hook records the result of the transaction as the after of the event
+func withAuditHook(ctx context.Context, action, targetType string, targetID func(result interface{}) string) context.Context {
+	return withTxHook(ctx, func(q *Queries, result interface{}) error {
+		return recordAuditEvent(ctx, q, action, targetType, targetID(result), nil, result)
+	})
+}
+
+func (store *AuditedStore) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
+	var user User
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		user, err = q.CreateUser(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, q, AuditActionUserCreate, AuditTargetUser, user.Username, nil, redactUser(user))
+	})
+
+	return user, err
+}
+
+func (store *AuditedStore) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error) {
+	var user User
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		before, err := q.GetUser(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		user, err = q.UpdateUser(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, q, AuditActionUserUpdate, AuditTargetUser, user.Username, redactUser(before), redactUser(user))
+	})
+
+	return user, err
+}
+
+func (store *AuditedStore) DeleteUserTx(ctx context.Context, username string) error {
+	ctx = withTxHook(ctx, func(q *Queries, _ interface{}) error {
+		user, err := q.GetUser(ctx, username)
+		if err != nil {
+			return err
+		}
+		return recordAuditEvent(ctx, q, AuditActionUserDelete, AuditTargetUser, username, nil, redactUser(user))
+	})
+
+	return store.SQLStore.DeleteUserTx(ctx, username)
+}
+
+// CreateSession is called on login, the created session is the session of the event when the actor has none
+func (store *AuditedStore) CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error) {
+	var session Session
+
+	ctx = withActorSession(ctx, arg.ID)
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		session, err = q.CreateSession(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, q, AuditActionUserLogin, AuditTargetSession, session.ID.String(), nil, redactSession(session))
+	})
+
+	return session, err
+}
+
+// RenewSessionTx records the new session, or the blocked family when the refresh token was reused.
+// The renewed session is the session of the event when the actor has none
+func (store *AuditedStore) RenewSessionTx(ctx context.Context, arg RenewSessionTxParam) (RenewSessionTxResult, error) {
+	ctx = withActorSession(ctx, arg.SessionID)
+
+	hookCtx := withTxHook(ctx, func(q *Queries, result interface{}) error {
+		renewal := result.(RenewSessionTxResult)
+		if renewal.Reused {
+			return recordAuditEvent(ctx, q, AuditActionTokenReuse, AuditTargetSession, arg.SessionID.String(), nil, renewal)
+		}
+
+		renewal.Session = redactSession(renewal.Session)
+		return recordAuditEvent(ctx, q, AuditActionTokenRenew, AuditTargetSession, renewal.Session.ID.String(), nil, renewal)
+	})
+
+	return store.SQLStore.RenewSessionTx(hookCtx, arg)
+}
+
+func (store *AuditedStore) BlockSession(ctx context.Context, id uuid.UUID) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		before, err := q.GetSession(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err = q.BlockSession(ctx, id); err != nil {
+			return err
+		}
+
+		after, err := q.GetSession(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, q, AuditActionUserLogout, AuditTargetSession, id.String(), redactSession(before), redactSession(after))
+	})
+}
+
+func (store *AuditedStore) BlockUserSessions(ctx context.Context, username string) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		if err := q.BlockUserSessions(ctx, username); err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, q, AuditActionUserSessionsBlock, AuditTargetUser, username, nil, nil)
+	})
+}
+
+// BlockSessionFamily is called when a user revokes one of their sessions, the target is the family of the session
+func (store *AuditedStore) BlockSessionFamily(ctx context.Context, familyID uuid.UUID) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		if err := q.BlockSessionFamily(ctx, familyID); err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, q, AuditActionUserSessionRevoke, AuditTargetSession, familyID.String(), nil, nil)
+	})
+}
+
+// BlockOtherUserSessions is called when a user revokes all their sessions but the one of arg.FamilyID
+func (store *AuditedStore) BlockOtherUserSessions(ctx context.Context, arg BlockOtherUserSessionsParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		if err := q.BlockOtherUserSessions(ctx, arg); err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, q, AuditActionUserSessionsRevoke, AuditTargetUser, arg.Username, nil, arg)
+	})
+}
+
+func (store *AuditedStore) CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error) {
+	var account Account
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		account, err = q.CreateAccount(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, q, AuditActionAccountCreate, AuditTargetAccount, formatID(account.ID), nil, account)
+	})
+
+	return account, err
+}
+
+func (store *AuditedStore) DeleteAccount(ctx context.Context, id int64) error {
+	return store.updateAccount(ctx, AuditActionAccountDelete, id, func(q *Queries) error {
+		return q.DeleteAccount(ctx, id)
+	})
+}
+
+func (store *AuditedStore) RestoreAccount(ctx context.Context, id int64) error {
+	return store.updateAccount(ctx, AuditActionAccountRestore, id, func(q *Queries) error {
+		return q.RestoreAccount(ctx, id)
+	})
+}
+
+// updateAccount records the account before and after update in the same transaction
+func (store *AuditedStore) updateAccount(ctx context.Context, action string, id int64, update func(q *Queries) error) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		before, err := q.GetAccountForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err = update(q); err != nil {
+			return err
+		}
+
+		after, err := q.GetAccount(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, q, action, AuditTargetAccount, formatID(id), before, after)
+	})
+}
+
+func (store *AuditedStore) TransferTx(ctx context.Context, arg TransferTxParam) (TransferTxResult, error) {
+	ctx = withAuditHook(ctx, AuditActionTransferCreate, AuditTargetTransfer, func(result interface{}) string {
+		return formatID(result.(TransferTxResult).Transfer.ID)
+	})
+
+	return store.SQLStore.TransferTx(ctx, arg)
+}
+
+func (store *AuditedStore) ReverseTransferTx(ctx context.Context, arg ReverseTransferTxParam) (ReverseTransferTxResult, error) {
+	ctx = withAuditHook(ctx, AuditActionTransferReverse, AuditTargetTransfer, func(result interface{}) string {
+		return formatID(result.(ReverseTransferTxResult).Original.ID)
+	})
+
+	return store.SQLStore.ReverseTransferTx(ctx, arg)
+}
+
+func (store *AuditedStore) ApproveTransferTx(ctx context.Context, arg ReviewTransferTxParam) (TransferTxResult, error) {
+	ctx = withAuditHook(ctx, AuditActionTransferApprove, AuditTargetTransfer, func(result interface{}) string {
+		return formatID(result.(TransferTxResult).Transfer.ID)
+	})
+
+	return store.SQLStore.ApproveTransferTx(ctx, arg)
+}
+
+func (store *AuditedStore) RejectTransferTx(ctx context.Context, arg ReviewTransferTxParam) (Transfer, error) {
+	ctx = withAuditHook(ctx, AuditActionTransferReject, AuditTargetTransfer, func(result interface{}) string {
+		return formatID(result.(Transfer).ID)
+	})
+
+	return store.SQLStore.RejectTransferTx(ctx, arg)
+}
+
+func (store *AuditedStore) PlaceHoldTx(ctx context.Context, arg PlaceHoldTxParam) (HoldTxResult, error) {
+	ctx = withAuditHook(ctx, AuditActionHoldPlace, AuditTargetHold, holdTxTargetID)
+	return store.SQLStore.PlaceHoldTx(ctx, arg)
+}
+
+func (store *AuditedStore) CaptureHoldTx(ctx context.Context, arg CaptureHoldTxParam) (HoldTxResult, error) {
+	ctx = withAuditHook(ctx, AuditActionHoldCapture, AuditTargetHold, holdTxTargetID)
+	return store.SQLStore.CaptureHoldTx(ctx, arg)
+}
+
+func (store *AuditedStore) ReleaseHoldTx(ctx context.Context, holdID int64) (HoldTxResult, error) {
+	ctx = withAuditHook(ctx, AuditActionHoldRelease, AuditTargetHold, holdTxTargetID)
+	return store.SQLStore.ReleaseHoldTx(ctx, holdID)
+}
+
+func (store *AuditedStore) ReleaseExpiredHoldTx(ctx context.Context, now time.Time) (HoldTxResult, error) {
+	ctx = withAuditHook(ctx, AuditActionHoldExpire, AuditTargetHold, holdTxTargetID)
+	return store.SQLStore.ReleaseExpiredHoldTx(ctx, now)
+}
+
+func (store *AuditedStore) DepositTx(ctx context.Context, arg DepositTxParam) (EntryTxResult, error) {
+	ctx = withAuditHook(ctx, AuditActionAccountDeposit, AuditTargetAccount, entryTxTargetID)
+	return store.SQLStore.DepositTx(ctx, arg)
+}
+
+func (store *AuditedStore) WithdrawTx(ctx context.Context, arg WithdrawTxParam) (EntryTxResult, error) {
+	ctx = withAuditHook(ctx, AuditActionAccountWithdraw, AuditTargetAccount, entryTxTargetID)
+	return store.SQLStore.WithdrawTx(ctx, arg)
+}
+
+func (store *AuditedStore) ChangeAccountStatusTx(ctx context.Context, arg ChangeAccountStatusTxParam) (AccountStatusTxResult, error) {
+	ctx = withAuditHook(ctx, AuditActionAccountStatusChange, AuditTargetAccount, accountStatusTxTargetID)
+	return store.SQLStore.ChangeAccountStatusTx(ctx, arg)
+}
+
+func (store *AuditedStore) CloseAccountTx(ctx context.Context, arg CloseAccountTxParam) (AccountStatusTxResult, error) {
+	ctx = withAuditHook(ctx, AuditActionAccountClose, AuditTargetAccount, accountStatusTxTargetID)
+	return store.SQLStore.CloseAccountTx(ctx, arg)
+}
+
+func (store *AuditedStore) CreateScheduledTransfer(ctx context.Context, arg CreateScheduledTransferParams) (ScheduledTransfer, error) {
+	var scheduled ScheduledTransfer
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		scheduled, err = q.CreateScheduledTransfer(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, q, AuditActionScheduledTransferCreate, AuditTargetScheduledTransfer, formatID(scheduled.ID), nil, scheduled)
+	})
+
+	return scheduled, err
+}
+
+func (store *AuditedStore) UpdateScheduledTransfer(ctx context.Context, arg UpdateScheduledTransferParams) (ScheduledTransfer, error) {
+	var scheduled ScheduledTransfer
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		before, err := q.GetScheduledTransfer(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		scheduled, err = q.UpdateScheduledTransfer(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, q, AuditActionScheduledTransferUpdate, AuditTargetScheduledTransfer, formatID(scheduled.ID), before, scheduled)
+	})
+
+	return scheduled, err
+}
+
+func entryTxTargetID(result interface{}) string {
+	return formatID(result.(EntryTxResult).Account.ID)
+}
+
+func holdTxTargetID(result interface{}) string {
+	return formatID(result.(HoldTxResult).Hold.ID)
+}
+
+func accountStatusTxTargetID(result interface{}) string {
+	return formatID(result.(AccountStatusTxResult).Account.ID)
+}
+
+// withActorSession makes id the session of the actor of ctx when it has none
+func withActorSession(ctx context.Context, id uuid.UUID) context.Context {
+	actor := ActorFromContext(ctx)
+	if actor.SessionID != uuid.Nil {
+		return ctx
+	}
+
+	actor.SessionID = id
+	return WithActor(ctx, actor)
+}
+
+func formatID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+// redactUser removes the password hash from the user so that it never ends up in the audit log
+func redactUser(user User) User {
+	user.HashedPassword = ""
+	return user
+}
+
+// redactSession removes the refresh token from the session so that it never ends up in the audit log
+func redactSession(session Session) Session {
+	session.RefreshToken = ""
+	return session
+}
@@ -0,0 +1,115 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/escalopa/gobank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func listTargetAuditEvents(t *testing.T, targetType, targetID string) []AuditEvent {
+	events, err := testQueries.ListAuditEvents(context.Background(), ListAuditEventsParams{
+		TargetType: sql.NullString{String: targetType, Valid: true},
+		TargetID:   sql.NullString{String: targetID, Valid: true},
+		PageSize:   10,
+	})
+	require.NoError(t, err)
+	return events
+}
+
+func TestAuditedStore(t *testing.T) {
+	store := NewAuditedStore(testDB)
+	user := createRandomUser(t)
+
+	actor := Actor{Username: user.Username, ClientIP: "127.0.0.1", UserAgent: "go-test"}
+	ctx := WithActor(context.Background(), actor)
+
+	account, err := store.CreateAccount(ctx, CreateAccountParams{Owner: user.Username, Currency: util.USD})
+	require.NoError(t, err)
+
+	err = store.DeleteAccount(ctx, account.ID)
+	require.NoError(t, err)
+
+	events := listTargetAuditEvents(t, AuditTargetAccount, fmt.Sprint(account.ID))
+	require.Len(t, events, 2)
+
+	require.Equal(t, AuditActionAccountCreate, events[0].Action)
+	require.Equal(t, actor.Username, events[0].ActorUsername)
+	require.Equal(t, actor.ClientIP, events[0].ClientIp)
+	require.Equal(t, actor.UserAgent, events[0].UserAgent)
+	require.False(t, events[0].SessionID.Valid)
+	require.JSONEq(t, "null", string(events[0].Before))
+
+	var before, after Account
+	require.Equal(t, AuditActionAccountDelete, events[1].Action)
+	require.NoError(t, json.Unmarshal(events[1].Before, &before))
+	require.NoError(t, json.Unmarshal(events[1].After, &after))
+	require.False(t, before.IsDeleted)
+	require.True(t, after.IsDeleted)
+}
+
+func TestAuditedStoreTx(t *testing.T) {
+	store := NewAuditedStore(testDB)
+	from := fundAccount(t, createRandomAccount(t), 100)
+	to := createAccountWithCurrency(t, from.Currency)
+	ctx := WithActor(context.Background(), Actor{Username: from.Owner})
+
+	result, err := store.TransferTx(ctx, TransferTxParam{FromAccountID: from.ID, ToAccountID: to.ID, Amount: 10})
+	require.NoError(t, err)
+
+	events := listTargetAuditEvents(t, AuditTargetTransfer, fmt.Sprint(result.Transfer.ID))
+	require.Len(t, events, 1)
+	require.Equal(t, from.Owner, events[0].ActorUsername)
+
+	var after TransferTxResult
+	require.NoError(t, json.Unmarshal(events[0].After, &after))
+	require.Equal(t, result.Transfer.ID, after.Transfer.ID)
+	require.Equal(t, result.FromAccount.Balance, after.FromAccount.Balance)
+
+	// A change that is rolled back leaves no event behind
+	_, err = store.WithdrawTx(ctx, WithdrawTxParam{AccountID: to.ID, Amount: 1000})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+	require.Empty(t, listTargetAuditEvents(t, AuditTargetAccount, fmt.Sprint(to.ID)))
+}
+
+func TestAuditedStoreRedactsSecrets(t *testing.T) {
+	store := NewAuditedStore(testDB)
+	user := createRandomUser(t)
+	ctx := WithActor(context.Background(), Actor{Username: user.Username})
+
+	_, err := store.UpdateUser(ctx, UpdateUserParams{
+		Username:       user.Username,
+		HashedPassword: sql.NullString{String: util.RandomString(20), Valid: true},
+	})
+	require.NoError(t, err)
+
+	events := listTargetAuditEvents(t, AuditTargetUser, user.Username)
+	require.Len(t, events, 1)
+
+	var before, after User
+	require.NoError(t, json.Unmarshal(events[0].Before, &before))
+	require.NoError(t, json.Unmarshal(events[0].After, &after))
+	require.Empty(t, before.HashedPassword)
+	require.Empty(t, after.HashedPassword)
+}
+
+func TestAuditEventsAppendOnly(t *testing.T) {
+	store := NewAuditedStore(testDB)
+	user := createRandomUser(t)
+
+	err := store.BlockUserSessions(WithActor(context.Background(), Actor{Username: user.Username}), user.Username)
+	require.NoError(t, err)
+
+	events := listTargetAuditEvents(t, AuditTargetUser, user.Username)
+	require.Len(t, events, 1)
+
+	_, err = testDB.Exec("UPDATE audit_events SET actor_username = '' WHERE id = $1", events[0].ID)
+	require.Error(t, err)
+
+	_, err = testDB.Exec("DELETE FROM audit_events WHERE id = $1", events[0].ID)
+	require.Error(t, err)
+}
@@ -7,33 +7,84 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"time"
 )
 
 const createEntry = `-- name: CreateEntry :one
-INSERT INTO entries (account_id, amount)
-VALUES ($1, $2)
-RETURNING id, account_id, amount, created_at
+INSERT INTO entries (
+        account_id,
+        amount,
+        type,
+        external_ref,
+        transfer_id,
+        balance_after,
+        description
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, account_id, amount, created_at, type, external_ref, transfer_id, balance_after, description
 `
 
 type CreateEntryParams struct {
-	AccountID int64 `json:"account_id"`
-	Amount    int64 `json:"amount"`
+	AccountID    int64          `json:"account_id"`
+	Amount       int64          `json:"amount"`
+	Type         EntryType      `json:"type"`
+	ExternalRef  sql.NullString `json:"external_ref"`
+	TransferID   sql.NullInt64  `json:"transfer_id"`
+	BalanceAfter int64          `json:"balance_after"`
+	Description  string         `json:"description"`
 }
 
 func (q *Queries) CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error) {
-	row := q.db.QueryRowContext(ctx, createEntry, arg.AccountID, arg.Amount)
+	row := q.db.QueryRowContext(ctx, createEntry,
+		arg.AccountID,
+		arg.Amount,
+		arg.Type,
+		arg.ExternalRef,
+		arg.TransferID,
+		arg.BalanceAfter,
+		arg.Description,
+	)
 	var i Entry
 	err := row.Scan(
 		&i.ID,
 		&i.AccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.Type,
+		&i.ExternalRef,
+		&i.TransferID,
+		&i.BalanceAfter,
+		&i.Description,
 	)
 	return i, err
 }
 
+const getAccountBalanceAt = `-- name: GetAccountBalanceAt :one
+SELECT (
+        accounts.balance - COALESCE(SUM(entries.amount), 0)
+    )::bigint AS balance
+FROM accounts
+    LEFT JOIN entries ON entries.account_id = accounts.id
+    AND entries.created_at >= $1
+WHERE accounts.id = $2
+GROUP BY accounts.id
+`
+
+type GetAccountBalanceAtParams struct {
+	At        time.Time `json:"at"`
+	AccountID int64     `json:"account_id"`
+}
+
+func (q *Queries) GetAccountBalanceAt(ctx context.Context, arg GetAccountBalanceAtParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getAccountBalanceAt, arg.At, arg.AccountID)
+	var balance int64
+	err := row.Scan(&balance)
+	return balance, err
+}
+
 const getEntry = `-- name: GetEntry :one
-SELECT id, account_id, amount, created_at
+SELECT id, account_id, amount, created_at, type, external_ref, transfer_id, balance_after, description
 FROM entries
 WHERE id = $1
 LIMIT 1
@@ -47,26 +98,45 @@ func (q *Queries) GetEntry(ctx context.Context, id int64) (Entry, error) {
 		&i.AccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.Type,
+		&i.ExternalRef,
+		&i.TransferID,
+		&i.BalanceAfter,
+		&i.Description,
 	)
 	return i, err
 }
 
 const listEntries = `-- name: ListEntries :many
-SELECT id, account_id, amount, created_at
+SELECT id, account_id, amount, created_at, type, external_ref, transfer_id, balance_after, description
 FROM entries
 WHERE account_id = $1
-ORDER BY id
-LIMIT $2 OFFSET $3
+    AND (
+        created_at > $2
+        OR (
+            created_at = $2
+            AND id > $3
+        )
+    )
+ORDER BY created_at,
+    id
+LIMIT $4
 `
 
 type ListEntriesParams struct {
-	AccountID int64 `json:"account_id"`
-	Limit     int32 `json:"limit"`
-	Offset    int32 `json:"offset"`
+	AccountID      int64     `json:"account_id"`
+	AfterCreatedAt time.Time `json:"after_created_at"`
+	AfterID        int64     `json:"after_id"`
+	PageSize       int32     `json:"page_size"`
 }
 
 func (q *Queries) ListEntries(ctx context.Context, arg ListEntriesParams) ([]Entry, error) {
-	rows, err := q.db.QueryContext(ctx, listEntries, arg.AccountID, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, listEntries,
+		arg.AccountID,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.PageSize,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -79,6 +149,139 @@ func (q *Queries) ListEntries(ctx context.Context, arg ListEntriesParams) ([]Ent
 			&i.AccountID,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.Type,
+			&i.ExternalRef,
+			&i.TransferID,
+			&i.BalanceAfter,
+			&i.Description,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEntriesBetween = `-- name: ListEntriesBetween :many
+SELECT id, account_id, amount, created_at, type, external_ref, transfer_id, balance_after, description
+FROM entries
+WHERE account_id = $1
+    AND created_at >= $2
+    AND created_at < $3
+ORDER BY id
+`
+
+type ListEntriesBetweenParams struct {
+	AccountID int64     `json:"account_id"`
+	FromTime  time.Time `json:"from_time"`
+	ToTime    time.Time `json:"to_time"`
+}
+
+func (q *Queries) ListEntriesBetween(ctx context.Context, arg ListEntriesBetweenParams) ([]Entry, error) {
+	rows, err := q.db.QueryContext(ctx, listEntriesBetween, arg.AccountID, arg.FromTime, arg.ToTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Entry{}
+	for rows.Next() {
+		var i Entry
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.Type,
+			&i.ExternalRef,
+			&i.TransferID,
+			&i.BalanceAfter,
+			&i.Description,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStatementLines = `-- name: ListStatementLines :many
+SELECT e.id, e.account_id, e.amount, e.created_at, e.type, e.external_ref, e.transfer_id, e.balance_after, e.description,
+    COALESCE(
+        CASE
+            WHEN t.to_account_id = e.account_id THEN t.from_account_id
+            ELSE t.to_account_id
+        END,
+        0
+    )::bigint AS counterparty
+FROM entries e
+    LEFT JOIN transfers t ON t.id = e.transfer_id
+WHERE e.account_id = $1
+    AND e.created_at >= $2
+    AND e.created_at < $3
+    AND e.id > $4
+ORDER BY e.id
+LIMIT $5
+`
+
+type ListStatementLinesParams struct {
+	AccountID int64     `json:"account_id"`
+	FromTime  time.Time `json:"from_time"`
+	ToTime    time.Time `json:"to_time"`
+	AfterID   int64     `json:"after_id"`
+	PageSize  int32     `json:"page_size"`
+}
+
+type ListStatementLinesRow struct {
+	ID           int64          `json:"id"`
+	AccountID    int64          `json:"account_id"`
+	Amount       int64          `json:"amount"`
+	CreatedAt    time.Time      `json:"created_at"`
+	Type         EntryType      `json:"type"`
+	ExternalRef  sql.NullString `json:"external_ref"`
+	TransferID   sql.NullInt64  `json:"transfer_id"`
+	BalanceAfter int64          `json:"balance_after"`
+	Description  string         `json:"description"`
+	Counterparty int64          `json:"counterparty"`
+}
+
+func (q *Queries) ListStatementLines(ctx context.Context, arg ListStatementLinesParams) ([]ListStatementLinesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listStatementLines,
+		arg.AccountID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.AfterID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListStatementLinesRow{}
+	for rows.Next() {
+		var i ListStatementLinesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.Type,
+			&i.ExternalRef,
+			&i.TransferID,
+			&i.BalanceAfter,
+			&i.Description,
+			&i.Counterparty,
 		); err != nil {
 			return nil, err
 		}
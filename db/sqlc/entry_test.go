@@ -16,8 +16,10 @@ func validateEntryBasic(t *testing.T, entry Entry) {
 
 func createRandomEntry(t *testing.T, account Account) Entry {
 	arg := CreateEntryParams{
-		AccountID: account.ID,
-		Amount:    1000,
+		AccountID:    account.ID,
+		Amount:       1000,
+		Type:         EntryTypeDeposit,
+		BalanceAfter: account.Balance,
 	}
 
 	entry, err := testQueries.CreateEntry(context.Background(), arg)
@@ -27,6 +29,7 @@ func createRandomEntry(t *testing.T, account Account) Entry {
 	validateEntryBasic(t, entry)
 	require.Equal(t, entry.AccountID, arg.AccountID)
 	require.Equal(t, entry.Amount, arg.Amount)
+	require.Equal(t, entry.Type, arg.Type)
 
 	return entry
 }
@@ -56,15 +59,24 @@ func TestListEntry(t *testing.T) {
 
 	arg := ListEntriesParams{
 		AccountID: account.ID,
-		Limit:     5,
-		Offset:    5,
+		PageSize:  5,
 	}
 
 	entries, err := testQueries.ListEntries(context.Background(), arg)
 	require.NoError(t, err)
 	require.Len(t, entries, 5)
 
-	for _, Entry := range entries {
+	// The next page starts right after the last entry of the first one
+	last := entries[len(entries)-1]
+	arg.AfterCreatedAt, arg.AfterID = last.CreatedAt, last.ID
+
+	nextEntries, err := testQueries.ListEntries(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, nextEntries, 5)
+
+	for _, Entry := range nextEntries {
 		validateEntryBasic(t, Entry)
+		require.Equal(t, account.ID, Entry.AccountID)
+		require.Greater(t, Entry.ID, last.ID)
 	}
 }
@@ -0,0 +1,169 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var ErrFeeAccountCurrency = errors.New("fee revenue account isn't in the currency of the fee schedule")
+
+// FeeTier is a band of a tiered fee schedule, an amount is charged the fee of the first tier it fits in
+type FeeTier struct {
+	// UpTo is the largest amount of the tier, nil for the last tier which has no upper bound
+	UpTo        *int64 `json:"up_to"`
+	FlatAmount  int64  `json:"flat_amount"`
+	BasisPoints int64  `json:"basis_points"`
+}
+
+// Fee computes the fee of a transfer of amount. Flat schedules charge FlatAmount whatever the amount, the fees of
+// percentage and tiered schedules are rounded down then kept between MinAmount and MaxAmount
+func (s FeeSchedule) Fee(amount int64) (int64, error) {
+	var fee int64
+
+	switch s.Type {
+	case FeeTypeFlat:
+		return s.FlatAmount, nil
+	case FeeTypePercentage:
+		fee = basisPointsOf(amount, s.BasisPoints)
+	case FeeTypeTiered:
+		var tiers []FeeTier
+		if err := json.Unmarshal(s.Tiers, &tiers); err != nil {
+			return 0, fmt.Errorf("cannot parse the tiers of fee schedule %d, %w", s.ID, err)
+		}
+
+		tier, ok := feeTierOf(tiers, amount)
+		if !ok {
+			return 0, fmt.Errorf("fee schedule %d has no tier for amount %d", s.ID, amount)
+		}
+		fee = tier.FlatAmount + basisPointsOf(amount, tier.BasisPoints)
+	default:
+		return 0, fmt.Errorf("fee schedule %d has unknown type %q", s.ID, s.Type)
+	}
+
+	if fee < s.MinAmount {
+		fee = s.MinAmount
+	}
+	if s.MaxAmount.Valid && fee > s.MaxAmount.Int64 {
+		fee = s.MaxAmount.Int64
+	}
+	return fee, nil
+}
+
+// feeTierOf returns the first tier amount fits in, the tiers are ordered by UpTo
+func feeTierOf(tiers []FeeTier, amount int64) (FeeTier, bool) {
+	for _, tier := range tiers {
+		if tier.UpTo == nil || amount <= *tier.UpTo {
+			return tier, true
+		}
+	}
+	return FeeTier{}, false
+}
+
+// basisPointsOf returns bps hundredths of a percent of amount rounded down, without overflowing for large amounts
+func basisPointsOf(amount, bps int64) int64 {
+	return amount/10_000*bps + amount%10_000*bps/10_000
+}
+
+// TransferFee is charged to the sender of a transfer on top of its amount, in the currency of the sender account
+type TransferFee struct {
+	Amount int64 `json:"amount"`
+	// AccountID is the house account credited with the fee, 0 when there is no fee
+	AccountID int64 `json:"account_id"`
+}
+
+// Fee returns the fee of the transfer
+func (t Transfer) Fee() TransferFee {
+	return TransferFee{Amount: t.FeeAmount, AccountID: t.FeeAccountID.Int64}
+}
+
+// QuoteTransferFee computes the fee TransferTx charges for a transfer of amount between the accounts, with the fee
+// schedule of the currency of from. The fee is zero when no schedule applies
+func (store *SQLStore) QuoteTransferFee(ctx context.Context, from, to Account, amount int64) (TransferFee, error) {
+	return quoteTransferFee(ctx, store.Queries, from, to, amount)
+}
+
+func quoteTransferFee(ctx context.Context, q *Queries, from, to Account, amount int64) (fee TransferFee, err error) {
+	schedule, err := q.GetFeeSchedule(ctx, GetFeeScheduleParams{
+		Currency:      from.Currency,
+		CrossCurrency: from.Currency != to.Currency,
+	})
+	if err == sql.ErrNoRows {
+		return fee, nil
+	}
+	if err != nil {
+		return
+	}
+
+	fee.Amount, err = schedule.Fee(amount)
+	if err != nil || fee.Amount == 0 {
+		return
+	}
+	fee.AccountID = schedule.RevenueAccountID
+	return
+}
+
+// quoteTransferFeeByID quotes the fee of a transfer before its accounts are locked, their currencies never change
+func quoteTransferFeeByID(ctx context.Context, q *Queries, fromAccountID, toAccountID, amount int64) (TransferFee, error) {
+	from, err := q.GetAccount(ctx, fromAccountID)
+	if err != nil {
+		return TransferFee{}, err
+	}
+
+	to, err := q.GetAccount(ctx, toAccountID)
+	if err != nil {
+		return TransferFee{}, err
+	}
+
+	return quoteTransferFee(ctx, q, from, to, amount)
+}
+
+// chargeFee debits the fee of the transfer from its sender and credits it to the house account, each with a fee entry.
+// It returns both accounts after the fee, the house account can be either account of the transfer
+func chargeFee(ctx context.Context, q *Queries, t Transfer) (from, revenue Account, entry Entry, err error) {
+	from, err = addMoney(ctx, q, t.FromAccountID, -t.FeeAmount)
+	if err != nil {
+		return
+	}
+
+	revenue, err = addMoney(ctx, q, t.FeeAccountID.Int64, t.FeeAmount)
+	if err != nil {
+		return
+	}
+
+	if revenue.Currency != from.Currency {
+		err = ErrFeeAccountCurrency
+		return
+	}
+
+	transferID := sql.NullInt64{Int64: t.ID, Valid: true}
+	entry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID:    from.ID,
+		Amount:       -t.FeeAmount,
+		Type:         EntryTypeFee,
+		TransferID:   transferID,
+		BalanceAfter: from.Balance,
+		Description:  fmt.Sprintf("fee of transfer %d", t.ID),
+	})
+
+	if err != nil {
+		return
+	}
+
+	// The house account paying a fee to itself is credited back
+	if revenue.ID == from.ID {
+		from = revenue
+	}
+
+	_, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID:    revenue.ID,
+		Amount:       t.FeeAmount,
+		Type:         EntryTypeFee,
+		TransferID:   transferID,
+		BalanceAfter: revenue.Balance,
+		Description:  fmt.Sprintf("fee of transfer %d from account %d", t.ID, from.ID),
+	})
+	return
+}
@@ -0,0 +1,233 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/escalopa/gobank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeScheduleFee(t *testing.T) {
+	upTo := int64(1000)
+	tiers, err := json.Marshal([]FeeTier{
+		{UpTo: &upTo, FlatAmount: 5},
+		{BasisPoints: 100},
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		schedule FeeSchedule
+		amount   int64
+		fee      int64
+	}{
+		{
+			name:     "Flat",
+			schedule: FeeSchedule{Type: FeeTypeFlat, FlatAmount: 25, MinAmount: 50},
+			amount:   1_000_000,
+			fee:      25,
+		},
+		{
+			name:     "Percentage",
+			schedule: FeeSchedule{Type: FeeTypePercentage, BasisPoints: 50},
+			amount:   15_000,
+			fee:      75,
+		},
+		{
+			name:     "PercentageRoundedDown",
+			schedule: FeeSchedule{Type: FeeTypePercentage, BasisPoints: 50},
+			amount:   199,
+			fee:      0,
+		},
+		{
+			name:     "PercentageMinAmount",
+			schedule: FeeSchedule{Type: FeeTypePercentage, BasisPoints: 50, MinAmount: 10},
+			amount:   100,
+			fee:      10,
+		},
+		{
+			name:     "PercentageMaxAmount",
+			schedule: FeeSchedule{Type: FeeTypePercentage, BasisPoints: 50, MaxAmount: sql.NullInt64{Int64: 100, Valid: true}},
+			amount:   1_000_000,
+			fee:      100,
+		},
+		{
+			name:     "PercentageLargeAmount",
+			schedule: FeeSchedule{Type: FeeTypePercentage, BasisPoints: 10_000},
+			amount:   1 << 60,
+			fee:      1 << 60,
+		},
+		{
+			name:     "TieredFirstTier",
+			schedule: FeeSchedule{Type: FeeTypeTiered, Tiers: tiers},
+			amount:   1000,
+			fee:      5,
+		},
+		{
+			name:     "TieredLastTier",
+			schedule: FeeSchedule{Type: FeeTypeTiered, Tiers: tiers},
+			amount:   2000,
+			fee:      20,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			fee, err := tc.schedule.Fee(tc.amount)
+			require.NoError(t, err)
+			require.Equal(t, tc.fee, fee)
+		})
+	}
+
+	// An amount above every tier can't be charged
+	_, err = FeeSchedule{Type: FeeTypeTiered, Tiers: json.RawMessage(`[{"up_to": 10}]`)}.Fee(11)
+	require.Error(t, err)
+}
+
+func createAccountIn(t *testing.T, currency string) Account {
+	account, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    createRandomUser(t).Username,
+		Balance:  util.RandomMoney(),
+		Currency: currency,
+	})
+	require.NoError(t, err)
+	return account
+}
+
+// createFlatFeeSchedule creates a schedule charging flatAmount for the same currency transfers out of the currency of
+// revenue, it is deleted once the test is over since it applies to every such transfer
+func createFlatFeeSchedule(t *testing.T, revenue Account, flatAmount int64) FeeSchedule {
+	schedule, err := testQueries.CreateFeeSchedule(context.Background(), CreateFeeScheduleParams{
+		Currency:         revenue.Currency,
+		Type:             FeeTypeFlat,
+		FlatAmount:       flatAmount,
+		Tiers:            json.RawMessage(`[]`),
+		RevenueAccountID: revenue.ID,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, testQueries.DeleteFeeSchedule(context.Background(), schedule.ID))
+	})
+	return schedule
+}
+
+func TestTransferTxFee(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := fundAccount(t, createRandomAccount(t), 100)
+	account2 := createAccountIn(t, account1.Currency)
+	revenue := createAccountIn(t, account1.Currency)
+	createFlatFeeSchedule(t, revenue, 3)
+
+	fee, err := store.QuoteTransferFee(context.Background(), account1, account2, 10)
+	require.NoError(t, err)
+	require.Equal(t, TransferFee{Amount: 3, AccountID: revenue.ID}, fee)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParam{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	// The sender pays the fee on top of the amount, with an entry of its own
+	require.Equal(t, fee, result.Fee)
+	require.Equal(t, int64(3), result.Transfer.FeeAmount)
+	require.Equal(t, revenue.ID, result.Transfer.FeeAccountID.Int64)
+	require.Equal(t, account1.Balance-13, result.FromAccount.Balance)
+	require.Equal(t, account2.Balance+10, result.ToAccount.Balance)
+	require.Equal(t, account1.Balance-10, result.FromEntry.BalanceAfter)
+
+	require.Equal(t, EntryTypeFee, result.FeeEntry.Type)
+	require.Equal(t, int64(-3), result.FeeEntry.Amount)
+	require.Equal(t, result.Transfer.ID, result.FeeEntry.TransferID.Int64)
+	require.Equal(t, result.FromAccount.Balance, result.FeeEntry.BalanceAfter)
+
+	updatedRevenue, err := store.GetAccount(context.Background(), revenue.ID)
+	require.NoError(t, err)
+	require.Equal(t, revenue.Balance+3, updatedRevenue.Balance)
+
+	// A cross currency transfer has no schedule
+	account3 := createAccountIn(t, util.RandomCurrency())
+	if account3.Currency != account1.Currency {
+		fee, err = store.QuoteTransferFee(context.Background(), account1, account3, 10)
+		require.NoError(t, err)
+		require.Zero(t, fee)
+	}
+}
+
+func TestTransferTxFeeToRevenueAccount(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := fundAccount(t, createRandomAccount(t), 100)
+	revenue := createAccountIn(t, account1.Currency)
+	createFlatFeeSchedule(t, revenue, 3)
+
+	// The house account receives both the amount and the fee
+	result, err := store.TransferTx(context.Background(), TransferTxParam{
+		FromAccountID: account1.ID,
+		ToAccountID:   revenue.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance-13, result.FromAccount.Balance)
+	require.Equal(t, revenue.Balance+13, result.ToAccount.Balance)
+
+	updatedRevenue, err := store.GetAccount(context.Background(), revenue.ID)
+	require.NoError(t, err)
+	require.Equal(t, updatedRevenue.Balance, result.ToAccount.Balance)
+}
+
+func TestTransferTxFeeInsufficientFunds(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccount(t)
+	account2 := createAccountIn(t, account1.Currency)
+	createFlatFeeSchedule(t, createAccountIn(t, account1.Currency), 3)
+
+	// The amount alone is affordable, the fee on top of it isn't
+	_, err := store.TransferTx(context.Background(), TransferTxParam{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        account1.AvailableBalance() + account1.OverdraftLimit,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	updatedAccount1, err := store.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance, updatedAccount1.Balance)
+}
+
+func TestTransferTxFeeApproved(t *testing.T) {
+	store := NewStore(testDB, WithRiskRules(reviewRule))
+
+	account1 := fundAccount(t, createRandomAccount(t), 100)
+	account2 := createAccountIn(t, account1.Currency)
+	revenue := createAccountIn(t, account1.Currency)
+	createFlatFeeSchedule(t, revenue, 3)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParam{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, TransferFee{Amount: 3, AccountID: revenue.ID}, result.Fee)
+	require.Zero(t, result.FeeEntry.ID)
+	require.Equal(t, account1.Balance, result.FromAccount.Balance)
+
+	// The fee is charged with the transfer once it is approved
+	approved, err := store.ApproveTransferTx(context.Background(), ReviewTransferTxParam{
+		TransferID: result.Transfer.ID,
+		ReviewedBy: createRandomUser(t).Username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance-13, approved.FromAccount.Balance)
+	require.Equal(t, int64(-3), approved.FeeEntry.Amount)
+}
@@ -0,0 +1,83 @@
+package db
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+var ErrIdempotencyKeyReused = errors.New("idempotency key was already used with a different request")
+
+// IdempotencyParam records a request under a client provided key, so that retrying it returns the stored result
+type IdempotencyParam struct {
+	Key       string
+	Username  string
+	Retention time.Duration
+}
+
+// fingerprint hashes the request fields of arg, the idempotency param itself is not part of the request
+func fingerprint(arg interface{}) (string, error) {
+	b, err := json.Marshal(arg)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// replayIdempotentRequest loads the result stored under the key into result,
+// replayed is false when the key hasn't been used yet (or has expired)
+func replayIdempotentRequest(ctx context.Context, q *Queries, idem *IdempotencyParam, fp string, result interface{}) (replayed bool, err error) {
+	err = q.DeleteExpiredIdempotencyKeys(ctx, idem.Username)
+	if err != nil {
+		return
+	}
+
+	key, err := q.GetIdempotencyKey(ctx, GetIdempotencyKeyParams{
+		Username: idem.Username,
+		Key:      idem.Key,
+	})
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err = nil
+		}
+		return
+	}
+
+	if key.Fingerprint != fp {
+		err = ErrIdempotencyKeyReused
+		return
+	}
+
+	err = json.Unmarshal(key.Response, result)
+	return err == nil, err
+}
+
+func saveIdempotentRequest(ctx context.Context, q *Queries, idem *IdempotencyParam, fp string, result interface{}) error {
+	response, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.CreateIdempotencyKey(ctx, CreateIdempotencyKeyParams{
+		Key:         idem.Key,
+		Username:    idem.Username,
+		Fingerprint: fp,
+		Response:    response,
+		ExpiresAt:   time.Now().Add(idem.Retention),
+	})
+	return err
+}
+
+func isUniqueViolation(err error) bool {
+	pqErr, ok := err.(*pq.Error)
+	return ok && pqErr.Code.Name() == "unique_violation"
+}
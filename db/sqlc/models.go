@@ -5,11 +5,406 @@
 package db
 
 import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+type AccountStatus string
+
+const (
+	AccountStatusActive AccountStatus = "active"
+	AccountStatusFrozen AccountStatus = "frozen"
+	AccountStatusClosed AccountStatus = "closed"
+)
+
+func (e *AccountStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AccountStatus(s)
+	case string:
+		*e = AccountStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AccountStatus: %T", src)
+	}
+	return nil
+}
+
+type NullAccountStatus struct {
+	AccountStatus AccountStatus
+	Valid         bool // Valid is true if AccountStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAccountStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.AccountStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AccountStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAccountStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AccountStatus), nil
+}
+
+type EntryType string
+
+const (
+	EntryTypeTransfer   EntryType = "transfer"
+	EntryTypeDeposit    EntryType = "deposit"
+	EntryTypeWithdrawal EntryType = "withdrawal"
+	EntryTypeFee        EntryType = "fee"
+)
+
+func (e *EntryType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EntryType(s)
+	case string:
+		*e = EntryType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EntryType: %T", src)
+	}
+	return nil
+}
+
+type NullEntryType struct {
+	EntryType EntryType
+	Valid     bool // Valid is true if EntryType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEntryType) Scan(value interface{}) error {
+	if value == nil {
+		ns.EntryType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EntryType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEntryType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EntryType), nil
+}
+
+type FeeType string
+
+const (
+	FeeTypeFlat       FeeType = "flat"
+	FeeTypePercentage FeeType = "percentage"
+	FeeTypeTiered     FeeType = "tiered"
+)
+
+func (e *FeeType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = FeeType(s)
+	case string:
+		*e = FeeType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for FeeType: %T", src)
+	}
+	return nil
+}
+
+type NullFeeType struct {
+	FeeType FeeType
+	Valid   bool // Valid is true if FeeType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullFeeType) Scan(value interface{}) error {
+	if value == nil {
+		ns.FeeType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.FeeType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullFeeType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.FeeType), nil
+}
+
+type HoldStatus string
+
+const (
+	HoldStatusActive   HoldStatus = "active"
+	HoldStatusCaptured HoldStatus = "captured"
+	HoldStatusReleased HoldStatus = "released"
+	HoldStatusExpired  HoldStatus = "expired"
+)
+
+func (e *HoldStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = HoldStatus(s)
+	case string:
+		*e = HoldStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for HoldStatus: %T", src)
+	}
+	return nil
+}
+
+type NullHoldStatus struct {
+	HoldStatus HoldStatus
+	Valid      bool // Valid is true if HoldStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullHoldStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.HoldStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.HoldStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullHoldStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.HoldStatus), nil
+}
+
+type ScheduledTransferStatus string
+
+const (
+	ScheduledTransferStatusActive    ScheduledTransferStatus = "active"
+	ScheduledTransferStatusPaused    ScheduledTransferStatus = "paused"
+	ScheduledTransferStatusCompleted ScheduledTransferStatus = "completed"
+	ScheduledTransferStatusFailed    ScheduledTransferStatus = "failed"
+	ScheduledTransferStatusCancelled ScheduledTransferStatus = "cancelled"
+)
+
+func (e *ScheduledTransferStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ScheduledTransferStatus(s)
+	case string:
+		*e = ScheduledTransferStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ScheduledTransferStatus: %T", src)
+	}
+	return nil
+}
+
+type NullScheduledTransferStatus struct {
+	ScheduledTransferStatus ScheduledTransferStatus
+	Valid                   bool // Valid is true if ScheduledTransferStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullScheduledTransferStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ScheduledTransferStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ScheduledTransferStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullScheduledTransferStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ScheduledTransferStatus), nil
+}
+
+type TransferFrequency string
+
+const (
+	TransferFrequencyOnce    TransferFrequency = "once"
+	TransferFrequencyDaily   TransferFrequency = "daily"
+	TransferFrequencyWeekly  TransferFrequency = "weekly"
+	TransferFrequencyMonthly TransferFrequency = "monthly"
+)
+
+func (e *TransferFrequency) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TransferFrequency(s)
+	case string:
+		*e = TransferFrequency(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TransferFrequency: %T", src)
+	}
+	return nil
+}
+
+type NullTransferFrequency struct {
+	TransferFrequency TransferFrequency
+	Valid             bool // Valid is true if TransferFrequency is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTransferFrequency) Scan(value interface{}) error {
+	if value == nil {
+		ns.TransferFrequency, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TransferFrequency.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTransferFrequency) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TransferFrequency), nil
+}
+
+type TransferStatus string
+
+const (
+	TransferStatusCompleted     TransferStatus = "completed"
+	TransferStatusPendingReview TransferStatus = "pending_review"
+	TransferStatusRejected      TransferStatus = "rejected"
+)
+
+func (e *TransferStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TransferStatus(s)
+	case string:
+		*e = TransferStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TransferStatus: %T", src)
+	}
+	return nil
+}
+
+type NullTransferStatus struct {
+	TransferStatus TransferStatus
+	Valid          bool // Valid is true if TransferStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTransferStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.TransferStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TransferStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTransferStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TransferStatus), nil
+}
+
+type UserRole string
+
+const (
+	UserRoleCustomer UserRole = "customer"
+	UserRoleTeller   UserRole = "teller"
+	UserRoleAdmin    UserRole = "admin"
+)
+
+func (e *UserRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = UserRole(s)
+	case string:
+		*e = UserRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for UserRole: %T", src)
+	}
+	return nil
+}
+
+type NullUserRole struct {
+	UserRole UserRole
+	Valid    bool // Valid is true if UserRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullUserRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.UserRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.UserRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullUserRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.UserRole), nil
+}
+
+type UserTier string
+
+const (
+	UserTierNew      UserTier = "new"
+	UserTierVerified UserTier = "verified"
+)
+
+func (e *UserTier) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = UserTier(s)
+	case string:
+		*e = UserTier(s)
+	default:
+		return fmt.Errorf("unsupported scan type for UserTier: %T", src)
+	}
+	return nil
+}
+
+type NullUserTier struct {
+	UserTier UserTier
+	Valid    bool // Valid is true if UserTier is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullUserTier) Scan(value interface{}) error {
+	if value == nil {
+		ns.UserTier, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.UserTier.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullUserTier) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.UserTier), nil
+}
+
 type Account struct {
 	ID        int64     `json:"id"`
 	Owner     string    `json:"owner"`
@@ -17,6 +412,42 @@ type Account struct {
 	Currency  string    `json:"currency"`
 	CreatedAt time.Time `json:"created_at"`
 	IsDeleted bool      `json:"is_deleted"`
+	// how far below zero the balance is allowed to go
+	OverdraftLimit int64 `json:"overdraft_limit"`
+	// frozen accounts can be credited but not debited, closed ones can be neither
+	Status AccountStatus `json:"status"`
+	// sum of the active holds of the account, the available balance is balance - held_amount
+	HeldAmount int64 `json:"held_amount"`
+}
+
+type AccountStatusChange struct {
+	ID         int64         `json:"id"`
+	AccountID  int64         `json:"account_id"`
+	FromStatus AccountStatus `json:"from_status"`
+	ToStatus   AccountStatus `json:"to_status"`
+	// user who made the change, the owner or an admin
+	ChangedBy string `json:"changed_by"`
+	Reason    string `json:"reason"`
+	// transfer that moved the balance out of the account when it was closed
+	SweepTransferID sql.NullInt64 `json:"sweep_transfer_id"`
+	CreatedAt       time.Time     `json:"created_at"`
+}
+
+type AuditEvent struct {
+	ID            int64  `json:"id"`
+	ActorUsername string `json:"actor_username"`
+	// session of the access token the change was made with, null when the actor has none
+	SessionID  uuid.NullUUID `json:"session_id"`
+	ClientIp   string        `json:"client_ip"`
+	UserAgent  string        `json:"user_agent"`
+	Action     string        `json:"action"`
+	TargetType string        `json:"target_type"`
+	TargetID   string        `json:"target_id"`
+	// target before the change, null when the change created it
+	Before json.RawMessage `json:"before"`
+	// target after the change
+	After     json.RawMessage `json:"after"`
+	CreatedAt time.Time       `json:"created_at"`
 }
 
 type Entry struct {
@@ -25,6 +456,95 @@ type Entry struct {
 	// can be negative or positive
 	Amount    int64     `json:"amount"`
 	CreatedAt time.Time `json:"created_at"`
+	Type      EntryType `json:"type"`
+	// reference of the deposit or withdrawal in the external system (teller receipt, payment id)
+	ExternalRef sql.NullString `json:"external_ref"`
+	// transfer that produced the entry, null for deposits and withdrawals
+	TransferID sql.NullInt64 `json:"transfer_id"`
+	// balance of the account right after the entry was applied
+	BalanceAfter int64  `json:"balance_after"`
+	Description  string `json:"description"`
+}
+
+// fees charged to the sender of a transfer on top of its amount, in the currency of the sender account
+type FeeSchedule struct {
+	ID       int64  `json:"id"`
+	Currency string `json:"currency"`
+	// whether the schedule applies to the transfers into an account of another currency or of the same one
+	CrossCurrency bool    `json:"cross_currency"`
+	Type          FeeType `json:"type"`
+	// fee of the flat schedules
+	FlatAmount int64 `json:"flat_amount"`
+	// fee of the percentage schedules, in hundredths of a percent of the amount rounded down
+	BasisPoints int64 `json:"basis_points"`
+	// bands of the tiered schedules ordered by up_to, [{"up_to": 100000, "flat_amount": 100, "basis_points": 0}, {"up_to": null, ...}], an amount is charged the flat_amount and the basis_points of the first band it fits in
+	Tiers json.RawMessage `json:"tiers"`
+	// lowest fee of the percentage and tiered schedules
+	MinAmount int64 `json:"min_amount"`
+	// highest fee of the percentage and tiered schedules, null for no cap
+	MaxAmount sql.NullInt64 `json:"max_amount"`
+	// house account credited with the fees, it must be in the currency of the schedule
+	RevenueAccountID int64     `json:"revenue_account_id"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+type Hold struct {
+	ID int64 `json:"id"`
+	// account the amount is reserved on
+	AccountID int64 `json:"account_id"`
+	// account credited when the hold is captured
+	ToAccountID int64      `json:"to_account_id"`
+	Amount      int64      `json:"amount"`
+	Description string     `json:"description"`
+	Status      HoldStatus `json:"status"`
+	// active holds are released once it passes
+	ExpiresAt time.Time `json:"expires_at"`
+	// part of amount transferred by the capture, the rest is released
+	CapturedAmount int64 `json:"captured_amount"`
+	// transfer made by the capture
+	TransferID sql.NullInt64 `json:"transfer_id"`
+	// when the hold was captured, released or expired
+	ClosedAt  sql.NullTime `json:"closed_at"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+type IdempotencyKey struct {
+	Key      string `json:"key"`
+	Username string `json:"username"`
+	// sha256 of the request the key was first used with
+	Fingerprint string          `json:"fingerprint"`
+	Response    json.RawMessage `json:"response"`
+	ExpiresAt   time.Time       `json:"expires_at"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+type ScheduledTransfer struct {
+	ID            int64             `json:"id"`
+	Owner         string            `json:"owner"`
+	FromAccountID int64             `json:"from_account_id"`
+	ToAccountID   int64             `json:"to_account_id"`
+	Amount        int64             `json:"amount"`
+	Frequency     TransferFrequency `json:"frequency"`
+	StartAt       time.Time         `json:"start_at"`
+	// no occurrence is run after it, null repeats forever
+	EndAt     sql.NullTime `json:"end_at"`
+	NextRunAt time.Time    `json:"next_run_at"`
+	// index of next_run_at in the schedule, 0 is start_at
+	Occurrence int32                   `json:"occurrence"`
+	Status     ScheduledTransferStatus `json:"status"`
+	CreatedAt  time.Time               `json:"created_at"`
+}
+
+type ScheduledTransferAttempt struct {
+	ID                  int64     `json:"id"`
+	ScheduledTransferID int64     `json:"scheduled_transfer_id"`
+	Occurrence          int32     `json:"occurrence"`
+	ScheduledFor        time.Time `json:"scheduled_for"`
+	// transfer made by the attempt, null when it failed
+	TransferID sql.NullInt64 `json:"transfer_id"`
+	// why the attempt failed, null when it succeeded
+	Error     sql.NullString `json:"error"`
+	CreatedAt time.Time      `json:"created_at"`
 }
 
 type Session struct {
@@ -36,6 +556,10 @@ type Session struct {
 	ClientIp     string    `json:"client_ip"`
 	ExpiresAt    time.Time `json:"expires_at"`
 	CreatedAt    time.Time `json:"created_at"`
+	// id of the session created on login, shared by every session rotated out of it
+	FamilyID uuid.UUID `json:"family_id"`
+	// when the refresh token was renewed, using it again blocks the whole family
+	RotatedAt sql.NullTime `json:"rotated_at"`
 }
 
 type Transfer struct {
@@ -45,6 +569,53 @@ type Transfer struct {
 	// must be positive
 	Amount    int64     `json:"amount"`
 	CreatedAt time.Time `json:"created_at"`
+	// amount credited in the currency of the destination account
+	ToAmount int64 `json:"to_amount"`
+	// exchange rate used to convert amount into to_amount, scaled by 1e8
+	FxRate int64 `json:"fx_rate"`
+	// when the exchange rate was published
+	FxRateAt time.Time `json:"fx_rate_at"`
+	// transfer this one reverses, it moves the money back from its recipient to its sender
+	ReversalOf sql.NullInt64 `json:"reversal_of"`
+	// part of amount given back to the sender by reversals so far, the transfer is fully reversed once it reaches amount
+	ReversedAmount int64 `json:"reversed_amount"`
+	// when the transfer was last reversed
+	ReversedAt sql.NullTime `json:"reversed_at"`
+	// pending_review transfers are held by a risk rule and move no money until an admin approves them, rejected ones never do
+	Status TransferStatus `json:"status"`
+	// risk rule that put the transfer into review
+	RiskRule sql.NullString `json:"risk_rule"`
+	// why the risk rule put the transfer into review
+	RiskReason sql.NullString `json:"risk_reason"`
+	// admin who approved or rejected the transfer
+	ReviewedBy sql.NullString `json:"reviewed_by"`
+	// why the admin approved or rejected the transfer
+	ReviewNote sql.NullString `json:"review_note"`
+	// when the transfer was approved or rejected
+	ReviewedAt sql.NullTime `json:"reviewed_at"`
+	// fee debited from the sender on top of amount, in the currency of the sender account
+	FeeAmount int64 `json:"fee_amount"`
+	// house account credited with the fee, null when there is no fee
+	FeeAccountID sql.NullInt64 `json:"fee_account_id"`
+}
+
+// velocity limits of the transfers out of an account, the ones of the account override the ones of its owner, which override the ones of their tier
+type TransferLimit struct {
+	ID        int64          `json:"id"`
+	Tier      NullUserTier   `json:"tier"`
+	Username  sql.NullString `json:"username"`
+	AccountID sql.NullInt64  `json:"account_id"`
+	// currency of the amounts of the limits of a tier or a user, they only count the accounts in that currency. The limits of an account are in its currency
+	Currency sql.NullString `json:"currency"`
+	// maximum amount of a single transfer, null inherits it
+	MaxAmount sql.NullInt64 `json:"max_amount"`
+	// maximum total amount per UTC day, null inherits it
+	DailyAmount sql.NullInt64 `json:"daily_amount"`
+	// maximum total amount per UTC month, null inherits it
+	MonthlyAmount sql.NullInt64 `json:"monthly_amount"`
+	// maximum number of transfers per UTC day, null inherits it
+	DailyCount sql.NullInt32 `json:"daily_count"`
+	CreatedAt  time.Time     `json:"created_at"`
 }
 
 type User struct {
@@ -54,4 +625,9 @@ type User struct {
 	Email             string    `json:"email"`
 	PasswordChangedAt time.Time `json:"password_changed_at"`
 	CreatedAt         time.Time `json:"created_at"`
+	IsDeleted         bool      `json:"is_deleted"`
+	// admins can view any account and block sessions of any user, tellers and admins make deposits and withdrawals
+	Role UserRole `json:"role"`
+	// picks the default transfer limits of the accounts of the user
+	Tier UserTier `json:"tier"`
 }
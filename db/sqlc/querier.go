@@ -6,28 +6,92 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
+	AddTransferReversedAmount(ctx context.Context, arg AddTransferReversedAmountParams) (Transfer, error)
+	AdvanceScheduledTransfer(ctx context.Context, arg AdvanceScheduledTransferParams) (ScheduledTransfer, error)
+	BlockOtherUserSessions(ctx context.Context, arg BlockOtherUserSessionsParams) error
+	BlockSession(ctx context.Context, id uuid.UUID) error
+	BlockSessionFamily(ctx context.Context, familyID uuid.UUID) error
+	BlockUserSessions(ctx context.Context, username string) error
+	CloseHold(ctx context.Context, arg CloseHoldParams) (Hold, error)
+	CountOwnerTransfersTo(ctx context.Context, arg CountOwnerTransfersToParams) (int32, error)
+	CountTransfersSince(ctx context.Context, arg CountTransfersSinceParams) (int32, error)
 	CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error)
+	CreateAccountStatusChange(ctx context.Context, arg CreateAccountStatusChangeParams) (AccountStatusChange, error)
+	CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) (AuditEvent, error)
 	CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error)
+	CreateFeeSchedule(ctx context.Context, arg CreateFeeScheduleParams) (FeeSchedule, error)
+	CreateHold(ctx context.Context, arg CreateHoldParams) (Hold, error)
+	CreateIdempotencyKey(ctx context.Context, arg CreateIdempotencyKeyParams) (IdempotencyKey, error)
+	CreatePendingTransfer(ctx context.Context, arg CreatePendingTransferParams) (Transfer, error)
+	CreateReversalTransfer(ctx context.Context, arg CreateReversalTransferParams) (Transfer, error)
+	CreateScheduledTransfer(ctx context.Context, arg CreateScheduledTransferParams) (ScheduledTransfer, error)
+	CreateScheduledTransferAttempt(ctx context.Context, arg CreateScheduledTransferAttemptParams) (ScheduledTransferAttempt, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
 	CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error)
+	CreateTransferLimit(ctx context.Context, arg CreateTransferLimitParams) (TransferLimit, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	DebitAccountBalance(ctx context.Context, arg DebitAccountBalanceParams) (Account, error)
 	DeleteAccount(ctx context.Context, id int64) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context, username string) error
+	DeleteFeeSchedule(ctx context.Context, id int64) error
+	DeleteUser(ctx context.Context, username string) error
+	DeleteUserAccounts(ctx context.Context, owner string) error
 	GetAccount(ctx context.Context, id int64) (Account, error)
+	GetAccountBalanceAt(ctx context.Context, arg GetAccountBalanceAtParams) (int64, error)
+	GetAccountForUpdate(ctx context.Context, id int64) (Account, error)
 	GetAccounts(ctx context.Context, owner string) ([]Account, error)
 	GetDeletedAccounts(ctx context.Context, owner string) ([]Account, error)
+	GetDueScheduledTransferForUpdate(ctx context.Context, now time.Time) (ScheduledTransfer, error)
 	GetEntry(ctx context.Context, id int64) (Entry, error)
+	GetExpiredHoldForUpdate(ctx context.Context, now time.Time) (Hold, error)
+	GetFeeSchedule(ctx context.Context, arg GetFeeScheduleParams) (FeeSchedule, error)
+	GetHold(ctx context.Context, id int64) (Hold, error)
+	GetHoldForUpdate(ctx context.Context, id int64) (Hold, error)
+	GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error)
+	GetNewIPSession(ctx context.Context, arg GetNewIPSessionParams) (GetNewIPSessionRow, error)
+	GetScheduledTransfer(ctx context.Context, id int64) (ScheduledTransfer, error)
 	GetSession(ctx context.Context, id uuid.UUID) (Session, error)
+	GetSessionForUpdate(ctx context.Context, id uuid.UUID) (Session, error)
 	GetTransfer(ctx context.Context, id int64) (Transfer, error)
+	GetTransferForUpdate(ctx context.Context, id int64) (Transfer, error)
+	GetTransferUsage(ctx context.Context, arg GetTransferUsageParams) (GetTransferUsageRow, error)
 	GetUser(ctx context.Context, username string) (User, error)
+	GetUserAccountsForUpdate(ctx context.Context, owner string) ([]Account, error)
+	GetUserForUpdate(ctx context.Context, username string) (User, error)
+	HoldAccountFunds(ctx context.Context, arg HoldAccountFundsParams) (Account, error)
+	IsSessionBlocked(ctx context.Context, id uuid.UUID) (bool, error)
+	ListAccountHolds(ctx context.Context, accountID int64) ([]Hold, error)
+	ListAccountStatusChanges(ctx context.Context, accountID int64) ([]AccountStatusChange, error)
+	ListActiveUserSessions(ctx context.Context, username string) ([]Session, error)
+	ListApplicableTransferLimits(ctx context.Context, arg ListApplicableTransferLimitsParams) ([]TransferLimit, error)
+	ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditEvent, error)
 	ListEntries(ctx context.Context, arg ListEntriesParams) ([]Entry, error)
+	ListEntriesBetween(ctx context.Context, arg ListEntriesBetweenParams) ([]Entry, error)
+	ListPendingTransfers(ctx context.Context, arg ListPendingTransfersParams) ([]Transfer, error)
+	ListScheduledTransferAttempts(ctx context.Context, arg ListScheduledTransferAttemptsParams) ([]ScheduledTransferAttempt, error)
+	ListScheduledTransfers(ctx context.Context, owner string) ([]ScheduledTransfer, error)
+	ListStatementLines(ctx context.Context, arg ListStatementLinesParams) ([]ListStatementLinesRow, error)
+	ListTransferReversals(ctx context.Context, reversalOf sql.NullInt64) ([]Transfer, error)
 	ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error)
+	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	ReleaseAccountFunds(ctx context.Context, arg ReleaseAccountFundsParams) (Account, error)
 	RestoreAccount(ctx context.Context, id int64) error
+	ReviewTransfer(ctx context.Context, arg ReviewTransferParams) (Transfer, error)
+	RotateSession(ctx context.Context, id uuid.UUID) (Session, error)
+	SearchTransfersByAmountAsc(ctx context.Context, arg SearchTransfersByAmountAscParams) ([]Transfer, error)
+	SearchTransfersByAmountDesc(ctx context.Context, arg SearchTransfersByAmountDescParams) ([]Transfer, error)
+	SearchTransfersByDateAsc(ctx context.Context, arg SearchTransfersByDateAscParams) ([]Transfer, error)
+	SearchTransfersByDateDesc(ctx context.Context, arg SearchTransfersByDateDescParams) ([]Transfer, error)
 	UpdateAccountBalance(ctx context.Context, arg UpdateAccountBalanceParams) (Account, error)
+	UpdateAccountStatus(ctx context.Context, arg UpdateAccountStatusParams) (Account, error)
+	UpdateScheduledTransfer(ctx context.Context, arg UpdateScheduledTransferParams) (ScheduledTransfer, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
 }
 
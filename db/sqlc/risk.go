@@ -0,0 +1,137 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/escalopa/gobank/fx"
+)
+
+var ErrTransferDenied = errors.New("transfer denied by a risk rule")
+
+// RiskVerdict is what a risk rule decides about a transfer
+type RiskVerdict string
+
+const (
+	RiskAllow  RiskVerdict = "allow"
+	RiskReview RiskVerdict = "review"
+	RiskDeny   RiskVerdict = "deny"
+)
+
+type RiskDecision struct {
+	Verdict RiskVerdict `json:"verdict"`
+	// Reason tells the admin reviewing the transfer, or the user whose transfer is denied, why the rule decided so
+	Reason string `json:"reason"`
+}
+
+// RiskTransfer is a transfer assessed by the risk rules, both of its accounts are locked
+type RiskTransfer struct {
+	From   Account   `json:"from"`
+	To     Account   `json:"to"`
+	Amount int64     `json:"amount"`
+	Now    time.Time `json:"now"`
+}
+
+// RiskRule assesses a transfer inside TransferTx before it commits, q runs in the transaction of the transfer
+type RiskRule interface {
+	// Name identifies the rule in the reviews and the errors of the transfers it holds or denies
+	Name() string
+	Assess(ctx context.Context, q Querier, transfer RiskTransfer) (RiskDecision, error)
+}
+
+// RiskDeniedError tells which rule denied a transfer and why, errors.Is matches it with ErrTransferDenied
+type RiskDeniedError struct {
+	Rule   string `json:"rule"`
+	Reason string `json:"reason"`
+}
+
+func (e *RiskDeniedError) Error() string {
+	return fmt.Sprintf("%s: %s, %s", ErrTransferDenied, e.Rule, e.Reason)
+}
+
+func (e *RiskDeniedError) Unwrap() error {
+	return ErrTransferDenied
+}
+
+// riskReview is the rule that put a transfer into review and why
+type riskReview struct {
+	Rule   string
+	Reason string
+}
+
+// assessRisk runs the rules in order and stops at the first one denying the transfer. Otherwise the first rule
+// putting it into review is returned, nil when every rule allows it
+func assessRisk(ctx context.Context, q *Queries, rules []RiskRule, transfer RiskTransfer) (*riskReview, error) {
+	var review *riskReview
+
+	for _, rule := range rules {
+		decision, err := rule.Assess(ctx, q, transfer)
+		if err != nil {
+			return nil, fmt.Errorf("cannot assess transfer with risk rule %s, %w", rule.Name(), err)
+		}
+
+		switch decision.Verdict {
+		case RiskDeny:
+			return nil, &RiskDeniedError{Rule: rule.Name(), Reason: decision.Reason}
+		case RiskReview:
+			if review == nil {
+				review = &riskReview{Rule: rule.Name(), Reason: decision.Reason}
+			}
+		}
+	}
+
+	return review, nil
+}
+
+// checkSettledTransfer checks a transfer that settles in the transaction making it, the sweep of a closed account or
+// the capture of a hold, against the limits and the risk rules like TransferTx does. Such a transfer can't wait for a
+// review, so a rule putting it into review refuses it like a deny would. Both accounts must be locked
+func (store *SQLStore) checkSettledTransfer(ctx context.Context, q *Queries, from, to Account, amount int64) error {
+	now := time.Now()
+	if err := checkTransferLimits(ctx, q, from, amount, now); err != nil {
+		return err
+	}
+
+	review, err := assessRisk(ctx, q, store.riskRules, RiskTransfer{From: from, To: to, Amount: amount, Now: now})
+	if err != nil {
+		return err
+	}
+
+	if review != nil {
+		return &RiskDeniedError{Rule: review.Rule, Reason: review.Reason}
+	}
+	return nil
+}
+
+// pendingTransfer stores a transfer put into review without moving any money. It is checked like a debit would be,
+// so that a transfer that can't be made isn't left for an admin to review
+func pendingTransfer(ctx context.Context, q *Queries, from, to Account, amount, toAmount int64, rate fx.Rate, fee TransferFee, review riskReview) (results TransferTxResult, err error) {
+	if err = from.CanDebit(); err != nil {
+		return
+	}
+	if err = to.CanCredit(); err != nil {
+		return
+	}
+	if from.AvailableBalance()-amount-fee.Amount < -from.OverdraftLimit {
+		err = ErrInsufficientFunds
+		return
+	}
+
+	results.FromAccount, results.ToAccount, results.Fee = from, to, fee
+	results.Transfer, err = q.CreatePendingTransfer(ctx, CreatePendingTransferParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        amount,
+		ToAmount:      toAmount,
+		FxRate:        rate.Value,
+		FxRateAt:      rate.UpdatedAt,
+		RiskRule:      sql.NullString{String: review.Rule, Valid: true},
+		RiskReason:    sql.NullString{String: review.Reason, Valid: true},
+		FeeAmount:     fee.Amount,
+		FeeAccountID:  sql.NullInt64{Int64: fee.AccountID, Valid: fee.AccountID != 0},
+	})
+	return
+}
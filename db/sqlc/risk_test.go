@@ -0,0 +1,143 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type stubRiskRule struct {
+	name     string
+	decision RiskDecision
+}
+
+func (r stubRiskRule) Name() string {
+	return r.name
+}
+
+func (r stubRiskRule) Assess(_ context.Context, _ Querier, _ RiskTransfer) (RiskDecision, error) {
+	return r.decision, nil
+}
+
+var (
+	allowRule  = stubRiskRule{name: "allow", decision: RiskDecision{Verdict: RiskAllow}}
+	reviewRule = stubRiskRule{name: "review", decision: RiskDecision{Verdict: RiskReview, Reason: "looks odd"}}
+	denyRule   = stubRiskRule{name: "deny", decision: RiskDecision{Verdict: RiskDeny, Reason: "looks bad"}}
+)
+
+func TestTransferTxRiskDenied(t *testing.T) {
+	store := NewStore(testDB, WithRiskRules(allowRule, reviewRule, denyRule))
+
+	account1, account2 := createRandomAccount(t), createRandomAccount(t)
+	account1 = fundAccount(t, account1, 100)
+
+	_, err := store.TransferTx(context.Background(), TransferTxParam{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+
+	// A deny wins over an earlier review
+	var deniedErr *RiskDeniedError
+	require.ErrorAs(t, err, &deniedErr)
+	require.ErrorIs(t, err, ErrTransferDenied)
+	require.Equal(t, RiskDeniedError{Rule: "deny", Reason: "looks bad"}, *deniedErr)
+
+	updatedAccount1, err := store.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance, updatedAccount1.Balance)
+}
+
+func TestTransferTxRiskReviewApproved(t *testing.T) {
+	store := NewStore(testDB, WithRiskRules(allowRule, reviewRule))
+	admin := createRandomUser(t)
+
+	account1, account2 := createRandomAccount(t), createRandomAccount(t)
+	account1 = fundAccount(t, account1, 100)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParam{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, TransferStatusPendingReview, result.Transfer.Status)
+	require.Equal(t, "review", result.Transfer.RiskRule.String)
+	require.Equal(t, "looks odd", result.Transfer.RiskReason.String)
+	require.Zero(t, result.FromEntry.ID)
+	require.Equal(t, account1.Balance, result.FromAccount.Balance)
+	require.Equal(t, account2.Balance, result.ToAccount.Balance)
+
+	// No money moved yet, so there is nothing to reverse
+	_, err = store.ReverseTransferTx(context.Background(), ReverseTransferTxParam{TransferID: result.Transfer.ID})
+	require.ErrorIs(t, err, ErrTransferNotCompleted)
+
+	approved, err := store.ApproveTransferTx(context.Background(), ReviewTransferTxParam{
+		TransferID: result.Transfer.ID,
+		ReviewedBy: admin.Username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, TransferStatusCompleted, approved.Transfer.Status)
+	require.Equal(t, admin.Username, approved.Transfer.ReviewedBy.String)
+	require.False(t, approved.Transfer.ReviewNote.Valid)
+	require.True(t, approved.Transfer.ReviewedAt.Valid)
+	require.Equal(t, account1.Balance-10, approved.FromAccount.Balance)
+	require.Equal(t, account2.Balance+10, approved.ToAccount.Balance)
+	require.Equal(t, approved.Transfer.ID, approved.FromEntry.TransferID.Int64)
+	require.Equal(t, approved.FromAccount.Balance, approved.FromEntry.BalanceAfter)
+
+	_, err = store.ApproveTransferTx(context.Background(), ReviewTransferTxParam{
+		TransferID: result.Transfer.ID,
+		ReviewedBy: admin.Username,
+	})
+	require.ErrorIs(t, err, ErrTransferNotPending)
+}
+
+func TestTransferTxRiskReviewRejected(t *testing.T) {
+	store := NewStore(testDB, WithRiskRules(reviewRule))
+	admin := createRandomUser(t)
+
+	account1, account2 := createRandomAccount(t), createRandomAccount(t)
+	account1 = fundAccount(t, account1, 100)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParam{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	rejected, err := store.RejectTransferTx(context.Background(), ReviewTransferTxParam{
+		TransferID: result.Transfer.ID,
+		ReviewedBy: admin.Username,
+		Note:       "the user didn't make it",
+	})
+	require.NoError(t, err)
+	require.Equal(t, TransferStatusRejected, rejected.Status)
+	require.Equal(t, "the user didn't make it", rejected.ReviewNote.String)
+
+	_, err = store.ApproveTransferTx(context.Background(), ReviewTransferTxParam{
+		TransferID: result.Transfer.ID,
+		ReviewedBy: admin.Username,
+	})
+	require.ErrorIs(t, err, ErrTransferNotPending)
+
+	updatedAccount1, err := store.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance, updatedAccount1.Balance)
+}
+
+func TestTransferTxRiskReviewInsufficientFunds(t *testing.T) {
+	store := NewStore(testDB, WithRiskRules(reviewRule))
+
+	account1, account2 := createRandomAccount(t), createRandomAccount(t)
+
+	// A transfer that can't be made isn't left for review
+	_, err := store.TransferTx(context.Background(), TransferTxParam{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        account1.AvailableBalance() + account1.OverdraftLimit + 1,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+}
@@ -0,0 +1,94 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrInvalidSchedule = errors.New("invalid schedule")
+
+// Validate checks a new schedule, its first occurrence must be after now and it must not end before it
+func (arg CreateScheduledTransferParams) Validate(now time.Time) error {
+	switch arg.Frequency {
+	case TransferFrequencyOnce, TransferFrequencyDaily, TransferFrequencyWeekly, TransferFrequencyMonthly:
+	default:
+		return fmt.Errorf("%w: unknown frequency %q", ErrInvalidSchedule, arg.Frequency)
+	}
+
+	if !arg.StartAt.After(now) {
+		return fmt.Errorf("%w: start_at must be in the future", ErrInvalidSchedule)
+	}
+
+	if arg.EndAt.Valid && arg.EndAt.Time.Before(arg.StartAt) {
+		return fmt.Errorf("%w: end_at must not be before start_at", ErrInvalidSchedule)
+	}
+	return nil
+}
+
+// ValidateEnd checks that the schedule still has its next occurrence to run when it ends at end
+func (st ScheduledTransfer) ValidateEnd(end time.Time) error {
+	if end.Before(st.NextRunAt) {
+		return fmt.Errorf("%w: end_at must not be before next_run_at", ErrInvalidSchedule)
+	}
+	return nil
+}
+
+// IsOpen reports whether the schedule can still be updated, closed schedules never run again
+func (st ScheduledTransfer) IsOpen() bool {
+	return st.Status == ScheduledTransferStatusActive || st.Status == ScheduledTransferStatusPaused
+}
+
+// IdempotencyKey identifies the transfer of the current occurrence of the schedule
+func (st ScheduledTransfer) IdempotencyKey() string {
+	return fmt.Sprintf("scheduled-transfer-%d-%d", st.ID, st.Occurrence)
+}
+
+// RunAt returns the time of the nth occurrence of the schedule, monthly schedules starting
+// at the end of a month run on the last day of shorter months
+func (st ScheduledTransfer) RunAt(n int32) time.Time {
+	start := st.StartAt.UTC()
+	switch st.Frequency {
+	case TransferFrequencyDaily:
+		return start.AddDate(0, 0, int(n))
+	case TransferFrequencyWeekly:
+		return start.AddDate(0, 0, 7*int(n))
+	case TransferFrequencyMonthly:
+		year, month, day := start.Date()
+		first := time.Date(year, month+time.Month(n), 1, start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), time.UTC)
+		if last := first.AddDate(0, 1, -1).Day(); day > last {
+			day = last
+		}
+		return first.AddDate(0, 0, day-1)
+	}
+	return start
+}
+
+// next returns the schedule after its current occurrence was attempted at now, occurrences missed
+// while the schedule was paused or the workers were down are skipped rather than run back to back
+func (st ScheduledTransfer) next(now time.Time, succeeded bool) AdvanceScheduledTransferParams {
+	arg := AdvanceScheduledTransferParams{
+		ID:         st.ID,
+		NextRunAt:  st.NextRunAt,
+		Occurrence: st.Occurrence,
+		Status:     ScheduledTransferStatusCompleted,
+	}
+
+	if st.Frequency == TransferFrequencyOnce {
+		if !succeeded {
+			arg.Status = ScheduledTransferStatusFailed
+		}
+		return arg
+	}
+
+	arg.Occurrence++
+	for !st.RunAt(arg.Occurrence).After(now) {
+		arg.Occurrence++
+	}
+	arg.NextRunAt = st.RunAt(arg.Occurrence)
+
+	if !st.EndAt.Valid || !arg.NextRunAt.After(st.EndAt.Time) {
+		arg.Status = ScheduledTransferStatusActive
+	}
+	return arg
+}
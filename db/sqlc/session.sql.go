@@ -12,6 +12,56 @@ import (
 	"github.com/google/uuid"
 )
 
+const blockOtherUserSessions = `-- name: BlockOtherUserSessions :exec
+UPDATE "sessions"
+SET is_blocked = true
+WHERE username = $1
+  AND family_id <> $2
+`
+
+type BlockOtherUserSessionsParams struct {
+	Username string    `json:"username"`
+	FamilyID uuid.UUID `json:"family_id"`
+}
+
+func (q *Queries) BlockOtherUserSessions(ctx context.Context, arg BlockOtherUserSessionsParams) error {
+	_, err := q.db.ExecContext(ctx, blockOtherUserSessions, arg.Username, arg.FamilyID)
+	return err
+}
+
+const blockSession = `-- name: BlockSession :exec
+UPDATE "sessions"
+SET is_blocked = true
+WHERE id = $1
+`
+
+func (q *Queries) BlockSession(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, blockSession, id)
+	return err
+}
+
+const blockSessionFamily = `-- name: BlockSessionFamily :exec
+UPDATE "sessions"
+SET is_blocked = true
+WHERE family_id = $1
+`
+
+func (q *Queries) BlockSessionFamily(ctx context.Context, familyID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, blockSessionFamily, familyID)
+	return err
+}
+
+const blockUserSessions = `-- name: BlockUserSessions :exec
+UPDATE "sessions"
+SET is_blocked = true
+WHERE username = $1
+`
+
+func (q *Queries) BlockUserSessions(ctx context.Context, username string) error {
+	_, err := q.db.ExecContext(ctx, blockUserSessions, username)
+	return err
+}
+
 const createSession = `-- name: CreateSession :one
 INSERT INTO "sessions" (
     id,
@@ -19,10 +69,11 @@ INSERT INTO "sessions" (
     refresh_token,
     user_agent,
     client_ip,
-    expires_at
+    expires_at,
+    family_id
   )
-VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, username, refresh_token, is_blocked, user_agent, client_ip, expires_at, created_at
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, username, refresh_token, is_blocked, user_agent, client_ip, expires_at, created_at, family_id, rotated_at
 `
 
 type CreateSessionParams struct {
@@ -32,6 +83,7 @@ type CreateSessionParams struct {
 	UserAgent    string    `json:"user_agent"`
 	ClientIp     string    `json:"client_ip"`
 	ExpiresAt    time.Time `json:"expires_at"`
+	FamilyID     uuid.UUID `json:"family_id"`
 }
 
 func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error) {
@@ -42,6 +94,7 @@ func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) (S
 		arg.UserAgent,
 		arg.ClientIp,
 		arg.ExpiresAt,
+		arg.FamilyID,
 	)
 	var i Session
 	err := row.Scan(
@@ -53,12 +106,56 @@ func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) (S
 		&i.ClientIp,
 		&i.ExpiresAt,
 		&i.CreatedAt,
+		&i.FamilyID,
+		&i.RotatedAt,
 	)
 	return i, err
 }
 
+const getNewIPSession = `-- name: GetNewIPSession :one
+SELECT s.id,
+  s.client_ip,
+  s.created_at
+FROM "sessions" AS s
+WHERE s.username = $1
+  AND s.created_at >= $2
+  AND EXISTS (
+    SELECT 1
+    FROM "sessions" AS o
+    WHERE o.username = s.username
+      AND o.created_at < s.created_at
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM "sessions" AS o
+    WHERE o.username = s.username
+      AND o.client_ip = s.client_ip
+      AND o.created_at < s.created_at
+  )
+ORDER BY s.created_at DESC
+LIMIT 1
+`
+
+type GetNewIPSessionParams struct {
+	Username string    `json:"username"`
+	Since    time.Time `json:"since"`
+}
+
+type GetNewIPSessionRow struct {
+	ID        uuid.UUID `json:"id"`
+	ClientIp  string    `json:"client_ip"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (q *Queries) GetNewIPSession(ctx context.Context, arg GetNewIPSessionParams) (GetNewIPSessionRow, error) {
+	row := q.db.QueryRowContext(ctx, getNewIPSession, arg.Username, arg.Since)
+	var i GetNewIPSessionRow
+	err := row.Scan(&i.ID, &i.ClientIp, &i.CreatedAt)
+	return i, err
+}
+
 const getSession = `-- name: GetSession :one
-SELECT id, username, refresh_token, is_blocked, user_agent, client_ip, expires_at, created_at
+SELECT id, username, refresh_token, is_blocked, user_agent, client_ip, expires_at, created_at, family_id, rotated_at
 FROM "sessions"
 WHERE id = $1
 LIMIT 1
@@ -76,6 +173,116 @@ func (q *Queries) GetSession(ctx context.Context, id uuid.UUID) (Session, error)
 		&i.ClientIp,
 		&i.ExpiresAt,
 		&i.CreatedAt,
+		&i.FamilyID,
+		&i.RotatedAt,
+	)
+	return i, err
+}
+
+const getSessionForUpdate = `-- name: GetSessionForUpdate :one
+SELECT id, username, refresh_token, is_blocked, user_agent, client_ip, expires_at, created_at, family_id, rotated_at
+FROM "sessions"
+WHERE id = $1
+LIMIT 1 FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetSessionForUpdate(ctx context.Context, id uuid.UUID) (Session, error) {
+	row := q.db.QueryRowContext(ctx, getSessionForUpdate, id)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.RefreshToken,
+		&i.IsBlocked,
+		&i.UserAgent,
+		&i.ClientIp,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.FamilyID,
+		&i.RotatedAt,
+	)
+	return i, err
+}
+
+const isSessionBlocked = `-- name: IsSessionBlocked :one
+SELECT is_blocked
+FROM "sessions"
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) IsSessionBlocked(ctx context.Context, id uuid.UUID) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isSessionBlocked, id)
+	var isBlocked bool
+	err := row.Scan(&isBlocked)
+	return isBlocked, err
+}
+
+const listActiveUserSessions = `-- name: ListActiveUserSessions :many
+SELECT id, username, refresh_token, is_blocked, user_agent, client_ip, expires_at, created_at, family_id, rotated_at
+FROM "sessions"
+WHERE username = $1
+  AND is_blocked = false
+  AND rotated_at IS NULL
+  AND expires_at > now()
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListActiveUserSessions(ctx context.Context, username string) ([]Session, error) {
+	rows, err := q.db.QueryContext(ctx, listActiveUserSessions, username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Session{}
+	for rows.Next() {
+		var i Session
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.RefreshToken,
+			&i.IsBlocked,
+			&i.UserAgent,
+			&i.ClientIp,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.FamilyID,
+			&i.RotatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const rotateSession = `-- name: RotateSession :one
+UPDATE "sessions"
+SET rotated_at = now()
+WHERE id = $1
+RETURNING id, username, refresh_token, is_blocked, user_agent, client_ip, expires_at, created_at, family_id, rotated_at
+`
+
+func (q *Queries) RotateSession(ctx context.Context, id uuid.UUID) (Session, error) {
+	row := q.db.QueryRowContext(ctx, rotateSession, id)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.RefreshToken,
+		&i.IsBlocked,
+		&i.UserAgent,
+		&i.ClientIp,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.FamilyID,
+		&i.RotatedAt,
 	)
 	return i, err
 }
@@ -0,0 +1,46 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListActiveUserSessions(t *testing.T) {
+	store := NewStore(testDB)
+	ctx := context.Background()
+	user := createRandomUser(t)
+
+	login, err := store.CreateSession(ctx, createSessionParams(user.Username))
+	require.NoError(t, err)
+
+	renewal, err := store.RenewSessionTx(ctx, RenewSessionTxParam{SessionID: login.ID, Session: createSessionParams(user.Username)})
+	require.NoError(t, err)
+
+	other, err := store.CreateSession(ctx, createSessionParams(user.Username))
+	require.NoError(t, err)
+
+	// The rotated session is left out, only the latest session of each family is active
+	sessions, err := store.ListActiveUserSessions(ctx, user.Username)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	require.Equal(t, other.ID, sessions[0].ID)
+	require.Equal(t, renewal.Session.ID, sessions[1].ID)
+
+	err = store.BlockOtherUserSessions(ctx, BlockOtherUserSessionsParams{Username: user.Username, FamilyID: other.FamilyID})
+	require.NoError(t, err)
+
+	sessions, err = store.ListActiveUserSessions(ctx, user.Username)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, other.ID, sessions[0].ID)
+
+	blocked, err := store.IsSessionBlocked(ctx, login.ID)
+	require.NoError(t, err)
+	require.True(t, blocked)
+
+	blocked, err = store.IsSessionBlocked(ctx, other.ID)
+	require.NoError(t, err)
+	require.False(t, blocked)
+}
@@ -3,31 +3,80 @@ package db
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"sort"
+	"time"
+
+	"github.com/escalopa/gobank/fx"
+)
+
+var (
+	ErrInsufficientFunds       = errors.New("insufficient funds, the transfer exceeds the account overdraft limit")
+	ErrConvertedAmountTooSmall = errors.New("amount is too small to be converted into the destination currency")
 )
 
 type Store interface {
 	Querier
 	TransferTx(ctx context.Context, arg TransferTxParam) (TransferTxResult, error)
+	DeleteUserTx(ctx context.Context, username string) error
+	DepositTx(ctx context.Context, arg DepositTxParam) (EntryTxResult, error)
+	WithdrawTx(ctx context.Context, arg WithdrawTxParam) (EntryTxResult, error)
+	AccountStatementTx(ctx context.Context, arg AccountStatementParam) (AccountStatement, error)
+	StreamAccountStatementTx(ctx context.Context, arg StreamAccountStatementParam, consume func(StatementStream) error) error
+	SearchTransfers(ctx context.Context, arg SearchTransfersParam) ([]Transfer, error)
+	RunScheduledTransferTx(ctx context.Context, now time.Time, fn ScheduledTransferFunc) (ScheduledTransferAttempt, error)
+	ChangeAccountStatusTx(ctx context.Context, arg ChangeAccountStatusTxParam) (AccountStatusTxResult, error)
+	CloseAccountTx(ctx context.Context, arg CloseAccountTxParam) (AccountStatusTxResult, error)
+	RenewSessionTx(ctx context.Context, arg RenewSessionTxParam) (RenewSessionTxResult, error)
+	ReverseTransferTx(ctx context.Context, arg ReverseTransferTxParam) (ReverseTransferTxResult, error)
+	PlaceHoldTx(ctx context.Context, arg PlaceHoldTxParam) (HoldTxResult, error)
+	CaptureHoldTx(ctx context.Context, arg CaptureHoldTxParam) (HoldTxResult, error)
+	ReleaseHoldTx(ctx context.Context, holdID int64) (HoldTxResult, error)
+	ReleaseExpiredHoldTx(ctx context.Context, now time.Time) (HoldTxResult, error)
+	ApproveTransferTx(ctx context.Context, arg ReviewTransferTxParam) (TransferTxResult, error)
+	RejectTransferTx(ctx context.Context, arg ReviewTransferTxParam) (Transfer, error)
+	QuoteTransferFee(ctx context.Context, from, to Account, amount int64) (TransferFee, error)
 }
 
 type SQLStore struct {
 	*Queries
 	db *sql.DB
+	// riskRules assess every transfer made with TransferTx, in order
+	riskRules []RiskRule
+}
+
+// StoreOption configures the store built by NewStore
+type StoreOption func(*SQLStore)
+
+// WithRiskRules makes TransferTx assess every transfer with the rules before it commits
+func WithRiskRules(rules ...RiskRule) StoreOption {
+	return func(store *SQLStore) {
+		store.riskRules = rules
+	}
 }
 
-func NewStore(db *sql.DB) Store {
-	return &SQLStore{
+func NewStore(db *sql.DB, opts ...StoreOption) Store {
+	store := &SQLStore{
 		db:      db,
 		Queries: New(db),
 	}
+
+	for _, opt := range opts {
+		opt(store)
+	}
+	return store
 }
 
 func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) error {
-	tx, err := store.db.BeginTx(ctx, nil)
+	return store.execTxWithOptions(ctx, nil, fn)
+}
+
+func (store *SQLStore) execTxWithOptions(ctx context.Context, opts *sql.TxOptions, fn func(*Queries) error) error {
+	tx, err := store.db.BeginTx(ctx, opts)
 
 	if err != nil {
-		return nil
+		return err
 	}
 
 	err = fn(New(tx))
@@ -42,31 +91,67 @@ func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) erro
 }
 
 func transferMoney(ctx context.Context, q *Queries, accountID1, amount1, accountID2, amount2 int64) (account1, account2 Account, err error) {
-	account1, err = q.UpdateAccountBalance(ctx, UpdateAccountBalanceParams{
-		ID:     accountID1,
-		Amount: amount1,
-	})
+	account1, err = addMoney(ctx, q, accountID1, amount1)
+	if err != nil {
+		return
+	}
 
+	account2, err = addMoney(ctx, q, accountID2, amount2)
 	if err != nil {
 		return
 	}
 
-	account2, err = q.UpdateAccountBalance(ctx, UpdateAccountBalanceParams{
-		ID:     accountID2,
-		Amount: amount2,
+	return
+}
+
+// addMoney credits the account, or debits it when amount is negative as long as the overdraft limit isn't exceeded
+func addMoney(ctx context.Context, q *Queries, accountID, amount int64) (Account, error) {
+	if amount >= 0 {
+		account, err := q.UpdateAccountBalance(ctx, UpdateAccountBalanceParams{
+			ID:     accountID,
+			Amount: amount,
+		})
+
+		if err == sql.ErrNoRows {
+			return account, balanceNotUpdatedError(ctx, q, accountID)
+		}
+		return account, err
+	}
+
+	account, err := q.DebitAccountBalance(ctx, DebitAccountBalanceParams{
+		ID:     accountID,
+		Amount: -amount,
 	})
 
+	if err == sql.ErrNoRows {
+		return account, balanceNotUpdatedError(ctx, q, accountID)
+	}
+	return account, err
+}
+
+// balanceNotUpdatedError tells why the balance of the account wasn't updated, its status forbids it
+// or the debit exceeds the overdraft limit.
+// The balance updates check the status in the same statement, so they can't race a status change
+func balanceNotUpdatedError(ctx context.Context, q *Queries, accountID int64) error {
+	account, err := q.GetAccount(ctx, accountID)
 	if err != nil {
-		return
+		return err
 	}
 
-	return
+	if err = account.CanDebit(); err != nil {
+		return err
+	}
+	return ErrInsufficientFunds
 }
 
 type TransferTxParam struct {
 	FromAccountID int64 `json:"from_account_id"`
 	ToAccountID   int64 `json:"to_account_id"`
 	Amount        int64 `json:"amount"`
+	// Rate converts Amount into the currency of the destination account, nil for same currency transfers
+	Rate *fx.Rate `json:"-"`
+	// Idempotency is optional, when set the result is stored under the key in the same transaction
+	Idempotency *IdempotencyParam `json:"-"`
 }
 
 type TransferTxResult struct {
@@ -75,49 +160,205 @@ type TransferTxResult struct {
 	ToAccount   Account  `json:"to_account"`
 	FromEntry   Entry    `json:"from_entry"`
 	ToEntry     Entry    `json:"to_entry"`
+	// Fee is charged to the from account on top of the amount, FeeEntry is zero until it is
+	Fee      TransferFee `json:"fee"`
+	FeeEntry Entry       `json:"fee_entry"`
 }
 
+// TransferTx moves arg.Amount out of the from account once it is checked against the velocity limits of the account,
+// a *TransferLimitError is returned when the transfer breaches one. The transfer is then assessed by the risk rules of
+// the store, a *RiskDeniedError is returned when one denies it. When one puts it into review the transfer is stored as
+// pending_review and no money moves until it is approved with ApproveTransferTx.
+// The fee of the fee schedule of the from account is charged on top of arg.Amount and credited to the house account of
+// the schedule, it isn't counted by the limits
 func (store *SQLStore) TransferTx(ctx context.Context, arg TransferTxParam) (TransferTxResult, error) {
 	var results TransferTxResult
 	var err error
+	var fp string
+
+	rate := fx.Rate{Value: fx.RateScale, UpdatedAt: time.Now()}
+	if arg.Rate != nil {
+		rate = *arg.Rate
+	}
+
+	toAmount := rate.Convert(arg.Amount)
+	if toAmount < 1 {
+		return results, ErrConvertedAmountTooSmall
+	}
+
+	if arg.Idempotency != nil {
+		fp, err = fingerprint(arg)
+		if err != nil {
+			return results, err
+		}
+	}
 
 	err = store.execTx(ctx, func(q *Queries) error {
-		results.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
-			FromAccountID: arg.FromAccountID,
-			ToAccountID:   arg.ToAccountID,
-			Amount:        arg.Amount,
-		})
+		if arg.Idempotency != nil {
+			replayed, err := replayIdempotentRequest(ctx, q, arg.Idempotency, fp, &results)
+			if err != nil || replayed {
+				return err
+			}
+		}
 
+		fee, err := quoteTransferFeeByID(ctx, q, arg.FromAccountID, arg.ToAccountID, arg.Amount)
 		if err != nil {
 			return err
 		}
 
-		results.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.FromAccountID,
-			Amount:    -arg.Amount,
-		})
-
+		accounts, err := lockAccounts(ctx, q, arg.FromAccountID, arg.ToAccountID, fee.AccountID)
 		if err != nil {
 			return err
 		}
+		from, to := accounts[arg.FromAccountID], accounts[arg.ToAccountID]
 
-		results.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.ToAccountID,
-			Amount:    arg.Amount,
-		})
+		now := time.Now()
+		if err = checkTransferLimits(ctx, q, from, arg.Amount, now); err != nil {
+			return err
+		}
 
+		review, err := assessRisk(ctx, q, store.riskRules, RiskTransfer{From: from, To: to, Amount: arg.Amount, Now: now})
 		if err != nil {
 			return err
 		}
 
-		if arg.FromAccountID < arg.ToAccountID {
-			results.FromAccount, results.ToAccount, err = transferMoney(ctx, q, arg.FromAccountID, -arg.Amount, arg.ToAccountID, arg.Amount)
+		if review != nil {
+			results, err = pendingTransfer(ctx, q, from, to, arg.Amount, toAmount, rate, fee, *review)
 		} else {
-			results.ToAccount, results.FromAccount, err = transferMoney(ctx, q, arg.ToAccountID, arg.Amount, arg.FromAccountID, -arg.Amount)
+			results, err = transfer(ctx, q, arg.FromAccountID, arg.ToAccountID, arg.Amount, toAmount, rate, fee)
+		}
+		if err != nil {
+			return err
+		}
+
+		if err = runTxHook(ctx, q, results); err != nil {
+			return err
+		}
+
+		if arg.Idempotency != nil {
+			return saveIdempotentRequest(ctx, q, arg.Idempotency, fp, results)
 		}
 
 		return nil
 	})
 
+	// A concurrent request with the same key committed first, the transfer was rolled back so replay its result.
+	// The violation may come from another constraint, or the key may be gone already, then the error is kept
+	if arg.Idempotency != nil && isUniqueViolation(err) {
+		var replayedResults TransferTxResult
+		replayed, replayErr := replayIdempotentRequest(ctx, store.Queries, arg.Idempotency, fp, &replayedResults)
+		if replayErr != nil {
+			return TransferTxResult{}, replayErr
+		}
+		if replayed {
+			return replayedResults, nil
+		}
+		return TransferTxResult{}, err
+	}
+
 	return results, err
 }
+
+// lockAccounts locks the accounts in the order of their ids like the balances are updated, so that concurrent
+// transactions over the same accounts are checked one after the other without deadlocking. Zero ids are skipped
+func lockAccounts(ctx context.Context, q *Queries, ids ...int64) (map[int64]Account, error) {
+	sorted := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if id != 0 {
+			sorted = append(sorted, id)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	accounts := make(map[int64]Account, len(sorted))
+	for _, id := range sorted {
+		if _, ok := accounts[id]; ok {
+			continue
+		}
+
+		account, err := q.GetAccountForUpdate(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		accounts[id] = account
+	}
+	return accounts, nil
+}
+
+// transfer moves amount out of the from account and toAmount into the to account, updating the balances in the order
+// of the account ids so that concurrent transfers between the same accounts can't deadlock. The fee, if any, is
+// charged once the amount is moved, its house account must already be locked
+func transfer(ctx context.Context, q *Queries, fromAccountID, toAccountID, amount, toAmount int64, rate fx.Rate, fee TransferFee) (results TransferTxResult, err error) {
+	created, err := q.CreateTransfer(ctx, CreateTransferParams{
+		FromAccountID: fromAccountID,
+		ToAccountID:   toAccountID,
+		Amount:        amount,
+		ToAmount:      toAmount,
+		FxRate:        rate.Value,
+		FxRateAt:      rate.UpdatedAt,
+		FeeAmount:     fee.Amount,
+		FeeAccountID:  sql.NullInt64{Int64: fee.AccountID, Valid: fee.AccountID != 0},
+	})
+
+	if err != nil {
+		return
+	}
+
+	return settleTransfer(ctx, q, created)
+}
+
+// settleTransfer moves the money of a stored transfer, charges its fee and writes their entries
+func settleTransfer(ctx context.Context, q *Queries, t Transfer) (results TransferTxResult, err error) {
+	results.Transfer, results.Fee = t, t.Fee()
+	fromAccountID, toAccountID, amount, toAmount := t.FromAccountID, t.ToAccountID, t.Amount, t.ToAmount
+
+	if fromAccountID < toAccountID {
+		results.FromAccount, results.ToAccount, err = transferMoney(ctx, q, fromAccountID, -amount, toAccountID, toAmount)
+	} else {
+		results.ToAccount, results.FromAccount, err = transferMoney(ctx, q, toAccountID, toAmount, fromAccountID, -amount)
+	}
+
+	if err != nil {
+		return
+	}
+
+	// Entries are written once the balances are updated, so that they carry the balance after the transfer
+	transferID := sql.NullInt64{Int64: results.Transfer.ID, Valid: true}
+	results.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID:    fromAccountID,
+		Amount:       -amount,
+		Type:         EntryTypeTransfer,
+		TransferID:   transferID,
+		BalanceAfter: results.FromAccount.Balance,
+		Description:  fmt.Sprintf("transfer to account %d", toAccountID),
+	})
+
+	if err != nil {
+		return
+	}
+
+	results.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID:    toAccountID,
+		Amount:       toAmount,
+		Type:         EntryTypeTransfer,
+		TransferID:   transferID,
+		BalanceAfter: results.ToAccount.Balance,
+		Description:  fmt.Sprintf("transfer from account %d", fromAccountID),
+	})
+
+	if err != nil {
+		return
+	}
+
+	if t.FeeAmount > 0 {
+		var revenue Account
+		results.FromAccount, revenue, results.FeeEntry, err = chargeFee(ctx, q, t)
+
+		// The recipient can be the house account, its balance then includes the fee
+		if err == nil && revenue.ID == results.ToAccount.ID {
+			results.ToAccount = revenue
+		}
+	}
+
+	return
+}
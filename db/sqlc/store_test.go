@@ -3,7 +3,10 @@ package db
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/escalopa/gobank/fx"
+	"github.com/escalopa/gobank/util"
 	"github.com/stretchr/testify/require"
 )
 
@@ -17,6 +20,7 @@ func TestTransferTx(t *testing.T) {
 	existed := make(map[int]bool)
 
 	n := 5
+	account1 = fundAccount(t, account1, amount*int64(n))
 	for i := 0; i < n; i++ {
 		go func() {
 			result, err := store.TransferTx(context.Background(), TransferTxParam{
@@ -51,6 +55,9 @@ func TestTransferTx(t *testing.T) {
 		validateEntryBasic(t, result.FromEntry)
 		require.Equal(t, -amount, result.FromEntry.Amount)
 		require.Equal(t, account1.ID, result.FromEntry.AccountID)
+		require.Equal(t, EntryTypeTransfer, result.FromEntry.Type)
+		require.Equal(t, result.Transfer.ID, result.FromEntry.TransferID.Int64)
+		require.Equal(t, result.FromAccount.Balance, result.FromEntry.BalanceAfter)
 
 		_, err = store.GetEntry(context.Background(), result.FromEntry.ID)
 		require.NoError(t, err)
@@ -59,6 +66,8 @@ func TestTransferTx(t *testing.T) {
 		validateEntryBasic(t, result.ToEntry)
 		require.Equal(t, amount, result.ToEntry.Amount)
 		require.Equal(t, account2.ID, result.ToEntry.AccountID)
+		require.Equal(t, result.Transfer.ID, result.ToEntry.TransferID.Int64)
+		require.Equal(t, result.ToAccount.Balance, result.ToEntry.BalanceAfter)
 
 		_, err = store.GetEntry(context.Background(), result.ToEntry.ID)
 		require.NoError(t, err)
@@ -107,6 +116,8 @@ func TestTransferTxDeadLock(t *testing.T) {
 	errs := make(chan error)
 
 	n := 10
+	account1 = fundAccount(t, account1, amount*int64(n))
+	account2 = fundAccount(t, account2, amount*int64(n))
 	for i := 0; i < n; i++ {
 		fromAccountID, toAccountID := account1.ID, account2.ID
 
@@ -141,3 +152,120 @@ func TestTransferTxDeadLock(t *testing.T) {
 	require.Equal(t, account1.Balance, updatedFromAccount.Balance)
 	require.Equal(t, account2.Balance, updatedToAccount.Balance)
 }
+
+func TestTransferTxIdempotency(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1, account2 := createRandomAccount(t), createRandomAccount(t)
+	account1 = fundAccount(t, account1, 10)
+	idem := &IdempotencyParam{Key: util.RandomString(16), Username: account1.Owner, Retention: time.Hour}
+	arg := TransferTxParam{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+		Idempotency:   idem,
+	}
+
+	results, errs := make(chan TransferTxResult), make(chan error)
+
+	n := 5
+	for i := 0; i < n; i++ {
+		go func() {
+			result, err := store.TransferTx(context.Background(), arg)
+
+			errs <- err
+			results <- result
+		}()
+	}
+
+	var transferID int64
+	for i := 0; i < n; i++ {
+		err := <-errs
+		require.NoError(t, err)
+
+		result := <-results
+		validateTransferBasic(t, result.Transfer)
+		if transferID == 0 {
+			transferID = result.Transfer.ID
+		}
+		require.Equal(t, transferID, result.Transfer.ID)
+	}
+
+	// Money must be moved only once
+	updatedFromAccount, err := store.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance-arg.Amount, updatedFromAccount.Balance)
+
+	// Same key with a different request
+	arg.Amount = 20
+	_, err = store.TransferTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrIdempotencyKeyReused)
+}
+
+func TestTransferTxInsufficientFunds(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1, account2 := createRandomAccount(t), createRandomAccount(t)
+	require.Zero(t, account1.OverdraftLimit)
+
+	_, err := store.TransferTx(context.Background(), TransferTxParam{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        account1.Balance + 1,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	// Nothing must be moved
+	updatedFromAccount, err := store.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance, updatedFromAccount.Balance)
+
+	updatedToAccount, err := store.GetAccount(context.Background(), account2.ID)
+	require.NoError(t, err)
+	require.Equal(t, account2.Balance, updatedToAccount.Balance)
+}
+
+// fundAccount adds amount to the account balance, so that transfers from it don't exceed the overdraft limit
+func fundAccount(t *testing.T, account Account, amount int64) Account {
+	account, err := testQueries.UpdateAccountBalance(context.Background(), UpdateAccountBalanceParams{
+		ID:     account.ID,
+		Amount: amount,
+	})
+	require.NoError(t, err)
+	return account
+}
+
+func TestTransferTxExchangeRate(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1, account2 := createRandomAccount(t), createRandomAccount(t)
+	account1 = fundAccount(t, account1, 100)
+
+	rate := fx.Rate{From: account1.Currency, To: account2.Currency, Value: 5 * fx.RateScale / 2, UpdatedAt: time.Now().Add(-time.Hour)}
+	result, err := store.TransferTx(context.Background(), TransferTxParam{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        15,
+		Rate:          &rate,
+	})
+	require.NoError(t, err)
+
+	// The source is debited the amount and the destination is credited the converted amount
+	require.Equal(t, int64(15), result.Transfer.Amount)
+	require.Equal(t, int64(37), result.Transfer.ToAmount)
+	require.Equal(t, rate.Value, result.Transfer.FxRate)
+	require.WithinDuration(t, rate.UpdatedAt, result.Transfer.FxRateAt, time.Second)
+	require.Equal(t, int64(-15), result.FromEntry.Amount)
+	require.Equal(t, int64(37), result.ToEntry.Amount)
+	require.Equal(t, account1.Balance-15, result.FromAccount.Balance)
+	require.Equal(t, account2.Balance+37, result.ToAccount.Balance)
+
+	rate.Value = fx.RateScale / 100
+	_, err = store.TransferTx(context.Background(), TransferTxParam{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+		Rate:          &rate,
+	})
+	require.ErrorIs(t, err, ErrConvertedAmountTooSmall)
+}
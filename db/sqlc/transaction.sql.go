@@ -7,26 +7,260 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"time"
 )
 
+const addTransferReversedAmount = `-- name: AddTransferReversedAmount :one
+UPDATE transfers
+SET reversed_amount = reversed_amount + $1,
+  reversed_at = now()
+WHERE id = $2
+RETURNING id, from_account_id, to_account_id, amount, created_at, to_amount, fx_rate, fx_rate_at, reversal_of, reversed_amount, reversed_at, status, risk_rule, risk_reason, reviewed_by, review_note, reviewed_at, fee_amount, fee_account_id
+`
+
+type AddTransferReversedAmountParams struct {
+	Amount int64 `json:"amount"`
+	ID     int64 `json:"id"`
+}
+
+func (q *Queries) AddTransferReversedAmount(ctx context.Context, arg AddTransferReversedAmountParams) (Transfer, error) {
+	row := q.db.QueryRowContext(ctx, addTransferReversedAmount, arg.Amount, arg.ID)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.ToAmount,
+		&i.FxRate,
+		&i.FxRateAt,
+		&i.ReversalOf,
+		&i.ReversedAmount,
+		&i.ReversedAt,
+		&i.Status,
+		&i.RiskRule,
+		&i.RiskReason,
+		&i.ReviewedBy,
+		&i.ReviewNote,
+		&i.ReviewedAt,
+		&i.FeeAmount,
+		&i.FeeAccountID,
+	)
+	return i, err
+}
+
+const countOwnerTransfersTo = `-- name: CountOwnerTransfersTo :one
+SELECT COUNT(*)::integer
+FROM transfers
+WHERE to_account_id = $1
+  AND status = 'completed'
+  AND from_account_id IN (
+    SELECT id
+    FROM accounts
+    WHERE owner = $2
+  )
+`
+
+type CountOwnerTransfersToParams struct {
+	ToAccountID int64  `json:"to_account_id"`
+	Owner       string `json:"owner"`
+}
+
+func (q *Queries) CountOwnerTransfersTo(ctx context.Context, arg CountOwnerTransfersToParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, countOwnerTransfersTo, arg.ToAccountID, arg.Owner)
+	var count int32
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countTransfersSince = `-- name: CountTransfersSince :one
+SELECT COUNT(*)::integer
+FROM transfers
+WHERE from_account_id = $1
+  AND reversal_of IS NULL
+  AND status <> 'rejected'
+  AND created_at >= $2
+`
+
+type CountTransfersSinceParams struct {
+	AccountID int64     `json:"account_id"`
+	Since     time.Time `json:"since"`
+}
+
+func (q *Queries) CountTransfersSince(ctx context.Context, arg CountTransfersSinceParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, countTransfersSince, arg.AccountID, arg.Since)
+	var count int32
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createPendingTransfer = `-- name: CreatePendingTransfer :one
+INSERT INTO transfers (
+    from_account_id,
+    to_account_id,
+    amount,
+    to_amount,
+    fx_rate,
+    fx_rate_at,
+    status,
+    risk_rule,
+    risk_reason,
+    fee_amount,
+    fee_account_id
+  )
+VALUES ($1, $2, $3, $4, $5, $6, 'pending_review', $7, $8, $9, $10)
+RETURNING id, from_account_id, to_account_id, amount, created_at, to_amount, fx_rate, fx_rate_at, reversal_of, reversed_amount, reversed_at, status, risk_rule, risk_reason, reviewed_by, review_note, reviewed_at, fee_amount, fee_account_id
+`
+
+type CreatePendingTransferParams struct {
+	FromAccountID int64          `json:"from_account_id"`
+	ToAccountID   int64          `json:"to_account_id"`
+	Amount        int64          `json:"amount"`
+	ToAmount      int64          `json:"to_amount"`
+	FxRate        int64          `json:"fx_rate"`
+	FxRateAt      time.Time      `json:"fx_rate_at"`
+	RiskRule      sql.NullString `json:"risk_rule"`
+	RiskReason    sql.NullString `json:"risk_reason"`
+	FeeAmount     int64          `json:"fee_amount"`
+	FeeAccountID  sql.NullInt64  `json:"fee_account_id"`
+}
+
+func (q *Queries) CreatePendingTransfer(ctx context.Context, arg CreatePendingTransferParams) (Transfer, error) {
+	row := q.db.QueryRowContext(ctx, createPendingTransfer,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.ToAmount,
+		arg.FxRate,
+		arg.FxRateAt,
+		arg.RiskRule,
+		arg.RiskReason,
+		arg.FeeAmount,
+		arg.FeeAccountID,
+	)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.ToAmount,
+		&i.FxRate,
+		&i.FxRateAt,
+		&i.ReversalOf,
+		&i.ReversedAmount,
+		&i.ReversedAt,
+		&i.Status,
+		&i.RiskRule,
+		&i.RiskReason,
+		&i.ReviewedBy,
+		&i.ReviewNote,
+		&i.ReviewedAt,
+		&i.FeeAmount,
+		&i.FeeAccountID,
+	)
+	return i, err
+}
+
+const createReversalTransfer = `-- name: CreateReversalTransfer :one
+INSERT INTO transfers (
+    from_account_id,
+    to_account_id,
+    amount,
+    to_amount,
+    fx_rate,
+    fx_rate_at,
+    reversal_of
+  )
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, from_account_id, to_account_id, amount, created_at, to_amount, fx_rate, fx_rate_at, reversal_of, reversed_amount, reversed_at, status, risk_rule, risk_reason, reviewed_by, review_note, reviewed_at, fee_amount, fee_account_id
+`
+
+type CreateReversalTransferParams struct {
+	FromAccountID int64         `json:"from_account_id"`
+	ToAccountID   int64         `json:"to_account_id"`
+	Amount        int64         `json:"amount"`
+	ToAmount      int64         `json:"to_amount"`
+	FxRate        int64         `json:"fx_rate"`
+	FxRateAt      time.Time     `json:"fx_rate_at"`
+	ReversalOf    sql.NullInt64 `json:"reversal_of"`
+}
+
+func (q *Queries) CreateReversalTransfer(ctx context.Context, arg CreateReversalTransferParams) (Transfer, error) {
+	row := q.db.QueryRowContext(ctx, createReversalTransfer,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.ToAmount,
+		arg.FxRate,
+		arg.FxRateAt,
+		arg.ReversalOf,
+	)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.ToAmount,
+		&i.FxRate,
+		&i.FxRateAt,
+		&i.ReversalOf,
+		&i.ReversedAmount,
+		&i.ReversedAt,
+		&i.Status,
+		&i.RiskRule,
+		&i.RiskReason,
+		&i.ReviewedBy,
+		&i.ReviewNote,
+		&i.ReviewedAt,
+		&i.FeeAmount,
+		&i.FeeAccountID,
+	)
+	return i, err
+}
+
 const createTransfer = `-- name: CreateTransfer :one
 INSERT INTO transfers (
     from_account_id,
     to_account_id,
-    amount
+    amount,
+    to_amount,
+    fx_rate,
+    fx_rate_at,
+    fee_amount,
+    fee_account_id
   )
-VALUES ($1, $2, $3)
-RETURNING id, from_account_id, to_account_id, amount, created_at
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, from_account_id, to_account_id, amount, created_at, to_amount, fx_rate, fx_rate_at, reversal_of, reversed_amount, reversed_at, status, risk_rule, risk_reason, reviewed_by, review_note, reviewed_at, fee_amount, fee_account_id
 `
 
 type CreateTransferParams struct {
-	FromAccountID int64 `json:"from_account_id"`
-	ToAccountID   int64 `json:"to_account_id"`
-	Amount        int64 `json:"amount"`
+	FromAccountID int64         `json:"from_account_id"`
+	ToAccountID   int64         `json:"to_account_id"`
+	Amount        int64         `json:"amount"`
+	ToAmount      int64         `json:"to_amount"`
+	FxRate        int64         `json:"fx_rate"`
+	FxRateAt      time.Time     `json:"fx_rate_at"`
+	FeeAmount     int64         `json:"fee_amount"`
+	FeeAccountID  sql.NullInt64 `json:"fee_account_id"`
 }
 
 func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error) {
-	row := q.db.QueryRowContext(ctx, createTransfer, arg.FromAccountID, arg.ToAccountID, arg.Amount)
+	row := q.db.QueryRowContext(ctx, createTransfer,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.ToAmount,
+		arg.FxRate,
+		arg.FxRateAt,
+		arg.FeeAmount,
+		arg.FeeAccountID,
+	)
 	var i Transfer
 	err := row.Scan(
 		&i.ID,
@@ -34,12 +268,26 @@ func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams)
 		&i.ToAccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.ToAmount,
+		&i.FxRate,
+		&i.FxRateAt,
+		&i.ReversalOf,
+		&i.ReversedAmount,
+		&i.ReversedAt,
+		&i.Status,
+		&i.RiskRule,
+		&i.RiskReason,
+		&i.ReviewedBy,
+		&i.ReviewNote,
+		&i.ReviewedAt,
+		&i.FeeAmount,
+		&i.FeeAccountID,
 	)
 	return i, err
 }
 
 const getTransfer = `-- name: GetTransfer :one
-SELECT id, from_account_id, to_account_id, amount, created_at
+SELECT id, from_account_id, to_account_id, amount, created_at, to_amount, fx_rate, fx_rate_at, reversal_of, reversed_amount, reversed_at, status, risk_rule, risk_reason, reviewed_by, review_note, reviewed_at, fee_amount, fee_account_id
 FROM transfers
 WHERE id = $1
 LIMIT 1
@@ -54,27 +302,198 @@ func (q *Queries) GetTransfer(ctx context.Context, id int64) (Transfer, error) {
 		&i.ToAccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.ToAmount,
+		&i.FxRate,
+		&i.FxRateAt,
+		&i.ReversalOf,
+		&i.ReversedAmount,
+		&i.ReversedAt,
+		&i.Status,
+		&i.RiskRule,
+		&i.RiskReason,
+		&i.ReviewedBy,
+		&i.ReviewNote,
+		&i.ReviewedAt,
+		&i.FeeAmount,
+		&i.FeeAccountID,
 	)
 	return i, err
 }
 
-const listTransfers = `-- name: ListTransfers :many
-SELECT id, from_account_id, to_account_id, amount, created_at
+const getTransferForUpdate = `-- name: GetTransferForUpdate :one
+SELECT id, from_account_id, to_account_id, amount, created_at, to_amount, fx_rate, fx_rate_at, reversal_of, reversed_amount, reversed_at, status, risk_rule, risk_reason, reviewed_by, review_note, reviewed_at, fee_amount, fee_account_id
 FROM transfers
-WHERE from_account_id = $1
-  OR to_account_id = $1
+WHERE id = $1
+LIMIT 1 FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetTransferForUpdate(ctx context.Context, id int64) (Transfer, error) {
+	row := q.db.QueryRowContext(ctx, getTransferForUpdate, id)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.ToAmount,
+		&i.FxRate,
+		&i.FxRateAt,
+		&i.ReversalOf,
+		&i.ReversedAmount,
+		&i.ReversedAt,
+		&i.Status,
+		&i.RiskRule,
+		&i.RiskReason,
+		&i.ReviewedBy,
+		&i.ReviewNote,
+		&i.ReviewedAt,
+		&i.FeeAmount,
+		&i.FeeAccountID,
+	)
+	return i, err
+}
+
+const listPendingTransfers = `-- name: ListPendingTransfers :many
+SELECT id, from_account_id, to_account_id, amount, created_at, to_amount, fx_rate, fx_rate_at, reversal_of, reversed_amount, reversed_at, status, risk_rule, risk_reason, reviewed_by, review_note, reviewed_at, fee_amount, fee_account_id
+FROM transfers
+WHERE status = 'pending_review'
+  AND id > $1
 ORDER BY id
-LIMIT $3 OFFSET $2
+LIMIT $2
+`
+
+type ListPendingTransfersParams struct {
+	AfterID  int64 `json:"after_id"`
+	PageSize int32 `json:"page_size"`
+}
+
+func (q *Queries) ListPendingTransfers(ctx context.Context, arg ListPendingTransfersParams) ([]Transfer, error) {
+	rows, err := q.db.QueryContext(ctx, listPendingTransfers, arg.AfterID, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Transfer{}
+	for rows.Next() {
+		var i Transfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.ToAmount,
+			&i.FxRate,
+			&i.FxRateAt,
+			&i.ReversalOf,
+			&i.ReversedAmount,
+			&i.ReversedAt,
+			&i.Status,
+			&i.RiskRule,
+			&i.RiskReason,
+			&i.ReviewedBy,
+			&i.ReviewNote,
+			&i.ReviewedAt,
+			&i.FeeAmount,
+			&i.FeeAccountID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransferReversals = `-- name: ListTransferReversals :many
+SELECT id, from_account_id, to_account_id, amount, created_at, to_amount, fx_rate, fx_rate_at, reversal_of, reversed_amount, reversed_at, status, risk_rule, risk_reason, reviewed_by, review_note, reviewed_at, fee_amount, fee_account_id
+FROM transfers
+WHERE reversal_of = $1
+ORDER BY id
+`
+
+func (q *Queries) ListTransferReversals(ctx context.Context, reversalOf sql.NullInt64) ([]Transfer, error) {
+	rows, err := q.db.QueryContext(ctx, listTransferReversals, reversalOf)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Transfer{}
+	for rows.Next() {
+		var i Transfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.ToAmount,
+			&i.FxRate,
+			&i.FxRateAt,
+			&i.ReversalOf,
+			&i.ReversedAmount,
+			&i.ReversedAt,
+			&i.Status,
+			&i.RiskRule,
+			&i.RiskReason,
+			&i.ReviewedBy,
+			&i.ReviewNote,
+			&i.ReviewedAt,
+			&i.FeeAmount,
+			&i.FeeAccountID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransfers = `-- name: ListTransfers :many
+SELECT id, from_account_id, to_account_id, amount, created_at, to_amount, fx_rate, fx_rate_at, reversal_of, reversed_amount, reversed_at, status, risk_rule, risk_reason, reviewed_by, review_note, reviewed_at, fee_amount, fee_account_id
+FROM transfers
+WHERE (
+    from_account_id = $1
+    OR to_account_id = $1
+  )
+  AND (
+    created_at > $2
+    OR (
+      created_at = $2
+      AND id > $3
+    )
+  )
+ORDER BY created_at,
+  id
+LIMIT $4
 `
 
 type ListTransfersParams struct {
-	AccountID int64 `json:"account_id"`
-	PageID    int32 `json:"page_id"`
-	PageSize  int32 `json:"page_size"`
+	AccountID      int64     `json:"account_id"`
+	AfterCreatedAt time.Time `json:"after_created_at"`
+	AfterID        int64     `json:"after_id"`
+	PageSize       int32     `json:"page_size"`
 }
 
 func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error) {
-	rows, err := q.db.QueryContext(ctx, listTransfers, arg.AccountID, arg.PageID, arg.PageSize)
+	rows, err := q.db.QueryContext(ctx, listTransfers,
+		arg.AccountID,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.PageSize,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -88,6 +507,20 @@ func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([
 			&i.ToAccountID,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.ToAmount,
+			&i.FxRate,
+			&i.FxRateAt,
+			&i.ReversalOf,
+			&i.ReversedAmount,
+			&i.ReversedAt,
+			&i.Status,
+			&i.RiskRule,
+			&i.RiskReason,
+			&i.ReviewedBy,
+			&i.ReviewNote,
+			&i.ReviewedAt,
+			&i.FeeAmount,
+			&i.FeeAccountID,
 		); err != nil {
 			return nil, err
 		}
@@ -101,3 +534,52 @@ func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([
 	}
 	return items, nil
 }
+
+const reviewTransfer = `-- name: ReviewTransfer :one
+UPDATE transfers
+SET status = $1,
+  reviewed_by = $2,
+  review_note = $3,
+  reviewed_at = now()
+WHERE id = $4
+RETURNING id, from_account_id, to_account_id, amount, created_at, to_amount, fx_rate, fx_rate_at, reversal_of, reversed_amount, reversed_at, status, risk_rule, risk_reason, reviewed_by, review_note, reviewed_at, fee_amount, fee_account_id
+`
+
+type ReviewTransferParams struct {
+	Status     TransferStatus `json:"status"`
+	ReviewedBy sql.NullString `json:"reviewed_by"`
+	ReviewNote sql.NullString `json:"review_note"`
+	ID         int64          `json:"id"`
+}
+
+func (q *Queries) ReviewTransfer(ctx context.Context, arg ReviewTransferParams) (Transfer, error) {
+	row := q.db.QueryRowContext(ctx, reviewTransfer,
+		arg.Status,
+		arg.ReviewedBy,
+		arg.ReviewNote,
+		arg.ID,
+	)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.ToAmount,
+		&i.FxRate,
+		&i.FxRateAt,
+		&i.ReversalOf,
+		&i.ReversedAmount,
+		&i.ReversedAt,
+		&i.Status,
+		&i.RiskRule,
+		&i.RiskReason,
+		&i.ReviewedBy,
+		&i.ReviewNote,
+		&i.ReviewedAt,
+		&i.FeeAmount,
+		&i.FeeAccountID,
+	)
+	return i, err
+}
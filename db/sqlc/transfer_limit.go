@@ -0,0 +1,206 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrTransferLimitExceeded = errors.New("transfer limit exceeded")
+
+// TransferLimitKind is one of the velocity limits of the transfers out of an account
+type TransferLimitKind string
+
+const (
+	TransferLimitMaxAmount     TransferLimitKind = "max_amount"
+	TransferLimitDailyAmount   TransferLimitKind = "daily_amount"
+	TransferLimitMonthlyAmount TransferLimitKind = "monthly_amount"
+	TransferLimitDailyCount    TransferLimitKind = "daily_count"
+)
+
+// TransferLimitError tells which limit a transfer breaches and how much of it is left,
+// errors.Is matches it with ErrTransferLimitExceeded
+type TransferLimitError struct {
+	Limit TransferLimitKind `json:"limit"`
+	Max   int64             `json:"max"`
+	// Remaining is the amount, or the number of transfers for daily_count, that can still be transferred
+	Remaining int64 `json:"remaining"`
+	// ResetsAt is when the allowance is back to Max, nil for max_amount
+	ResetsAt *time.Time `json:"resets_at,omitempty"`
+}
+
+func (e *TransferLimitError) Error() string {
+	return fmt.Sprintf("%s: %s is %d, %d left", ErrTransferLimitExceeded, e.Limit, e.Max, e.Remaining)
+}
+
+func (e *TransferLimitError) Unwrap() error {
+	return ErrTransferLimitExceeded
+}
+
+// TransferLimits are the limits applied to an account, the invalid ones are unlimited
+type TransferLimits struct {
+	MaxAmount     sql.NullInt64 `json:"max_amount"`
+	DailyAmount   sql.NullInt64 `json:"daily_amount"`
+	MonthlyAmount sql.NullInt64 `json:"monthly_amount"`
+	DailyCount    sql.NullInt32 `json:"daily_count"`
+
+	// accountScoped are the limits set on the account itself, they count the transfers out of the account only.
+	// The limits of its owner or their tier count the transfers out of every account of the owner in its currency
+	accountScoped map[TransferLimitKind]bool
+}
+
+// transferUsage is what was transferred in the current day and month, out of an account or all the accounts of its owner
+type transferUsage struct {
+	dailyCount    int32
+	dailyAmount   int64
+	monthlyAmount int64
+}
+
+// of returns the usage counted by a limit, per account for the limits of the account and per owner for the others
+func (u GetTransferUsageRow) of(accountScoped bool) transferUsage {
+	if accountScoped {
+		return transferUsage{dailyCount: u.DailyCount, dailyAmount: u.DailyAmount, monthlyAmount: u.MonthlyAmount}
+	}
+	return transferUsage{dailyCount: u.OwnerDailyCount, dailyAmount: u.OwnerDailyAmount, monthlyAmount: u.OwnerMonthlyAmount}
+}
+
+// specificity orders the limits from the least specific, the ones of a tier, to the ones of an account
+func (l TransferLimit) specificity() int {
+	switch {
+	case l.AccountID.Valid:
+		return 2
+	case l.Username.Valid:
+		return 1
+	}
+	return 0
+}
+
+// mergeTransferLimits keeps the most specific value of every limit, the ones of the account override the ones of
+// its owner, which override the ones of their tier
+func mergeTransferLimits(limits []TransferLimit) TransferLimits {
+	var merged TransferLimits
+	for specificity := 0; specificity <= 2; specificity++ {
+		for _, limit := range limits {
+			if limit.specificity() != specificity {
+				continue
+			}
+
+			if limit.MaxAmount.Valid {
+				merged.MaxAmount = limit.MaxAmount
+			}
+			if limit.DailyAmount.Valid {
+				merged.DailyAmount = limit.DailyAmount
+				merged.setScope(TransferLimitDailyAmount, limit)
+			}
+			if limit.MonthlyAmount.Valid {
+				merged.MonthlyAmount = limit.MonthlyAmount
+				merged.setScope(TransferLimitMonthlyAmount, limit)
+			}
+			if limit.DailyCount.Valid {
+				merged.DailyCount = limit.DailyCount
+				merged.setScope(TransferLimitDailyCount, limit)
+			}
+		}
+	}
+	return merged
+}
+
+// setScope records whether the value of kind comes from a limit of the account
+func (l *TransferLimits) setScope(kind TransferLimitKind, limit TransferLimit) {
+	if l.accountScoped == nil {
+		l.accountScoped = map[TransferLimitKind]bool{}
+	}
+	l.accountScoped[kind] = limit.AccountID.Valid
+}
+
+// Check returns a *TransferLimitError when a transfer of amount made at now breaches the limits, given the usage of
+// the account and its owner so far. Days and months are in UTC
+func (l TransferLimits) Check(amount int64, usage GetTransferUsageRow, now time.Time) error {
+	if l.MaxAmount.Valid && amount > l.MaxAmount.Int64 {
+		return &TransferLimitError{Limit: TransferLimitMaxAmount, Max: l.MaxAmount.Int64, Remaining: l.MaxAmount.Int64}
+	}
+
+	dayStart, monthStart := transferLimitPeriods(now)
+	nextDay, nextMonth := dayStart.AddDate(0, 0, 1), monthStart.AddDate(0, 1, 0)
+
+	if used := usage.of(l.accountScoped[TransferLimitDailyCount]).dailyCount; l.DailyCount.Valid && used+1 > l.DailyCount.Int32 {
+		return &TransferLimitError{
+			Limit:     TransferLimitDailyCount,
+			Max:       int64(l.DailyCount.Int32),
+			Remaining: remainingAllowance(int64(l.DailyCount.Int32), int64(used)),
+			ResetsAt:  &nextDay,
+		}
+	}
+
+	if used := usage.of(l.accountScoped[TransferLimitDailyAmount]).dailyAmount; l.DailyAmount.Valid && used+amount > l.DailyAmount.Int64 {
+		return &TransferLimitError{
+			Limit:     TransferLimitDailyAmount,
+			Max:       l.DailyAmount.Int64,
+			Remaining: remainingAllowance(l.DailyAmount.Int64, used),
+			ResetsAt:  &nextDay,
+		}
+	}
+
+	if used := usage.of(l.accountScoped[TransferLimitMonthlyAmount]).monthlyAmount; l.MonthlyAmount.Valid && used+amount > l.MonthlyAmount.Int64 {
+		return &TransferLimitError{
+			Limit:     TransferLimitMonthlyAmount,
+			Max:       l.MonthlyAmount.Int64,
+			Remaining: remainingAllowance(l.MonthlyAmount.Int64, used),
+			ResetsAt:  &nextMonth,
+		}
+	}
+
+	return nil
+}
+
+func remainingAllowance(max, used int64) int64 {
+	if used >= max {
+		return 0
+	}
+	return max - used
+}
+
+// transferLimitPeriods returns the start of the UTC day and month of now
+func transferLimitPeriods(now time.Time) (dayStart, monthStart time.Time) {
+	now = now.UTC()
+	dayStart = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	monthStart = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return
+}
+
+// checkTransferLimits checks a transfer of amount out of from against its limits and the transfers made in the
+// current day and month. The limits of the owner and their tier are the ones set for the currency of the account, and
+// they only count the transfers out of the accounts of the owner in that currency. The owner of the account is locked,
+// so that concurrent transfers out of any of their accounts are counted one after the other
+func checkTransferLimits(ctx context.Context, q *Queries, from Account, amount int64, now time.Time) error {
+	owner, err := q.GetUserForUpdate(ctx, from.Owner)
+	if err != nil {
+		return err
+	}
+
+	limits, err := q.ListApplicableTransferLimits(ctx, ListApplicableTransferLimitsParams{
+		AccountID: sql.NullInt64{Int64: from.ID, Valid: true},
+		Username:  sql.NullString{String: owner.Username, Valid: true},
+		Tier:      NullUserTier{UserTier: owner.Tier, Valid: true},
+		Currency:  from.Currency,
+	})
+	if err != nil {
+		return err
+	}
+
+	dayStart, monthStart := transferLimitPeriods(now)
+	usage, err := q.GetTransferUsage(ctx, GetTransferUsageParams{
+		AccountID:  from.ID,
+		DayStart:   dayStart,
+		Owner:      owner.Username,
+		Currency:   from.Currency,
+		MonthStart: monthStart,
+	})
+	if err != nil {
+		return err
+	}
+
+	return mergeTransferLimits(limits).Check(amount, usage, now)
+}
@@ -0,0 +1,225 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/escalopa/gobank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeTransferLimits(t *testing.T) {
+	limits := []TransferLimit{
+		{
+			AccountID:   sql.NullInt64{Int64: 1, Valid: true},
+			DailyAmount: sql.NullInt64{Int64: 50, Valid: true},
+		},
+		{
+			Tier:          NullUserTier{UserTier: UserTierNew, Valid: true},
+			MaxAmount:     sql.NullInt64{Int64: 100, Valid: true},
+			DailyAmount:   sql.NullInt64{Int64: 200, Valid: true},
+			MonthlyAmount: sql.NullInt64{Int64: 1000, Valid: true},
+			DailyCount:    sql.NullInt32{Int32: 10, Valid: true},
+		},
+		{
+			Username:   sql.NullString{String: "user", Valid: true},
+			MaxAmount:  sql.NullInt64{Int64: 80, Valid: true},
+			DailyCount: sql.NullInt32{Int32: 5, Valid: true},
+		},
+	}
+
+	merged := mergeTransferLimits(limits)
+	require.Equal(t, sql.NullInt64{Int64: 80, Valid: true}, merged.MaxAmount)
+	require.Equal(t, sql.NullInt64{Int64: 50, Valid: true}, merged.DailyAmount)
+	require.Equal(t, sql.NullInt64{Int64: 1000, Valid: true}, merged.MonthlyAmount)
+	require.Equal(t, sql.NullInt32{Int32: 5, Valid: true}, merged.DailyCount)
+
+	// Only the daily amount of the account counts its own transfers, the others count the ones of its owner
+	require.True(t, merged.accountScoped[TransferLimitDailyAmount])
+	require.False(t, merged.accountScoped[TransferLimitMonthlyAmount])
+	require.False(t, merged.accountScoped[TransferLimitDailyCount])
+
+	require.Equal(t, TransferLimits{}, mergeTransferLimits(nil))
+}
+
+func TestTransferLimitsCheck(t *testing.T) {
+	now := time.Date(2023, time.March, 15, 10, 0, 0, 0, time.UTC)
+	nextDay := time.Date(2023, time.March, 16, 0, 0, 0, 0, time.UTC)
+	nextMonth := time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+	limits := TransferLimits{
+		MaxAmount:     sql.NullInt64{Int64: 100, Valid: true},
+		DailyAmount:   sql.NullInt64{Int64: 200, Valid: true},
+		MonthlyAmount: sql.NullInt64{Int64: 1000, Valid: true},
+		DailyCount:    sql.NullInt32{Int32: 3, Valid: true},
+	}
+
+	testCases := []struct {
+		name   string
+		amount int64
+		usage  GetTransferUsageRow
+		err    *TransferLimitError
+	}{
+		{
+			name:   "OK",
+			amount: 100,
+			usage:  GetTransferUsageRow{OwnerDailyCount: 2, OwnerDailyAmount: 100, OwnerMonthlyAmount: 900},
+		},
+		{
+			name:   "MaxAmount",
+			amount: 101,
+			err:    &TransferLimitError{Limit: TransferLimitMaxAmount, Max: 100, Remaining: 100},
+		},
+		{
+			name:   "DailyCount",
+			amount: 1,
+			usage:  GetTransferUsageRow{OwnerDailyCount: 3, OwnerDailyAmount: 3, OwnerMonthlyAmount: 3},
+			err:    &TransferLimitError{Limit: TransferLimitDailyCount, Max: 3, Remaining: 0, ResetsAt: &nextDay},
+		},
+		{
+			name:   "DailyAmount",
+			amount: 100,
+			usage:  GetTransferUsageRow{OwnerDailyCount: 1, OwnerDailyAmount: 150, OwnerMonthlyAmount: 150},
+			err:    &TransferLimitError{Limit: TransferLimitDailyAmount, Max: 200, Remaining: 50, ResetsAt: &nextDay},
+		},
+		{
+			name:   "MonthlyAmount",
+			amount: 100,
+			usage:  GetTransferUsageRow{OwnerMonthlyAmount: 990},
+			err:    &TransferLimitError{Limit: TransferLimitMonthlyAmount, Max: 1000, Remaining: 10, ResetsAt: &nextMonth},
+		},
+		{
+			// The transfers out of the other accounts of the owner count as well
+			name:   "OwnerDailyAmount",
+			amount: 100,
+			usage:  GetTransferUsageRow{DailyCount: 1, DailyAmount: 10, MonthlyAmount: 10, OwnerDailyCount: 2, OwnerDailyAmount: 150, OwnerMonthlyAmount: 150},
+			err:    &TransferLimitError{Limit: TransferLimitDailyAmount, Max: 200, Remaining: 50, ResetsAt: &nextDay},
+		},
+		{
+			// The limits of the account only count its own transfers
+			name:   "AccountDailyAmount",
+			amount: 100,
+			usage:  GetTransferUsageRow{DailyCount: 1, DailyAmount: 10, MonthlyAmount: 10, OwnerDailyCount: 2, OwnerDailyAmount: 150, OwnerMonthlyAmount: 150},
+		},
+		{
+			name:   "Unlimited",
+			amount: 1_000_000,
+			usage:  GetTransferUsageRow{OwnerDailyCount: 100, OwnerDailyAmount: 1_000_000, OwnerMonthlyAmount: 1_000_000},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			l := limits
+			switch tc.name {
+			case "Unlimited":
+				l = TransferLimits{}
+			case "AccountDailyAmount":
+				l.accountScoped = map[TransferLimitKind]bool{TransferLimitDailyAmount: true}
+			}
+
+			err := l.Check(tc.amount, tc.usage, now)
+			if tc.err == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			var limitErr *TransferLimitError
+			require.ErrorAs(t, err, &limitErr)
+			require.ErrorIs(t, err, ErrTransferLimitExceeded)
+			require.Equal(t, tc.err, limitErr)
+		})
+	}
+}
+
+func TestTransferTxLimits(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1, account2 := createRandomAccount(t), createRandomAccount(t)
+	account1 = fundAccount(t, account1, 100)
+
+	_, err := testQueries.CreateTransferLimit(context.Background(), CreateTransferLimitParams{
+		AccountID:   sql.NullInt64{Int64: account1.ID, Valid: true},
+		DailyAmount: sql.NullInt64{Int64: 30, Valid: true},
+		DailyCount:  sql.NullInt32{Int32: 2, Valid: true},
+	})
+	require.NoError(t, err)
+
+	arg := TransferTxParam{FromAccountID: account1.ID, ToAccountID: account2.ID, Amount: 20}
+	_, err = store.TransferTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	// 20 of the daily 30 are used
+	_, err = store.TransferTx(context.Background(), arg)
+
+	var limitErr *TransferLimitError
+	require.True(t, errors.As(err, &limitErr))
+	require.Equal(t, TransferLimitDailyAmount, limitErr.Limit)
+	require.Equal(t, int64(10), limitErr.Remaining)
+
+	arg.Amount = 10
+	_, err = store.TransferTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	arg.Amount = 1
+	_, err = store.TransferTx(context.Background(), arg)
+	require.True(t, errors.As(err, &limitErr))
+	require.Equal(t, TransferLimitDailyCount, limitErr.Limit)
+
+	// Nothing more than the allowed transfers is moved
+	updatedAccount1, err := store.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance-30, updatedAccount1.Balance)
+}
+
+func TestTransferTxOwnerLimits(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := fundAccount(t, createRandomAccount(t), 100)
+	account2, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    account1.Owner,
+		Balance:  100,
+		Currency: account1.Currency,
+	})
+	require.NoError(t, err)
+	recipient := createAccountIn(t, account1.Currency)
+
+	_, err = testQueries.CreateTransferLimit(context.Background(), CreateTransferLimitParams{
+		Username:    sql.NullString{String: account1.Owner, Valid: true},
+		Currency:    sql.NullString{String: account1.Currency, Valid: true},
+		DailyAmount: sql.NullInt64{Int64: 30, Valid: true},
+	})
+	require.NoError(t, err)
+
+	_, err = store.TransferTx(context.Background(), TransferTxParam{FromAccountID: account1.ID, ToAccountID: recipient.ID, Amount: 20})
+	require.NoError(t, err)
+
+	// The limit of the owner is shared by all their accounts, it can't be worked around by moving to another one
+	_, err = store.TransferTx(context.Background(), TransferTxParam{FromAccountID: account2.ID, ToAccountID: recipient.ID, Amount: 20})
+
+	var limitErr *TransferLimitError
+	require.True(t, errors.As(err, &limitErr))
+	require.Equal(t, TransferLimitDailyAmount, limitErr.Limit)
+	require.Equal(t, int64(10), limitErr.Remaining)
+
+	// The limit is in the currency of the accounts it counts, the accounts of the owner in other currencies aren't
+	// limited by it
+	currency := util.USD
+	if account1.Currency == util.USD {
+		currency = util.EGP
+	}
+	account3, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    account1.Owner,
+		Balance:  100,
+		Currency: currency,
+	})
+	require.NoError(t, err)
+
+	_, err = store.TransferTx(context.Background(), TransferTxParam{FromAccountID: account3.ID, ToAccountID: createAccountIn(t, currency).ID, Amount: 20})
+	require.NoError(t, err)
+}
@@ -0,0 +1,134 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/escalopa/gobank/util"
+)
+
+type TransferDirection string
+
+const (
+	TransferDirectionAll TransferDirection = ""
+	TransferDirectionIn  TransferDirection = "in"
+	TransferDirectionOut TransferDirection = "out"
+)
+
+type TransferSort string
+
+const (
+	TransferSortDate   TransferSort = "date"
+	TransferSortAmount TransferSort = "amount"
+)
+
+var ErrInvalidTransferSearch = errors.New("invalid transfer search")
+
+// SearchTransfersParam filters the transfers of an account, unset filters match every transfer
+type SearchTransfersParam struct {
+	AccountID      int64
+	Direction      TransferDirection
+	CounterpartyID sql.NullInt64
+	MinAmount      sql.NullInt64
+	MaxAmount      sql.NullInt64
+	// From is inclusive and To is exclusive
+	From       sql.NullTime
+	To         sql.NullTime
+	Sort       TransferSort
+	Descending bool
+	// After is the cursor of the previous page, the zero cursor starts at the first page
+	After    util.Cursor
+	PageSize int32
+}
+
+// sortKey identifies the order of the results in the cursors, e.g. "amount" or "-date"
+func (arg SearchTransfersParam) sortKey() string {
+	sort := arg.Sort
+	if sort == "" {
+		sort = TransferSortDate
+	}
+	if arg.Descending {
+		return "-" + string(sort)
+	}
+	return string(sort)
+}
+
+// NextCursor returns the cursor of the page that follows transfer with the same search
+func (arg SearchTransfersParam) NextCursor(transfer Transfer) util.Cursor {
+	return util.Cursor{CreatedAt: transfer.CreatedAt, Amount: transfer.Amount, ID: transfer.ID, Sort: arg.sortKey()}
+}
+
+func (arg SearchTransfersParam) validate() error {
+	switch arg.Direction {
+	case TransferDirectionAll, TransferDirectionIn, TransferDirectionOut:
+	default:
+		return fmt.Errorf("%w: unknown direction %q", ErrInvalidTransferSearch, arg.Direction)
+	}
+
+	switch arg.Sort {
+	case "", TransferSortDate, TransferSortAmount:
+	default:
+		return fmt.Errorf("%w: unknown sort %q", ErrInvalidTransferSearch, arg.Sort)
+	}
+
+	if arg.MinAmount.Valid && arg.MaxAmount.Valid && arg.MinAmount.Int64 > arg.MaxAmount.Int64 {
+		return fmt.Errorf("%w: min_amount is greater than max_amount", ErrInvalidTransferSearch)
+	}
+
+	if arg.From.Valid && arg.To.Valid && !arg.To.Time.After(arg.From.Time) {
+		return fmt.Errorf("%w: to must be after from", ErrInvalidTransferSearch)
+	}
+
+	if arg.After.ID != 0 && arg.After.Sort != arg.sortKey() {
+		return fmt.Errorf("%w: %v, it was issued for another sort", ErrInvalidTransferSearch, util.ErrInvalidCursor)
+	}
+	return nil
+}
+
+// SearchTransfers returns a page of the transfers of the account matching the filters in the requested order
+func (store *SQLStore) SearchTransfers(ctx context.Context, arg SearchTransfersParam) ([]Transfer, error) {
+	if err := arg.validate(); err != nil {
+		return nil, err
+	}
+
+	byDate := SearchTransfersByDateAscParams{
+		AccountID:      arg.AccountID,
+		Outgoing:       arg.Direction != TransferDirectionIn,
+		Incoming:       arg.Direction != TransferDirectionOut,
+		CounterpartyID: arg.CounterpartyID,
+		MinAmount:      arg.MinAmount,
+		MaxAmount:      arg.MaxAmount,
+		FromTime:       arg.From,
+		ToTime:         arg.To,
+		AfterID:        arg.After.ID,
+		AfterCreatedAt: arg.After.CreatedAt,
+		PageSize:       arg.PageSize,
+	}
+
+	byAmount := SearchTransfersByAmountAscParams{
+		AccountID:      byDate.AccountID,
+		Outgoing:       byDate.Outgoing,
+		Incoming:       byDate.Incoming,
+		CounterpartyID: byDate.CounterpartyID,
+		MinAmount:      byDate.MinAmount,
+		MaxAmount:      byDate.MaxAmount,
+		FromTime:       byDate.FromTime,
+		ToTime:         byDate.ToTime,
+		AfterID:        byDate.AfterID,
+		AfterAmount:    arg.After.Amount,
+		PageSize:       byDate.PageSize,
+	}
+
+	switch {
+	case arg.Sort == TransferSortAmount && arg.Descending:
+		return store.SearchTransfersByAmountDesc(ctx, SearchTransfersByAmountDescParams(byAmount))
+	case arg.Sort == TransferSortAmount:
+		return store.SearchTransfersByAmountAsc(ctx, byAmount)
+	case arg.Descending:
+		return store.SearchTransfersByDateDesc(ctx, SearchTransfersByDateDescParams(byDate))
+	default:
+		return store.SearchTransfersByDateAsc(ctx, byDate)
+	}
+}
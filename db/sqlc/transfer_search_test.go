@@ -0,0 +1,128 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/escalopa/gobank/fx"
+	"github.com/stretchr/testify/require"
+)
+
+func createTransferWithAmount(t *testing.T, from, to Account, amount int64) Transfer {
+	transfer, err := testQueries.CreateTransfer(context.Background(), CreateTransferParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        amount,
+		ToAmount:      amount,
+		FxRate:        fx.RateScale,
+		FxRateAt:      time.Now(),
+	})
+	require.NoError(t, err)
+	return transfer
+}
+
+func transferIDs(transfers []Transfer) []int64 {
+	ids := make([]int64, 0, len(transfers))
+	for _, transfer := range transfers {
+		ids = append(ids, transfer.ID)
+	}
+	return ids
+}
+
+func TestSearchTransfers(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1, account2, account3 := createRandomAccount(t), createRandomAccount(t), createRandomAccount(t)
+	out1 := createTransferWithAmount(t, account1, account2, 10)
+	in := createTransferWithAmount(t, account2, account1, 20)
+	out2 := createTransferWithAmount(t, account1, account3, 30)
+
+	testCases := []struct {
+		name     string
+		arg      SearchTransfersParam
+		expected []int64
+	}{
+		{
+			name:     "All",
+			arg:      SearchTransfersParam{},
+			expected: []int64{out1.ID, in.ID, out2.ID},
+		},
+		{
+			name:     "Incoming",
+			arg:      SearchTransfersParam{Direction: TransferDirectionIn},
+			expected: []int64{in.ID},
+		},
+		{
+			name:     "Outgoing",
+			arg:      SearchTransfersParam{Direction: TransferDirectionOut},
+			expected: []int64{out1.ID, out2.ID},
+		},
+		{
+			name:     "Counterparty",
+			arg:      SearchTransfersParam{CounterpartyID: sql.NullInt64{Int64: account2.ID, Valid: true}},
+			expected: []int64{out1.ID, in.ID},
+		},
+		{
+			name:     "AmountRange",
+			arg:      SearchTransfersParam{MinAmount: sql.NullInt64{Int64: 15, Valid: true}, MaxAmount: sql.NullInt64{Int64: 25, Valid: true}},
+			expected: []int64{in.ID},
+		},
+		{
+			name:     "DateRange",
+			arg:      SearchTransfersParam{From: sql.NullTime{Time: time.Now().Add(time.Hour), Valid: true}},
+			expected: []int64{},
+		},
+		{
+			name:     "AmountDescending",
+			arg:      SearchTransfersParam{Sort: TransferSortAmount, Descending: true},
+			expected: []int64{out2.ID, in.ID, out1.ID},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			tc.arg.AccountID = account1.ID
+			tc.arg.PageSize = 10
+
+			transfers, err := store.SearchTransfers(context.Background(), tc.arg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, transferIDs(transfers))
+		})
+	}
+}
+
+func TestSearchTransfersCursor(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1, account2 := createRandomAccount(t), createRandomAccount(t)
+	for _, amount := range []int64{50, 10, 40, 20, 30} {
+		createTransferWithAmount(t, account1, account2, amount)
+	}
+
+	arg := SearchTransfersParam{AccountID: account1.ID, Sort: TransferSortAmount, PageSize: 2}
+
+	var amounts []int64
+	for {
+		transfers, err := store.SearchTransfers(context.Background(), arg)
+		require.NoError(t, err)
+
+		for _, transfer := range transfers {
+			amounts = append(amounts, transfer.Amount)
+		}
+
+		if len(transfers) < int(arg.PageSize) {
+			break
+		}
+		arg.After = arg.NextCursor(transfers[len(transfers)-1])
+	}
+	require.Equal(t, []int64{10, 20, 30, 40, 50}, amounts)
+
+	// A cursor issued for another order is rejected
+	arg.Descending = true
+	_, err := store.SearchTransfers(context.Background(), arg)
+	require.ErrorIs(t, err, ErrInvalidTransferSearch)
+}
@@ -62,7 +62,6 @@ func TestListTransfer(t *testing.T) {
 
 	arg := ListTransfersParams{
 		AccountID: account1.ID,
-		PageID:    5,
 		PageSize:  5,
 	}
 
@@ -70,7 +69,16 @@ func TestListTransfer(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, transfers, 5)
 
-	for _, transfer := range transfers {
+	// The next page starts right after the last transfer of the first one
+	last := transfers[len(transfers)-1]
+	arg.AfterCreatedAt, arg.AfterID = last.CreatedAt, last.ID
+
+	nextTransfers, err := testQueries.ListTransfers(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, nextTransfers, 5)
+
+	for _, transfer := range nextTransfers {
 		validateTransferBasic(t, transfer)
+		require.Greater(t, transfer.ID, last.ID)
 	}
 }
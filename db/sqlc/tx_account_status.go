@@ -0,0 +1,153 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/escalopa/gobank/fx"
+)
+
+type ChangeAccountStatusTxParam struct {
+	AccountID int64         `json:"account_id"`
+	Status    AccountStatus `json:"status"`
+	// ChangedBy is the owner of the account or the admin making the change
+	ChangedBy string `json:"changed_by"`
+	Reason    string `json:"reason"`
+}
+
+type CloseAccountTxParam struct {
+	AccountID int64 `json:"account_id"`
+	// SweepToAccountID receives the balance of the account before it is closed, zero when the balance is already zero
+	SweepToAccountID int64 `json:"sweep_to_account_id"`
+	// Rate converts the balance into the currency of the sweep account, nil for same currency sweeps
+	Rate      *fx.Rate `json:"-"`
+	ChangedBy string   `json:"changed_by"`
+	Reason    string   `json:"reason"`
+}
+
+type AccountStatusTxResult struct {
+	Account Account             `json:"account"`
+	Change  AccountStatusChange `json:"change"`
+	// Sweep is the transfer that emptied the account before closing it, nil when nothing was swept
+	Sweep *TransferTxResult `json:"sweep"`
+}
+
+// ChangeAccountStatusTx moves the account to a new status and records who did it and why.
+// The account row is locked, so a transfer that started before the change finishes first and one that starts after sees it
+func (store *SQLStore) ChangeAccountStatusTx(ctx context.Context, arg ChangeAccountStatusTxParam) (AccountStatusTxResult, error) {
+	var result AccountStatusTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		result.Account, result.Change, err = changeAccountStatus(ctx, q, account, arg, sql.NullInt64{})
+		if err != nil {
+			return err
+		}
+
+		return runTxHook(ctx, q, result)
+	})
+
+	return result, err
+}
+
+// CloseAccountTx closes the account, a positive balance is first swept to SweepToAccountID. The sweep is checked
+// against the limits and the risk rules like a transfer. ErrAccountBalanceNotZero is returned when there is money
+// left and no account to sweep it to, or when the balance is negative
+func (store *SQLStore) CloseAccountTx(ctx context.Context, arg CloseAccountTxParam) (AccountStatusTxResult, error) {
+	var result AccountStatusTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		accounts, err := lockAccounts(ctx, q, arg.AccountID, arg.SweepToAccountID)
+		if err != nil {
+			return err
+		}
+		account := accounts[arg.AccountID]
+
+		if !account.CanMoveTo(AccountStatusClosed) {
+			return ErrInvalidStatusTransition
+		}
+
+		// The money reserved by the holds can't be swept
+		if account.HeldAmount != 0 {
+			return ErrAccountHasHolds
+		}
+
+		var sweepTransferID sql.NullInt64
+		if account.Balance > 0 && arg.SweepToAccountID != 0 {
+			rate := fx.Rate{Value: fx.RateScale, UpdatedAt: time.Now()}
+			if arg.Rate != nil {
+				rate = *arg.Rate
+			}
+
+			toAmount := rate.Convert(account.Balance)
+			if toAmount < 1 {
+				return ErrConvertedAmountTooSmall
+			}
+
+			if err = store.checkSettledTransfer(ctx, q, account, accounts[arg.SweepToAccountID], account.Balance); err != nil {
+				return err
+			}
+
+			sweep, err := transfer(ctx, q, account.ID, arg.SweepToAccountID, account.Balance, toAmount, rate, TransferFee{})
+			if err != nil {
+				return err
+			}
+
+			result.Sweep = &sweep
+			account = sweep.FromAccount
+			sweepTransferID = sql.NullInt64{Int64: sweep.Transfer.ID, Valid: true}
+		}
+
+		result.Account, result.Change, err = changeAccountStatus(ctx, q, account, ChangeAccountStatusTxParam{
+			AccountID: account.ID,
+			Status:    AccountStatusClosed,
+			ChangedBy: arg.ChangedBy,
+			Reason:    arg.Reason,
+		}, sweepTransferID)
+		if err != nil {
+			return err
+		}
+
+		return runTxHook(ctx, q, result)
+	})
+
+	return result, err
+}
+
+// changeAccountStatus moves the locked account to arg.Status and records the change
+func changeAccountStatus(ctx context.Context, q *Queries, account Account, arg ChangeAccountStatusTxParam, sweepTransferID sql.NullInt64) (Account, AccountStatusChange, error) {
+	if !account.CanMoveTo(arg.Status) {
+		return Account{}, AccountStatusChange{}, ErrInvalidStatusTransition
+	}
+
+	if arg.Status == AccountStatusClosed && account.Balance != 0 {
+		return Account{}, AccountStatusChange{}, ErrAccountBalanceNotZero
+	}
+
+	if arg.Status == AccountStatusClosed && account.HeldAmount != 0 {
+		return Account{}, AccountStatusChange{}, ErrAccountHasHolds
+	}
+
+	updated, err := q.UpdateAccountStatus(ctx, UpdateAccountStatusParams{
+		ID:     account.ID,
+		Status: arg.Status,
+	})
+	if err != nil {
+		return Account{}, AccountStatusChange{}, err
+	}
+
+	change, err := q.CreateAccountStatusChange(ctx, CreateAccountStatusChangeParams{
+		AccountID:       account.ID,
+		FromStatus:      account.Status,
+		ToStatus:        arg.Status,
+		ChangedBy:       arg.ChangedBy,
+		Reason:          arg.Reason,
+		SweepTransferID: sweepTransferID,
+	})
+	return updated, change, err
+}
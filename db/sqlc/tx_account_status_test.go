@@ -0,0 +1,170 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createAccountWithCurrency(t *testing.T, currency string) Account {
+	user := createRandomUser(t)
+
+	account, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Balance:  0,
+		Currency: currency,
+	})
+	require.NoError(t, err)
+	require.Equal(t, AccountStatusActive, account.Status)
+	return account
+}
+
+func TestAccountCanMoveTo(t *testing.T) {
+	testCases := []struct {
+		from, to AccountStatus
+		allowed  bool
+	}{
+		{AccountStatusActive, AccountStatusFrozen, true},
+		{AccountStatusActive, AccountStatusClosed, true},
+		{AccountStatusActive, AccountStatusActive, false},
+		{AccountStatusFrozen, AccountStatusActive, true},
+		{AccountStatusFrozen, AccountStatusClosed, true},
+		{AccountStatusClosed, AccountStatusActive, false},
+		{AccountStatusClosed, AccountStatusFrozen, false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.allowed, Account{Status: tc.from}.CanMoveTo(tc.to), "%s -> %s", tc.from, tc.to)
+	}
+
+	require.NoError(t, Account{Status: AccountStatusFrozen}.CanCredit())
+	require.ErrorIs(t, Account{Status: AccountStatusFrozen}.CanDebit(), ErrAccountFrozen)
+	require.ErrorIs(t, Account{Status: AccountStatusClosed}.CanCredit(), ErrAccountClosed)
+}
+
+func TestChangeAccountStatusTx(t *testing.T) {
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	account := fundAccount(t, createRandomAccount(t), 100)
+	arg := ChangeAccountStatusTxParam{
+		AccountID: account.ID,
+		Status:    AccountStatusFrozen,
+		ChangedBy: account.Owner,
+		Reason:    "suspicious activity",
+	}
+
+	result, err := store.ChangeAccountStatusTx(ctx, arg)
+	require.NoError(t, err)
+	require.Equal(t, AccountStatusFrozen, result.Account.Status)
+	require.Equal(t, AccountStatusActive, result.Change.FromStatus)
+	require.Equal(t, AccountStatusFrozen, result.Change.ToStatus)
+	require.Equal(t, arg.ChangedBy, result.Change.ChangedBy)
+	require.Equal(t, arg.Reason, result.Change.Reason)
+	require.False(t, result.Change.SweepTransferID.Valid)
+
+	// Frozen accounts are credited but not debited
+	_, err = store.DepositTx(ctx, DepositTxParam{AccountID: account.ID, Amount: 10})
+	require.NoError(t, err)
+
+	_, err = store.WithdrawTx(ctx, WithdrawTxParam{AccountID: account.ID, Amount: 10})
+	require.ErrorIs(t, err, ErrAccountFrozen)
+
+	other := createAccountWithCurrency(t, account.Currency)
+	_, err = store.TransferTx(ctx, TransferTxParam{FromAccountID: account.ID, ToAccountID: other.ID, Amount: 10})
+	require.ErrorIs(t, err, ErrAccountFrozen)
+
+	// Freezing twice isn't a transition
+	_, err = store.ChangeAccountStatusTx(ctx, arg)
+	require.ErrorIs(t, err, ErrInvalidStatusTransition)
+
+	arg.Status = AccountStatusActive
+	result, err = store.ChangeAccountStatusTx(ctx, arg)
+	require.NoError(t, err)
+	require.Equal(t, AccountStatusActive, result.Account.Status)
+
+	_, err = store.WithdrawTx(ctx, WithdrawTxParam{AccountID: account.ID, Amount: 10})
+	require.NoError(t, err)
+
+	changes, err := store.ListAccountStatusChanges(ctx, account.ID)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	require.Equal(t, AccountStatusFrozen, changes[0].ToStatus)
+	require.Equal(t, AccountStatusActive, changes[1].ToStatus)
+}
+
+func TestCloseAccountTx(t *testing.T) {
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	account := fundAccount(t, createRandomAccount(t), 100)
+	sweepTo := createAccountWithCurrency(t, account.Currency)
+
+	// Money can't be left behind in a closed account
+	arg := CloseAccountTxParam{AccountID: account.ID, ChangedBy: account.Owner}
+	_, err := store.CloseAccountTx(ctx, arg)
+	require.ErrorIs(t, err, ErrAccountBalanceNotZero)
+
+	arg.SweepToAccountID = sweepTo.ID
+	result, err := store.CloseAccountTx(ctx, arg)
+	require.NoError(t, err)
+	require.Equal(t, AccountStatusClosed, result.Account.Status)
+	require.Zero(t, result.Account.Balance)
+
+	require.NotNil(t, result.Sweep)
+	require.Equal(t, account.Balance, result.Sweep.Transfer.Amount)
+	require.Equal(t, sweepTo.Balance+account.Balance, result.Sweep.ToAccount.Balance)
+	require.Equal(t, result.Sweep.Transfer.ID, result.Change.SweepTransferID.Int64)
+
+	// Closed accounts can neither be credited nor reopened
+	_, err = store.DepositTx(ctx, DepositTxParam{AccountID: account.ID, Amount: 10})
+	require.ErrorIs(t, err, ErrAccountClosed)
+
+	_, err = store.TransferTx(ctx, TransferTxParam{FromAccountID: sweepTo.ID, ToAccountID: account.ID, Amount: 10})
+	require.ErrorIs(t, err, ErrAccountClosed)
+
+	_, err = store.ChangeAccountStatusTx(ctx, ChangeAccountStatusTxParam{AccountID: account.ID, Status: AccountStatusActive, ChangedBy: account.Owner})
+	require.ErrorIs(t, err, ErrInvalidStatusTransition)
+
+	// Empty accounts are closed without a sweep
+	empty := createAccountWithCurrency(t, account.Currency)
+	result, err = store.CloseAccountTx(ctx, CloseAccountTxParam{AccountID: empty.ID, ChangedBy: empty.Owner})
+	require.NoError(t, err)
+	require.Nil(t, result.Sweep)
+	require.Equal(t, AccountStatusClosed, result.Account.Status)
+}
+
+func TestCloseAccountTxSweepChecked(t *testing.T) {
+	ctx := context.Background()
+
+	account := fundAccount(t, createRandomAccount(t), 100)
+	sweepTo := createAccountWithCurrency(t, account.Currency)
+
+	// The balance can't be swept past the limits of the account
+	_, err := testQueries.CreateTransferLimit(ctx, CreateTransferLimitParams{
+		AccountID: sql.NullInt64{Int64: account.ID, Valid: true},
+		MaxAmount: sql.NullInt64{Int64: account.Balance - 1, Valid: true},
+	})
+	require.NoError(t, err)
+
+	arg := CloseAccountTxParam{AccountID: account.ID, SweepToAccountID: sweepTo.ID, ChangedBy: account.Owner}
+	_, err = NewStore(testDB).CloseAccountTx(ctx, arg)
+
+	var limitErr *TransferLimitError
+	require.True(t, errors.As(err, &limitErr))
+	require.Equal(t, TransferLimitMaxAmount, limitErr.Limit)
+
+	// Nor past the risk rules, a sweep can't wait for a review
+	other := fundAccount(t, createRandomAccount(t), 100)
+	arg.AccountID = other.ID
+	_, err = NewStore(testDB, WithRiskRules(reviewRule)).CloseAccountTx(ctx, arg)
+	require.ErrorIs(t, err, ErrTransferDenied)
+
+	updated, err := testQueries.GetAccount(ctx, other.ID)
+	require.NoError(t, err)
+	require.Equal(t, AccountStatusActive, updated.Status)
+	require.Equal(t, other.Balance, updated.Balance)
+}
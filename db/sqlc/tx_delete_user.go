@@ -0,0 +1,41 @@
+package db
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrNonZeroBalance = errors.New("all accounts must have zero balance before deleting the user")
+
+// DeleteUserTx soft deletes the user with all the user's accounts and blocks every session of the user
+func (store *SQLStore) DeleteUserTx(ctx context.Context, username string) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		accounts, err := q.GetUserAccountsForUpdate(ctx, username)
+		if err != nil {
+			return err
+		}
+
+		for _, account := range accounts {
+			if account.Balance != 0 {
+				return ErrNonZeroBalance
+			}
+		}
+
+		err = q.DeleteUserAccounts(ctx, username)
+		if err != nil {
+			return err
+		}
+
+		err = q.BlockUserSessions(ctx, username)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteUser(ctx, username)
+		if err != nil {
+			return err
+		}
+
+		return runTxHook(ctx, q, nil)
+	})
+}
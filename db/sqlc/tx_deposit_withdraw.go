@@ -0,0 +1,65 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+type DepositTxParam struct {
+	AccountID int64 `json:"account_id"`
+	Amount    int64 `json:"amount"`
+	// ExternalRef identifies the deposit in the system the money came from (teller receipt, payment id)
+	ExternalRef string `json:"external_ref"`
+}
+
+type WithdrawTxParam struct {
+	AccountID int64 `json:"account_id"`
+	Amount    int64 `json:"amount"`
+	// ExternalRef identifies the withdrawal in the system the money goes to (teller receipt, payout id)
+	ExternalRef string `json:"external_ref"`
+}
+
+type EntryTxResult struct {
+	Account Account `json:"account"`
+	Entry   Entry   `json:"entry"`
+}
+
+// DepositTx credits the account and records a deposit entry for it
+func (store *SQLStore) DepositTx(ctx context.Context, arg DepositTxParam) (EntryTxResult, error) {
+	return store.entryTx(ctx, arg.AccountID, arg.Amount, EntryTypeDeposit, arg.ExternalRef)
+}
+
+// WithdrawTx debits the account and records a withdrawal entry for it,
+// ErrInsufficientFunds is returned when the withdrawal exceeds the account overdraft limit
+func (store *SQLStore) WithdrawTx(ctx context.Context, arg WithdrawTxParam) (EntryTxResult, error) {
+	return store.entryTx(ctx, arg.AccountID, -arg.Amount, EntryTypeWithdrawal, arg.ExternalRef)
+}
+
+func (store *SQLStore) entryTx(ctx context.Context, accountID, amount int64, entryType EntryType, externalRef string) (EntryTxResult, error) {
+	var result EntryTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Account, err = addMoney(ctx, q, accountID, amount)
+		if err != nil {
+			return err
+		}
+
+		result.Entry, err = q.CreateEntry(ctx, CreateEntryParams{
+			AccountID:    accountID,
+			Amount:       amount,
+			Type:         entryType,
+			ExternalRef:  sql.NullString{String: externalRef, Valid: externalRef != ""},
+			BalanceAfter: result.Account.Balance,
+			Description:  string(entryType),
+		})
+		if err != nil {
+			return err
+		}
+
+		return runTxHook(ctx, q, result)
+	})
+
+	return result, err
+}
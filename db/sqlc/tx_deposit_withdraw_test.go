@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/escalopa/gobank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepositTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := createRandomAccount(t)
+	arg := DepositTxParam{
+		AccountID:   account.ID,
+		Amount:      util.RandomMoney() + 1,
+		ExternalRef: util.RandomString(12),
+	}
+
+	result, err := store.DepositTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	validateEntryBasic(t, result.Entry)
+	require.Equal(t, account.ID, result.Entry.AccountID)
+	require.Equal(t, arg.Amount, result.Entry.Amount)
+	require.Equal(t, EntryTypeDeposit, result.Entry.Type)
+	require.Equal(t, arg.ExternalRef, result.Entry.ExternalRef.String)
+	require.False(t, result.Entry.TransferID.Valid)
+	require.Equal(t, result.Account.Balance, result.Entry.BalanceAfter)
+
+	require.Equal(t, account.Balance+arg.Amount, result.Account.Balance)
+}
+
+func TestWithdrawTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := fundAccount(t, createRandomAccount(t), 100)
+	arg := WithdrawTxParam{
+		AccountID: account.ID,
+		Amount:    40,
+	}
+
+	result, err := store.WithdrawTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	validateEntryBasic(t, result.Entry)
+	require.Equal(t, -arg.Amount, result.Entry.Amount)
+	require.Equal(t, EntryTypeWithdrawal, result.Entry.Type)
+	require.False(t, result.Entry.ExternalRef.Valid)
+	require.Equal(t, result.Account.Balance, result.Entry.BalanceAfter)
+	require.Equal(t, account.Balance-arg.Amount, result.Account.Balance)
+
+	// Withdrawing more than the balance exceeds the overdraft limit
+	arg.Amount = result.Account.Balance + result.Account.OverdraftLimit + 1
+	_, err = store.WithdrawTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	updatedAccount, err := store.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, result.Account.Balance, updatedAccount.Balance)
+}
@@ -0,0 +1,221 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/escalopa/gobank/fx"
+)
+
+var (
+	ErrHoldNotActive      = errors.New("hold is already captured, released or expired")
+	ErrHoldExpired        = errors.New("hold is expired")
+	ErrCaptureExceedsHold = errors.New("capture exceeds the amount of the hold")
+	ErrNoHoldExpired      = errors.New("no hold is expired")
+	ErrAccountHasHolds    = errors.New("account has active holds, they must be captured or released first")
+)
+
+// AvailableBalance is the part of the balance that isn't reserved by the active holds of the account
+func (a Account) AvailableBalance() int64 {
+	return a.Balance - a.HeldAmount
+}
+
+type PlaceHoldTxParam struct {
+	AccountID int64 `json:"account_id"`
+	// ToAccountID is credited when the hold is captured
+	ToAccountID int64     `json:"to_account_id"`
+	Amount      int64     `json:"amount"`
+	Description string    `json:"description"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+type CaptureHoldTxParam struct {
+	HoldID int64 `json:"hold_id"`
+	// Amount is the part of the hold transferred, 0 captures all of it. The rest is released
+	Amount int64 `json:"amount"`
+	// Rate converts Amount into the currency of the destination account, nil for same currency holds
+	Rate *fx.Rate `json:"-"`
+}
+
+type HoldTxResult struct {
+	Hold Hold `json:"hold"`
+	// Account is the account of the hold, with its held amount after the change
+	Account Account `json:"account"`
+	// Capture is the transfer made by capturing the hold, nil when it is released
+	Capture *TransferTxResult `json:"capture"`
+}
+
+// PlaceHoldTx reserves arg.Amount on the account until the hold is captured, released or expires.
+// The amount is checked against the available balance in the same statement that reserves it, like debits do,
+// so concurrent holds and transfers can't reserve the same money twice
+func (store *SQLStore) PlaceHoldTx(ctx context.Context, arg PlaceHoldTxParam) (HoldTxResult, error) {
+	var result HoldTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result.Account, err = q.HoldAccountFunds(ctx, HoldAccountFundsParams{
+			ID:     arg.AccountID,
+			Amount: arg.Amount,
+		})
+
+		if err == sql.ErrNoRows {
+			return balanceNotUpdatedError(ctx, q, arg.AccountID)
+		}
+		if err != nil {
+			return err
+		}
+
+		result.Hold, err = q.CreateHold(ctx, CreateHoldParams{
+			AccountID:   arg.AccountID,
+			ToAccountID: arg.ToAccountID,
+			Amount:      arg.Amount,
+			Description: arg.Description,
+			ExpiresAt:   arg.ExpiresAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		return runTxHook(ctx, q, result)
+	})
+
+	return result, err
+}
+
+// CaptureHoldTx transfers arg.Amount of the hold to its destination account and releases the rest. The capture is
+// checked against the limits and the risk rules like a transfer. ErrHoldExpired is returned once the hold expired,
+// even when it wasn't released yet
+func (store *SQLStore) CaptureHoldTx(ctx context.Context, arg CaptureHoldTxParam) (HoldTxResult, error) {
+	var result HoldTxResult
+
+	rate := fx.Rate{Value: fx.RateScale, UpdatedAt: time.Now()}
+	if arg.Rate != nil {
+		rate = *arg.Rate
+	}
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		hold, err := q.GetHoldForUpdate(ctx, arg.HoldID)
+		if err != nil {
+			return err
+		}
+
+		if hold.Status != HoldStatusActive {
+			return ErrHoldNotActive
+		}
+		if !hold.ExpiresAt.After(time.Now()) {
+			return ErrHoldExpired
+		}
+
+		amount := arg.Amount
+		if amount == 0 {
+			amount = hold.Amount
+		}
+		if amount < 0 || amount > hold.Amount {
+			return ErrCaptureExceedsHold
+		}
+
+		toAmount := rate.Convert(amount)
+		if toAmount < 1 {
+			return ErrConvertedAmountTooSmall
+		}
+
+		accounts, err := lockAccounts(ctx, q, hold.AccountID, hold.ToAccountID)
+		if err != nil {
+			return err
+		}
+
+		if err = store.checkSettledTransfer(ctx, q, accounts[hold.AccountID], accounts[hold.ToAccountID], amount); err != nil {
+			return err
+		}
+
+		// The whole hold is released first so that the transfer can debit the captured part of it
+		if _, err = q.ReleaseAccountFunds(ctx, ReleaseAccountFundsParams{ID: hold.AccountID, Amount: hold.Amount}); err != nil {
+			return err
+		}
+
+		capture, err := transfer(ctx, q, hold.AccountID, hold.ToAccountID, amount, toAmount, rate, TransferFee{})
+		if err != nil {
+			return err
+		}
+
+		result.Capture = &capture
+		result.Account = capture.FromAccount
+		result.Hold, err = q.CloseHold(ctx, CloseHoldParams{
+			ID:             hold.ID,
+			Status:         HoldStatusCaptured,
+			CapturedAmount: amount,
+			TransferID:     sql.NullInt64{Int64: capture.Transfer.ID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		return runTxHook(ctx, q, result)
+	})
+
+	return result, err
+}
+
+// ReleaseHoldTx gives the amount of the hold back to the available balance of its account
+func (store *SQLStore) ReleaseHoldTx(ctx context.Context, holdID int64) (HoldTxResult, error) {
+	var result HoldTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		hold, err := q.GetHoldForUpdate(ctx, holdID)
+		if err != nil {
+			return err
+		}
+
+		result, err = releaseHold(ctx, q, hold, HoldStatusReleased)
+		if err != nil {
+			return err
+		}
+
+		return runTxHook(ctx, q, result)
+	})
+
+	return result, err
+}
+
+// ReleaseExpiredHoldTx releases the hold that expired the earliest as expired, skipping the ones locked by other workers.
+// ErrNoHoldExpired is returned when no active hold expired at now
+func (store *SQLStore) ReleaseExpiredHoldTx(ctx context.Context, now time.Time) (HoldTxResult, error) {
+	var result HoldTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		hold, err := q.GetExpiredHoldForUpdate(ctx, now)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return ErrNoHoldExpired
+			}
+			return err
+		}
+
+		result, err = releaseHold(ctx, q, hold, HoldStatusExpired)
+		if err != nil {
+			return err
+		}
+
+		return runTxHook(ctx, q, result)
+	})
+
+	return result, err
+}
+
+// releaseHold closes the locked hold with status and gives its amount back to its account
+func releaseHold(ctx context.Context, q *Queries, hold Hold, status HoldStatus) (result HoldTxResult, err error) {
+	if hold.Status != HoldStatusActive {
+		err = ErrHoldNotActive
+		return
+	}
+
+	result.Account, err = q.ReleaseAccountFunds(ctx, ReleaseAccountFundsParams{ID: hold.AccountID, Amount: hold.Amount})
+	if err != nil {
+		return
+	}
+
+	result.Hold, err = q.CloseHold(ctx, CloseHoldParams{ID: hold.ID, Status: status})
+	return
+}
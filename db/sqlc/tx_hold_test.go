@@ -0,0 +1,189 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func placeRandomHold(t *testing.T, store Store, account, to Account, amount int64, expiresAt time.Time) HoldTxResult {
+	result, err := store.PlaceHoldTx(context.Background(), PlaceHoldTxParam{
+		AccountID:   account.ID,
+		ToAccountID: to.ID,
+		Amount:      amount,
+		Description: "card authorization",
+		ExpiresAt:   expiresAt,
+	})
+	require.NoError(t, err)
+	return result
+}
+
+func TestPlaceHoldTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1, account2 := createRandomAccount(t), createRandomAccount(t)
+	account1 = fundAccount(t, account1, 100)
+
+	result := placeRandomHold(t, store, account1, account2, 60, time.Now().Add(time.Hour))
+	require.NotZero(t, result.Hold.ID)
+	require.Equal(t, HoldStatusActive, result.Hold.Status)
+	require.Equal(t, int64(60), result.Hold.Amount)
+	require.Equal(t, account1.Balance, result.Account.Balance)
+	require.Equal(t, int64(60), result.Account.HeldAmount)
+	require.Equal(t, account1.Balance-60, result.Account.AvailableBalance())
+
+	// Transfers are checked against the available balance
+	_, err := store.TransferTx(context.Background(), TransferTxParam{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        result.Account.AvailableBalance() + 1,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	// So are holds
+	_, err = store.PlaceHoldTx(context.Background(), PlaceHoldTxParam{
+		AccountID:   account1.ID,
+		ToAccountID: account2.ID,
+		Amount:      result.Account.AvailableBalance() + 1,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	holds, err := store.ListAccountHolds(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Len(t, holds, 1)
+}
+
+func TestCaptureHoldTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1, account2 := createRandomAccount(t), createRandomAccount(t)
+	account1 = fundAccount(t, account1, 100)
+
+	hold := placeRandomHold(t, store, account1, account2, 60, time.Now().Add(time.Hour))
+
+	_, err := store.CaptureHoldTx(context.Background(), CaptureHoldTxParam{HoldID: hold.Hold.ID, Amount: 61})
+	require.ErrorIs(t, err, ErrCaptureExceedsHold)
+
+	// A partial capture releases the rest of the hold
+	result, err := store.CaptureHoldTx(context.Background(), CaptureHoldTxParam{HoldID: hold.Hold.ID, Amount: 45})
+	require.NoError(t, err)
+	require.Equal(t, HoldStatusCaptured, result.Hold.Status)
+	require.Equal(t, int64(45), result.Hold.CapturedAmount)
+	require.True(t, result.Hold.ClosedAt.Valid)
+
+	require.NotNil(t, result.Capture)
+	require.Equal(t, result.Capture.Transfer.ID, result.Hold.TransferID.Int64)
+	require.Equal(t, int64(45), result.Capture.Transfer.Amount)
+	require.Equal(t, account2.Balance+45, result.Capture.ToAccount.Balance)
+
+	require.Zero(t, result.Account.HeldAmount)
+	require.Equal(t, account1.Balance-45, result.Account.Balance)
+
+	_, err = store.CaptureHoldTx(context.Background(), CaptureHoldTxParam{HoldID: hold.Hold.ID})
+	require.ErrorIs(t, err, ErrHoldNotActive)
+}
+
+func TestCaptureHoldTxChecked(t *testing.T) {
+	store := NewStore(testDB, WithRiskRules(denyRule))
+
+	account1, account2 := createRandomAccount(t), createRandomAccount(t)
+	account1 = fundAccount(t, account1, 100)
+
+	// Captures go through the risk rules like transfers, the hold is left active when one is denied
+	hold := placeRandomHold(t, store, account1, account2, 60, time.Now().Add(time.Hour))
+	_, err := store.CaptureHoldTx(context.Background(), CaptureHoldTxParam{HoldID: hold.Hold.ID})
+	require.ErrorIs(t, err, ErrTransferDenied)
+
+	holds, err := store.ListAccountHolds(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Len(t, holds, 1)
+	require.Equal(t, HoldStatusActive, holds[0].Status)
+}
+
+func TestReleaseHoldTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1, account2 := createRandomAccount(t), createRandomAccount(t)
+	account1 = fundAccount(t, account1, 100)
+
+	hold := placeRandomHold(t, store, account1, account2, 60, time.Now().Add(time.Hour))
+
+	result, err := store.ReleaseHoldTx(context.Background(), hold.Hold.ID)
+	require.NoError(t, err)
+	require.Equal(t, HoldStatusReleased, result.Hold.Status)
+	require.Zero(t, result.Hold.CapturedAmount)
+	require.False(t, result.Hold.TransferID.Valid)
+	require.Nil(t, result.Capture)
+	require.Zero(t, result.Account.HeldAmount)
+	require.Equal(t, account1.Balance, result.Account.Balance)
+
+	_, err = store.ReleaseHoldTx(context.Background(), hold.Hold.ID)
+	require.ErrorIs(t, err, ErrHoldNotActive)
+}
+
+func TestReleaseExpiredHoldTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1, account2 := createRandomAccount(t), createRandomAccount(t)
+	account1 = fundAccount(t, account1, 100)
+
+	hold := placeRandomHold(t, store, account1, account2, 60, time.Now().Add(time.Minute))
+
+	_, err := store.CaptureHoldTx(context.Background(), CaptureHoldTxParam{HoldID: hold.Hold.ID})
+	require.NoError(t, err)
+
+	hold = placeRandomHold(t, store, account1, account2, 20, time.Now().Add(time.Minute))
+
+	// Holds of other tests may expire first, release them all
+	later := time.Now().Add(time.Hour)
+	released := false
+	for {
+		result, err := store.ReleaseExpiredHoldTx(context.Background(), later)
+		if err == ErrNoHoldExpired {
+			break
+		}
+		require.NoError(t, err)
+		require.Equal(t, HoldStatusExpired, result.Hold.Status)
+
+		if result.Hold.ID == hold.Hold.ID {
+			released = true
+			require.Zero(t, result.Account.HeldAmount)
+		}
+	}
+	require.True(t, released)
+
+	_, err = store.CaptureHoldTx(context.Background(), CaptureHoldTxParam{HoldID: hold.Hold.ID})
+	require.ErrorIs(t, err, ErrHoldNotActive)
+}
+
+func TestCaptureHoldTxExpired(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1, account2 := createRandomAccount(t), createRandomAccount(t)
+	account1 = fundAccount(t, account1, 100)
+
+	// Expired but not released yet
+	hold := placeRandomHold(t, store, account1, account2, 60, time.Now().Add(-time.Second))
+
+	_, err := store.CaptureHoldTx(context.Background(), CaptureHoldTxParam{HoldID: hold.Hold.ID})
+	require.ErrorIs(t, err, ErrHoldExpired)
+}
+
+func TestCloseAccountTxWithHolds(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1, account2 := createRandomAccount(t), createRandomAccount(t)
+	account1 = fundAccount(t, account1, 100)
+
+	placeRandomHold(t, store, account1, account2, 60, time.Now().Add(time.Hour))
+
+	_, err := store.CloseAccountTx(context.Background(), CloseAccountTxParam{
+		AccountID:        account1.ID,
+		SweepToAccountID: account2.ID,
+		ChangedBy:        account1.Owner,
+	})
+	require.ErrorIs(t, err, ErrAccountHasHolds)
+}
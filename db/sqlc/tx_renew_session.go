@@ -0,0 +1,77 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrRefreshTokenReused = errors.New("refresh token was already renewed, every session of its family is blocked")
+	ErrSessionBlocked     = errors.New("session is blocked")
+)
+
+type RenewSessionTxParam struct {
+	// SessionID is the session of the refresh token being renewed
+	SessionID uuid.UUID `json:"session_id"`
+	// Session is the session of the new refresh token, it joins the family of the renewed session
+	Session CreateSessionParams `json:"-"`
+}
+
+type RenewSessionTxResult struct {
+	// Session is the new session, zero when the refresh token was reused
+	Session  Session   `json:"session"`
+	FamilyID uuid.UUID `json:"family_id"`
+	// Reused is set when the refresh token was already renewed and the family got blocked
+	Reused bool `json:"reused"`
+}
+
+// RenewSessionTx rotates the session of a refresh token: the session is marked as rotated and a new session
+// of the same family is created for the new refresh token.
+// A refresh token is renewed once, when a rotated one is renewed again it has leaked, so every session of its family
+// is blocked and ErrRefreshTokenReused is returned. The session is locked, so of two concurrent renewals of the same
+// refresh token the second one is the reuse
+func (store *SQLStore) RenewSessionTx(ctx context.Context, arg RenewSessionTxParam) (RenewSessionTxResult, error) {
+	var result RenewSessionTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		session, err := q.GetSessionForUpdate(ctx, arg.SessionID)
+		if err != nil {
+			return err
+		}
+
+		if session.IsBlocked {
+			return ErrSessionBlocked
+		}
+
+		result.FamilyID = session.FamilyID
+		if session.RotatedAt.Valid {
+			result.Reused = true
+			if err = q.BlockSessionFamily(ctx, session.FamilyID); err != nil {
+				return err
+			}
+			return runTxHook(ctx, q, result)
+		}
+
+		if _, err = q.RotateSession(ctx, session.ID); err != nil {
+			return err
+		}
+
+		newSession := arg.Session
+		newSession.FamilyID = session.FamilyID
+		result.Session, err = q.CreateSession(ctx, newSession)
+		if err != nil {
+			return err
+		}
+
+		return runTxHook(ctx, q, result)
+	})
+
+	// The family is blocked in the transaction, so the error is only returned once it is committed
+	if err == nil && result.Reused {
+		err = ErrRefreshTokenReused
+	}
+
+	return result, err
+}
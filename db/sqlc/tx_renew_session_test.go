@@ -0,0 +1,54 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/escalopa/gobank/util"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func createSessionParams(username string) CreateSessionParams {
+	id := uuid.New()
+	return CreateSessionParams{
+		ID:           id,
+		Username:     username,
+		RefreshToken: util.RandomString(32),
+		UserAgent:    "go-test",
+		ClientIp:     "127.0.0.1",
+		ExpiresAt:    time.Now().Add(time.Hour),
+		FamilyID:     id,
+	}
+}
+
+func TestRenewSessionTx(t *testing.T) {
+	store := NewStore(testDB)
+	ctx := context.Background()
+	user := createRandomUser(t)
+
+	login, err := store.CreateSession(ctx, createSessionParams(user.Username))
+	require.NoError(t, err)
+
+	result, err := store.RenewSessionTx(ctx, RenewSessionTxParam{SessionID: login.ID, Session: createSessionParams(user.Username)})
+	require.NoError(t, err)
+	require.False(t, result.Reused)
+	require.Equal(t, login.FamilyID, result.Session.FamilyID)
+
+	rotated, err := store.GetSession(ctx, login.ID)
+	require.NoError(t, err)
+	require.True(t, rotated.RotatedAt.Valid)
+	require.False(t, rotated.IsBlocked)
+
+	// The rotated refresh token shows up again, the whole family is blocked
+	_, err = store.RenewSessionTx(ctx, RenewSessionTxParam{SessionID: login.ID, Session: createSessionParams(user.Username)})
+	require.ErrorIs(t, err, ErrRefreshTokenReused)
+
+	latest, err := store.GetSession(ctx, result.Session.ID)
+	require.NoError(t, err)
+	require.True(t, latest.IsBlocked)
+
+	_, err = store.RenewSessionTx(ctx, RenewSessionTxParam{SessionID: latest.ID, Session: createSessionParams(user.Username)})
+	require.ErrorIs(t, err, ErrSessionBlocked)
+}
@@ -0,0 +1,171 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/escalopa/gobank/fx"
+)
+
+var (
+	ErrTransferReversed        = errors.New("transfer is already fully reversed")
+	ErrTransferIsReversal      = errors.New("transfer is a reversal, it can't be reversed")
+	ErrReversalExceedsTransfer = errors.New("reversal exceeds the amount of the transfer that is left to reverse")
+	ErrRefundWindowClosed      = errors.New("transfer is too old to be refunded by its recipient")
+)
+
+type ReverseTransferTxParam struct {
+	TransferID int64 `json:"transfer_id"`
+	// Amount is the part of the amount of the transfer given back to its sender, 0 reverses all that is left
+	Amount int64 `json:"amount"`
+}
+
+type ReverseTransferTxResult struct {
+	// Original is the reversed transfer, along with the amount reversed so far
+	Original Transfer `json:"original"`
+	// Reversal moves the money back, from the recipient of the original transfer to its sender
+	Reversal TransferTxResult `json:"reversal"`
+}
+
+// RemainingAmount is the part of the amount of the transfer that can still be reversed
+func (t Transfer) RemainingAmount() int64 {
+	return t.Amount - t.ReversedAmount
+}
+
+// CanBeRefunded tells whether the recipient of the transfer can still refund it at now, they have window after it
+// was made. Admins can reverse transfers at any time
+func (t Transfer) CanBeRefunded(now time.Time, window time.Duration) error {
+	if now.After(t.CreatedAt.Add(window)) {
+		return ErrRefundWindowClosed
+	}
+	return nil
+}
+
+// convertedShare is the part of ToAmount matching reversed out of Amount, at the rate of the transfer.
+// It reaches ToAmount exactly once all of Amount is reversed, so that partial reversals never take back
+// more than the recipient got
+func (t Transfer) convertedShare(reversed int64) int64 {
+	share := new(big.Int).Mul(big.NewInt(reversed), big.NewInt(t.ToAmount))
+	return share.Quo(share, big.NewInt(t.Amount)).Int64()
+}
+
+// ReverseTransferTx gives arg.Amount of the transfer back to its sender with a reversal transfer, debiting its
+// recipient with the matching part of what they received. A transfer can be refunded in parts until all of
+// its amount is reversed, ErrTransferReversed is returned after that
+func (store *SQLStore) ReverseTransferTx(ctx context.Context, arg ReverseTransferTxParam) (ReverseTransferTxResult, error) {
+	var result ReverseTransferTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// Locking the original transfer serializes its reversals
+		original, err := q.GetTransferForUpdate(ctx, arg.TransferID)
+		if err != nil {
+			return err
+		}
+
+		if original.Status != TransferStatusCompleted {
+			return ErrTransferNotCompleted
+		}
+
+		if original.ReversalOf.Valid {
+			return ErrTransferIsReversal
+		}
+
+		remaining := original.RemainingAmount()
+		if remaining == 0 {
+			return ErrTransferReversed
+		}
+
+		amount := arg.Amount
+		if amount == 0 {
+			amount = remaining
+		}
+		if amount < 0 || amount > remaining {
+			return ErrReversalExceedsTransfer
+		}
+
+		// The recipient gives back what they got for the reversed part, in the currency they got it in
+		debit := original.convertedShare(original.ReversedAmount+amount) - original.convertedShare(original.ReversedAmount)
+		if debit < 1 {
+			return ErrConvertedAmountTooSmall
+		}
+
+		result.Reversal, err = reverseTransfer(ctx, q, original, debit, amount)
+		if err != nil {
+			return err
+		}
+
+		result.Original, err = q.AddTransferReversedAmount(ctx, AddTransferReversedAmountParams{
+			ID:     original.ID,
+			Amount: amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		return runTxHook(ctx, q, result)
+	})
+
+	return result, err
+}
+
+// reverseTransfer moves debit out of the recipient of original and amount into its sender, updating the balances
+// in the order of the account ids like transfer does
+func reverseTransfer(ctx context.Context, q *Queries, original Transfer, debit, amount int64) (results TransferTxResult, err error) {
+	fromAccountID, toAccountID := original.ToAccountID, original.FromAccountID
+
+	results.Transfer, err = q.CreateReversalTransfer(ctx, CreateReversalTransferParams{
+		FromAccountID: fromAccountID,
+		ToAccountID:   toAccountID,
+		Amount:        debit,
+		ToAmount:      amount,
+		// Converted back at the rate of the original transfer
+		FxRate:     fx.InverseRate(original.FxRate),
+		FxRateAt:   original.FxRateAt,
+		ReversalOf: sql.NullInt64{Int64: original.ID, Valid: true},
+	})
+
+	if err != nil {
+		return
+	}
+
+	if fromAccountID < toAccountID {
+		results.FromAccount, results.ToAccount, err = transferMoney(ctx, q, fromAccountID, -debit, toAccountID, amount)
+	} else {
+		results.ToAccount, results.FromAccount, err = transferMoney(ctx, q, toAccountID, amount, fromAccountID, -debit)
+	}
+
+	if err != nil {
+		return
+	}
+
+	transferID := sql.NullInt64{Int64: results.Transfer.ID, Valid: true}
+	description := fmt.Sprintf("reversal of transfer %d", original.ID)
+
+	results.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID:    fromAccountID,
+		Amount:       -debit,
+		Type:         EntryTypeTransfer,
+		TransferID:   transferID,
+		BalanceAfter: results.FromAccount.Balance,
+		Description:  description,
+	})
+
+	if err != nil {
+		return
+	}
+
+	results.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID:    toAccountID,
+		Amount:       amount,
+		Type:         EntryTypeTransfer,
+		TransferID:   transferID,
+		BalanceAfter: results.ToAccount.Balance,
+		Description:  description,
+	})
+
+	return
+}
@@ -0,0 +1,149 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/escalopa/gobank/fx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReverseTransferTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1, account2 := createRandomAccount(t), createRandomAccount(t)
+	account1 = fundAccount(t, account1, 100)
+
+	transfer, err := store.TransferTx(context.Background(), TransferTxParam{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        50,
+	})
+	require.NoError(t, err)
+
+	// Partial refund
+	result, err := store.ReverseTransferTx(context.Background(), ReverseTransferTxParam{
+		TransferID: transfer.Transfer.ID,
+		Amount:     20,
+	})
+	require.NoError(t, err)
+
+	validateTransferBasic(t, result.Reversal.Transfer)
+	require.Equal(t, account2.ID, result.Reversal.Transfer.FromAccountID)
+	require.Equal(t, account1.ID, result.Reversal.Transfer.ToAccountID)
+	require.Equal(t, int64(20), result.Reversal.Transfer.Amount)
+	require.Equal(t, sql.NullInt64{Int64: transfer.Transfer.ID, Valid: true}, result.Reversal.Transfer.ReversalOf)
+
+	require.Equal(t, int64(-20), result.Reversal.FromEntry.Amount)
+	require.Equal(t, int64(20), result.Reversal.ToEntry.Amount)
+	require.Equal(t, result.Reversal.Transfer.ID, result.Reversal.ToEntry.TransferID.Int64)
+	require.Equal(t, transfer.FromAccount.Balance+20, result.Reversal.ToAccount.Balance)
+	require.Equal(t, transfer.ToAccount.Balance-20, result.Reversal.FromAccount.Balance)
+
+	require.Equal(t, int64(20), result.Original.ReversedAmount)
+	require.True(t, result.Original.ReversedAt.Valid)
+
+	// More than what is left
+	_, err = store.ReverseTransferTx(context.Background(), ReverseTransferTxParam{
+		TransferID: transfer.Transfer.ID,
+		Amount:     31,
+	})
+	require.ErrorIs(t, err, ErrReversalExceedsTransfer)
+
+	// A reversal can't be reversed
+	_, err = store.ReverseTransferTx(context.Background(), ReverseTransferTxParam{
+		TransferID: result.Reversal.Transfer.ID,
+	})
+	require.ErrorIs(t, err, ErrTransferIsReversal)
+
+	// The rest
+	result, err = store.ReverseTransferTx(context.Background(), ReverseTransferTxParam{
+		TransferID: transfer.Transfer.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(30), result.Reversal.Transfer.Amount)
+	require.Equal(t, int64(50), result.Original.ReversedAmount)
+	require.Equal(t, account1.Balance, result.Reversal.ToAccount.Balance)
+	require.Equal(t, account2.Balance, result.Reversal.FromAccount.Balance)
+
+	reversals, err := store.ListTransferReversals(context.Background(), sql.NullInt64{Int64: transfer.Transfer.ID, Valid: true})
+	require.NoError(t, err)
+	require.Len(t, reversals, 2)
+
+	// Twice
+	_, err = store.ReverseTransferTx(context.Background(), ReverseTransferTxParam{
+		TransferID: transfer.Transfer.ID,
+	})
+	require.ErrorIs(t, err, ErrTransferReversed)
+}
+
+func TestReverseTransferTxExchangeRate(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1, account2 := createRandomAccount(t), createRandomAccount(t)
+	account1 = fundAccount(t, account1, 100)
+
+	rate := fx.Rate{From: account1.Currency, To: account2.Currency, Value: 5 * fx.RateScale / 2, UpdatedAt: time.Now()}
+	transfer, err := store.TransferTx(context.Background(), TransferTxParam{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        15,
+		Rate:          &rate,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(37), transfer.Transfer.ToAmount)
+
+	// The recipient gives back the converted share of the refund, never more than they got in total
+	for _, refund := range []struct{ amount, debit int64 }{{5, 12}, {5, 12}, {5, 13}} {
+		result, err := store.ReverseTransferTx(context.Background(), ReverseTransferTxParam{
+			TransferID: transfer.Transfer.ID,
+			Amount:     refund.amount,
+		})
+		require.NoError(t, err)
+		require.Equal(t, refund.debit, result.Reversal.Transfer.Amount)
+		require.Equal(t, refund.amount, result.Reversal.Transfer.ToAmount)
+	}
+
+	updatedAccount2, err := store.GetAccount(context.Background(), account2.ID)
+	require.NoError(t, err)
+	require.Equal(t, account2.Balance, updatedAccount2.Balance)
+}
+
+func TestReverseTransferTxInsufficientFunds(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1, account2 := createRandomAccount(t), createRandomAccount(t)
+	account1 = fundAccount(t, account1, 100)
+
+	transfer, err := store.TransferTx(context.Background(), TransferTxParam{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        50,
+	})
+	require.NoError(t, err)
+
+	// The recipient already spent the money
+	_, err = store.WithdrawTx(context.Background(), WithdrawTxParam{
+		AccountID: account2.ID,
+		Amount:    transfer.ToAccount.Balance,
+	})
+	require.NoError(t, err)
+
+	_, err = store.ReverseTransferTx(context.Background(), ReverseTransferTxParam{TransferID: transfer.Transfer.ID})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	// Nothing is recorded as reversed
+	original, err := store.GetTransfer(context.Background(), transfer.Transfer.ID)
+	require.NoError(t, err)
+	require.Zero(t, original.ReversedAmount)
+	require.False(t, original.ReversedAt.Valid)
+}
+
+func TestTransferCanBeRefunded(t *testing.T) {
+	transfer := Transfer{CreatedAt: time.Now().Add(-time.Hour)}
+
+	require.NoError(t, transfer.CanBeRefunded(time.Now(), 2*time.Hour))
+	require.ErrorIs(t, transfer.CanBeRefunded(time.Now(), time.Minute), ErrRefundWindowClosed)
+}
@@ -0,0 +1,94 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+var (
+	ErrTransferNotPending   = errors.New("transfer isn't pending review")
+	ErrTransferNotCompleted = errors.New("transfer isn't completed, it is pending review or was rejected")
+)
+
+type ReviewTransferTxParam struct {
+	TransferID int64  `json:"transfer_id"`
+	ReviewedBy string `json:"reviewed_by"`
+	// Note is why the transfer is approved or rejected, optional
+	Note string `json:"note"`
+}
+
+// ApproveTransferTx moves the money of a transfer put into review by a risk rule, at the rate and with the fee it was
+// created with. The limits and the risk rules aren't checked again, the balance and the status of the accounts are
+func (store *SQLStore) ApproveTransferTx(ctx context.Context, arg ReviewTransferTxParam) (TransferTxResult, error) {
+	var result TransferTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		pending, err := getPendingTransferForUpdate(ctx, q, arg.TransferID)
+		if err != nil {
+			return err
+		}
+
+		_, err = lockAccounts(ctx, q, pending.FromAccountID, pending.ToAccountID, pending.FeeAccountID.Int64)
+		if err != nil {
+			return err
+		}
+
+		result, err = settleTransfer(ctx, q, pending)
+		if err != nil {
+			return err
+		}
+
+		result.Transfer, err = q.ReviewTransfer(ctx, reviewTransferParams(arg, TransferStatusCompleted))
+		if err != nil {
+			return err
+		}
+
+		return runTxHook(ctx, q, result)
+	})
+
+	return result, err
+}
+
+// RejectTransferTx closes a transfer put into review by a risk rule, no money is moved
+func (store *SQLStore) RejectTransferTx(ctx context.Context, arg ReviewTransferTxParam) (Transfer, error) {
+	var transfer Transfer
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		_, err := getPendingTransferForUpdate(ctx, q, arg.TransferID)
+		if err != nil {
+			return err
+		}
+
+		transfer, err = q.ReviewTransfer(ctx, reviewTransferParams(arg, TransferStatusRejected))
+		if err != nil {
+			return err
+		}
+
+		return runTxHook(ctx, q, transfer)
+	})
+
+	return transfer, err
+}
+
+// getPendingTransferForUpdate locks the transfer so that it is approved or rejected only once
+func getPendingTransferForUpdate(ctx context.Context, q *Queries, id int64) (Transfer, error) {
+	transfer, err := q.GetTransferForUpdate(ctx, id)
+	if err != nil {
+		return transfer, err
+	}
+
+	if transfer.Status != TransferStatusPendingReview {
+		return transfer, ErrTransferNotPending
+	}
+	return transfer, nil
+}
+
+func reviewTransferParams(arg ReviewTransferTxParam, status TransferStatus) ReviewTransferParams {
+	return ReviewTransferParams{
+		ID:         arg.TransferID,
+		Status:     status,
+		ReviewedBy: sql.NullString{String: arg.ReviewedBy, Valid: true},
+		ReviewNote: sql.NullString{String: arg.Note, Valid: arg.Note != ""},
+	}
+}
@@ -0,0 +1,57 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+var ErrNoScheduledTransferDue = errors.New("no scheduled transfer is due")
+
+// ScheduledTransferFunc executes the due occurrence of a scheduled transfer,
+// the transfer must be stored under IdempotencyKey so that retrying the occurrence doesn't move money twice
+type ScheduledTransferFunc func(ctx context.Context, scheduled ScheduledTransfer) (TransferTxResult, error)
+
+// RunScheduledTransferTx locks the scheduled transfer that is due the earliest, skipping the ones locked by other workers,
+// executes its occurrence with fn, then records the attempt and moves the schedule to its next occurrence.
+// ErrNoScheduledTransferDue is returned when nothing is due.
+//
+// The lock is held while fn runs, when the transaction can't commit after fn did the occurrence is retried,
+// and its idempotency key replays the transfer instead of making it twice
+func (store *SQLStore) RunScheduledTransferTx(ctx context.Context, now time.Time, fn ScheduledTransferFunc) (ScheduledTransferAttempt, error) {
+	var attempt ScheduledTransferAttempt
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		scheduled, err := q.GetDueScheduledTransferForUpdate(ctx, now)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return ErrNoScheduledTransferDue
+			}
+			return err
+		}
+
+		arg := CreateScheduledTransferAttemptParams{
+			ScheduledTransferID: scheduled.ID,
+			Occurrence:          scheduled.Occurrence,
+			ScheduledFor:        scheduled.NextRunAt,
+		}
+
+		result, err := fn(ctx, scheduled)
+		if err != nil {
+			arg.Error = sql.NullString{String: err.Error(), Valid: true}
+		} else {
+			arg.TransferID = sql.NullInt64{Int64: result.Transfer.ID, Valid: true}
+		}
+
+		attempt, err = q.CreateScheduledTransferAttempt(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.AdvanceScheduledTransfer(ctx, scheduled.next(now, !arg.Error.Valid))
+		return err
+	})
+
+	return attempt, err
+}
@@ -0,0 +1,204 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var errNotUnderTest = errors.New("scheduled transfer isn't under test")
+
+func newScheduledTransfer(t *testing.T, from, to Account, amount int64, frequency TransferFrequency, startAt time.Time) ScheduledTransfer {
+	scheduled, err := testQueries.CreateScheduledTransfer(context.Background(), CreateScheduledTransferParams{
+		Owner:         from.Owner,
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        amount,
+		Frequency:     frequency,
+		StartAt:       startAt,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, scheduled.ID)
+	require.Equal(t, ScheduledTransferStatusActive, scheduled.Status)
+	require.WithinDuration(t, startAt, scheduled.NextRunAt, time.Second)
+	require.Zero(t, scheduled.Occurrence)
+	return scheduled
+}
+
+// runScheduledTransfer makes transfers for the occurrences of scheduled only, other due schedules left by
+// previous tests are attempted with errNotUnderTest so that they don't block the one under test
+func runScheduledTransfer(store Store, scheduled ScheduledTransfer) ScheduledTransferFunc {
+	return func(ctx context.Context, st ScheduledTransfer) (TransferTxResult, error) {
+		if st.ID != scheduled.ID {
+			return TransferTxResult{}, errNotUnderTest
+		}
+
+		return store.TransferTx(ctx, TransferTxParam{
+			FromAccountID: st.FromAccountID,
+			ToAccountID:   st.ToAccountID,
+			Amount:        st.Amount,
+			Idempotency:   &IdempotencyParam{Key: st.IdempotencyKey(), Username: st.Owner, Retention: time.Hour},
+		})
+	}
+}
+
+// runDueScheduledTransfers runs every due schedule and returns the attempts made for scheduled
+func runDueScheduledTransfers(t *testing.T, store Store, scheduled ScheduledTransfer, now time.Time) []ScheduledTransferAttempt {
+	var attempts []ScheduledTransferAttempt
+	for {
+		attempt, err := store.RunScheduledTransferTx(context.Background(), now, runScheduledTransfer(store, scheduled))
+		if errors.Is(err, ErrNoScheduledTransferDue) {
+			return attempts
+		}
+		require.NoError(t, err)
+
+		if attempt.ScheduledTransferID == scheduled.ID {
+			attempts = append(attempts, attempt)
+		}
+	}
+}
+
+func TestScheduledTransferRunAt(t *testing.T) {
+	start := time.Date(2023, time.January, 31, 9, 0, 0, 0, time.UTC)
+
+	monthly := ScheduledTransfer{Frequency: TransferFrequencyMonthly, StartAt: start}
+	require.Equal(t, start, monthly.RunAt(0))
+	require.Equal(t, time.Date(2023, time.February, 28, 9, 0, 0, 0, time.UTC), monthly.RunAt(1))
+	require.Equal(t, time.Date(2023, time.March, 31, 9, 0, 0, 0, time.UTC), monthly.RunAt(2))
+	require.Equal(t, time.Date(2024, time.February, 29, 9, 0, 0, 0, time.UTC), monthly.RunAt(13))
+
+	weekly := ScheduledTransfer{Frequency: TransferFrequencyWeekly, StartAt: start}
+	require.Equal(t, time.Date(2023, time.February, 14, 9, 0, 0, 0, time.UTC), weekly.RunAt(2))
+
+	daily := ScheduledTransfer{Frequency: TransferFrequencyDaily, StartAt: start}
+	require.Equal(t, time.Date(2023, time.February, 1, 9, 0, 0, 0, time.UTC), daily.RunAt(1))
+
+	once := ScheduledTransfer{Frequency: TransferFrequencyOnce, StartAt: start}
+	require.Equal(t, start, once.RunAt(5))
+}
+
+func TestScheduledTransferNext(t *testing.T) {
+	start := time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC)
+	daily := ScheduledTransfer{Frequency: TransferFrequencyDaily, StartAt: start, NextRunAt: start}
+
+	// Occurrences missed until now are skipped
+	arg := daily.next(start.Add(72*time.Hour), true)
+	require.Equal(t, int32(4), arg.Occurrence)
+	require.Equal(t, daily.RunAt(4), arg.NextRunAt)
+	require.Equal(t, ScheduledTransferStatusActive, arg.Status)
+
+	// The schedule completes when the next occurrence is after its end
+	daily.EndAt = sql.NullTime{Time: start.Add(36 * time.Hour), Valid: true}
+	arg = daily.next(start, false)
+	require.Equal(t, ScheduledTransferStatusActive, arg.Status)
+	arg = daily.next(start.Add(24*time.Hour), true)
+	require.Equal(t, ScheduledTransferStatusCompleted, arg.Status)
+
+	once := ScheduledTransfer{Frequency: TransferFrequencyOnce, StartAt: start, NextRunAt: start}
+	require.Equal(t, ScheduledTransferStatusCompleted, once.next(start, true).Status)
+	require.Equal(t, ScheduledTransferStatusFailed, once.next(start, false).Status)
+}
+
+func TestRunScheduledTransferTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1, account2 := createRandomAccount(t), createRandomAccount(t)
+	account1 = fundAccount(t, account1, 10)
+	now := time.Now()
+	// The first occurrence moves the whole balance
+	scheduled := newScheduledTransfer(t, account1, account2, account1.Balance, TransferFrequencyMonthly, now.Add(-time.Minute))
+
+	attempts := runDueScheduledTransfers(t, store, scheduled, now)
+	require.Len(t, attempts, 1)
+	require.Zero(t, attempts[0].Occurrence)
+	require.WithinDuration(t, scheduled.NextRunAt, attempts[0].ScheduledFor, time.Second)
+	require.True(t, attempts[0].TransferID.Valid)
+	require.False(t, attempts[0].Error.Valid)
+
+	transfer, err := store.GetTransfer(context.Background(), attempts[0].TransferID.Int64)
+	require.NoError(t, err)
+	require.Equal(t, scheduled.Amount, transfer.Amount)
+
+	updated, err := store.GetScheduledTransfer(context.Background(), scheduled.ID)
+	require.NoError(t, err)
+	require.Equal(t, ScheduledTransferStatusActive, updated.Status)
+	require.Equal(t, int32(1), updated.Occurrence)
+	require.WithinDuration(t, scheduled.RunAt(1), updated.NextRunAt, time.Second)
+
+	// The next occurrence isn't due yet
+	require.Empty(t, runDueScheduledTransfers(t, store, scheduled, now))
+
+	// The account has no money left for the next occurrence, the attempt is recorded and the schedule carries on
+	attempts = runDueScheduledTransfers(t, store, scheduled, updated.NextRunAt)
+	require.Len(t, attempts, 1)
+	require.Equal(t, int32(1), attempts[0].Occurrence)
+	require.False(t, attempts[0].TransferID.Valid)
+	require.Equal(t, ErrInsufficientFunds.Error(), attempts[0].Error.String)
+
+	updated, err = store.GetScheduledTransfer(context.Background(), scheduled.ID)
+	require.NoError(t, err)
+	require.Equal(t, ScheduledTransferStatusActive, updated.Status)
+	require.Equal(t, int32(2), updated.Occurrence)
+
+	listed, err := store.ListScheduledTransferAttempts(context.Background(), ListScheduledTransferAttemptsParams{
+		ScheduledTransferID: scheduled.ID,
+		PageSize:            10,
+	})
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+}
+
+func TestRunScheduledTransferTxOnce(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1, account2 := createRandomAccount(t), createRandomAccount(t)
+	now := time.Now()
+	scheduled := newScheduledTransfer(t, account1, account2, account1.Balance+1, TransferFrequencyOnce, now)
+
+	attempts := runDueScheduledTransfers(t, store, scheduled, now)
+	require.Len(t, attempts, 1)
+	require.Equal(t, ErrInsufficientFunds.Error(), attempts[0].Error.String)
+
+	updated, err := store.GetScheduledTransfer(context.Background(), scheduled.ID)
+	require.NoError(t, err)
+	require.Equal(t, ScheduledTransferStatusFailed, updated.Status)
+
+	// Failed schedules can't be resumed
+	_, err = store.UpdateScheduledTransfer(context.Background(), UpdateScheduledTransferParams{
+		ID:     scheduled.ID,
+		Status: NullScheduledTransferStatus{ScheduledTransferStatus: ScheduledTransferStatusActive, Valid: true},
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestRunScheduledTransferTxConcurrent(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1, account2 := createRandomAccount(t), createRandomAccount(t)
+	account1 = fundAccount(t, account1, 100)
+	now := time.Now()
+	scheduled := newScheduledTransfer(t, account1, account2, 10, TransferFrequencyWeekly, now.Add(-time.Minute))
+
+	// Every worker skips the schedule locked by another one, so the occurrence is run once
+	n := 5
+	results := make(chan []ScheduledTransferAttempt)
+	for i := 0; i < n; i++ {
+		go func() {
+			results <- runDueScheduledTransfers(t, store, scheduled, now)
+		}()
+	}
+
+	var attempts []ScheduledTransferAttempt
+	for i := 0; i < n; i++ {
+		attempts = append(attempts, <-results...)
+	}
+	require.Len(t, attempts, 1)
+
+	updatedAccount, err := store.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance-scheduled.Amount, updatedAccount.Balance)
+}
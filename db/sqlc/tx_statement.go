@@ -0,0 +1,160 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+type AccountStatementParam struct {
+	AccountID int64
+	// From is inclusive and To is exclusive
+	From time.Time
+	To   time.Time
+}
+
+type StatementEntry struct {
+	Entry
+	RunningBalance int64 `json:"running_balance"`
+}
+
+// StatementLine is an entry of a statement along with the other account of its transfer
+type StatementLine struct {
+	StatementEntry
+	// Counterparty is the other account of the transfer of the entry, zero for deposits and withdrawals
+	Counterparty int64 `json:"counterparty"`
+}
+
+type StreamAccountStatementParam struct {
+	AccountStatementParam
+	// PageSize is the number of entries read from the database at a time
+	PageSize int32
+}
+
+// StatementStream is the statement of a period whose lines are read from the database as they are consumed
+type StatementStream struct {
+	OpeningBalance int64
+	// EachLine calls fn with every line of the statement in order, until fn fails. The lines are read a page at a time,
+	// a page is only read once fn got all the lines of the previous one
+	EachLine func(fn func(StatementLine) error) error
+}
+
+type AccountStatement struct {
+	OpeningBalance int64            `json:"opening_balance"`
+	ClosingBalance int64            `json:"closing_balance"`
+	Entries        []StatementEntry `json:"entries"`
+}
+
+// AccountStatementTx rebuilds the account balance over the period from the ledger,
+// both queries read the same snapshot so the balances always add up with the entries
+func (store *SQLStore) AccountStatementTx(ctx context.Context, arg AccountStatementParam) (AccountStatement, error) {
+	var statement AccountStatement
+
+	opts := &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}
+	err := store.execTxWithOptions(ctx, opts, func(q *Queries) error {
+		var err error
+
+		statement.OpeningBalance, err = q.GetAccountBalanceAt(ctx, GetAccountBalanceAtParams{
+			At:        arg.From,
+			AccountID: arg.AccountID,
+		})
+		if err != nil {
+			return err
+		}
+
+		entries, err := q.ListEntriesBetween(ctx, ListEntriesBetweenParams{
+			AccountID: arg.AccountID,
+			FromTime:  arg.From,
+			ToTime:    arg.To,
+		})
+		if err != nil {
+			return err
+		}
+
+		balance := statement.OpeningBalance
+		statement.Entries = make([]StatementEntry, 0, len(entries))
+		for _, entry := range entries {
+			balance += entry.Amount
+			statement.Entries = append(statement.Entries, StatementEntry{Entry: entry, RunningBalance: balance})
+		}
+		statement.ClosingBalance = balance
+
+		return nil
+	})
+
+	return statement, err
+}
+
+// StreamAccountStatementTx calls consume with the statement of the period, whose lines are read as consume goes
+// through them instead of all at once. Everything is read from the same snapshot, which is held until consume returns
+func (store *SQLStore) StreamAccountStatementTx(ctx context.Context, arg StreamAccountStatementParam, consume func(StatementStream) error) error {
+	if arg.PageSize < 1 {
+		return fmt.Errorf("page size must be positive, provided: %d", arg.PageSize)
+	}
+
+	opts := &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}
+	return store.execTxWithOptions(ctx, opts, func(q *Queries) error {
+		openingBalance, err := q.GetAccountBalanceAt(ctx, GetAccountBalanceAtParams{
+			At:        arg.From,
+			AccountID: arg.AccountID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return consume(StatementStream{
+			OpeningBalance: openingBalance,
+			EachLine: func(fn func(StatementLine) error) error {
+				return eachStatementLine(ctx, q, arg, openingBalance, fn)
+			},
+		})
+	})
+}
+
+// eachStatementLine reads the entries of the statement a page at a time, keyed by the id of the last entry of the
+// previous page, and calls fn with each of them along with the running balance
+func eachStatementLine(ctx context.Context, q *Queries, arg StreamAccountStatementParam, balance int64, fn func(StatementLine) error) error {
+	var afterID int64
+	for {
+		rows, err := q.ListStatementLines(ctx, ListStatementLinesParams{
+			AccountID: arg.AccountID,
+			FromTime:  arg.From,
+			ToTime:    arg.To,
+			AfterID:   afterID,
+			PageSize:  arg.PageSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			balance += row.Amount
+			err = fn(StatementLine{
+				StatementEntry: StatementEntry{
+					Entry: Entry{
+						ID:           row.ID,
+						AccountID:    row.AccountID,
+						Amount:       row.Amount,
+						CreatedAt:    row.CreatedAt,
+						Type:         row.Type,
+						ExternalRef:  row.ExternalRef,
+						TransferID:   row.TransferID,
+						BalanceAfter: row.BalanceAfter,
+						Description:  row.Description,
+					},
+					RunningBalance: balance,
+				},
+				Counterparty: row.Counterparty,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(rows) < int(arg.PageSize) {
+			return nil
+		}
+		afterID = rows[len(rows)-1].ID
+	}
+}
@@ -0,0 +1,116 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountStatementTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := createRandomAccount(t)
+	from := time.Now().Add(-time.Minute)
+
+	_, err := store.DepositTx(context.Background(), DepositTxParam{AccountID: account.ID, Amount: 100})
+	require.NoError(t, err)
+
+	_, err = store.WithdrawTx(context.Background(), WithdrawTxParam{AccountID: account.ID, Amount: 30})
+	require.NoError(t, err)
+
+	statement, err := store.AccountStatementTx(context.Background(), AccountStatementParam{
+		AccountID: account.ID,
+		From:      from,
+		To:        time.Now().Add(time.Minute),
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, account.Balance, statement.OpeningBalance)
+	require.Equal(t, account.Balance+70, statement.ClosingBalance)
+	require.Len(t, statement.Entries, 2)
+	require.Equal(t, EntryTypeDeposit, statement.Entries[0].Type)
+	require.Equal(t, account.Balance+100, statement.Entries[0].RunningBalance)
+	require.Equal(t, EntryTypeWithdrawal, statement.Entries[1].Type)
+	require.Equal(t, account.Balance+70, statement.Entries[1].RunningBalance)
+
+	// The running balance matches the snapshot stored in the ledger
+	for _, entry := range statement.Entries {
+		require.Equal(t, entry.BalanceAfter, entry.RunningBalance)
+	}
+
+	// A period after the last entry has no entries and both balances equal the current one
+	statement, err = store.AccountStatementTx(context.Background(), AccountStatementParam{
+		AccountID: account.ID,
+		From:      time.Now().Add(time.Minute),
+		To:        time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Empty(t, statement.Entries)
+	require.Equal(t, account.Balance+70, statement.OpeningBalance)
+	require.Equal(t, account.Balance+70, statement.ClosingBalance)
+}
+
+func TestStreamAccountStatementTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := fundAccount(t, createRandomAccount(t), 100)
+	other := createAccountWithCurrency(t, account.Currency)
+	from := time.Now().Add(-time.Minute)
+
+	_, err := store.DepositTx(context.Background(), DepositTxParam{AccountID: account.ID, Amount: 20})
+	require.NoError(t, err)
+
+	transfer, err := store.TransferTx(context.Background(), TransferTxParam{FromAccountID: account.ID, ToAccountID: other.ID, Amount: 10})
+	require.NoError(t, err)
+
+	_, err = store.WithdrawTx(context.Background(), WithdrawTxParam{AccountID: account.ID, Amount: 5})
+	require.NoError(t, err)
+
+	arg := StreamAccountStatementParam{
+		AccountStatementParam: AccountStatementParam{AccountID: account.ID, From: from, To: time.Now().Add(time.Minute)},
+		PageSize:              1,
+	}
+
+	// A page of a single entry reads the whole period, in order, with the same balances as the statement
+	statement, err := store.AccountStatementTx(context.Background(), arg.AccountStatementParam)
+	require.NoError(t, err)
+
+	var lines []StatementLine
+	err = store.StreamAccountStatementTx(context.Background(), arg, func(stream StatementStream) error {
+		require.Equal(t, statement.OpeningBalance, stream.OpeningBalance)
+		return stream.EachLine(func(line StatementLine) error {
+			lines = append(lines, line)
+			return nil
+		})
+	})
+	require.NoError(t, err)
+	require.Len(t, lines, len(statement.Entries))
+
+	for i, line := range lines {
+		require.Equal(t, statement.Entries[i], line.StatementEntry)
+		if line.TransferID.Valid {
+			require.Equal(t, transfer.Transfer.ID, line.TransferID.Int64)
+			require.Equal(t, other.ID, line.Counterparty)
+		} else {
+			require.Zero(t, line.Counterparty)
+		}
+	}
+
+	// An error of the consumer stops the lines and is returned as is
+	calls := 0
+	err = store.StreamAccountStatementTx(context.Background(), arg, func(stream StatementStream) error {
+		return stream.EachLine(func(line StatementLine) error {
+			calls++
+			return sql.ErrConnDone
+		})
+	})
+	require.ErrorIs(t, err, sql.ErrConnDone)
+	require.Equal(t, 1, calls)
+
+	arg.PageSize = 0
+	err = store.StreamAccountStatementTx(context.Background(), arg, func(stream StatementStream) error { return nil })
+	require.Error(t, err)
+}
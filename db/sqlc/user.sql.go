@@ -13,7 +13,7 @@ import (
 const createUser = `-- name: CreateUser :one
 INSERT INTO "users" (username, hashed_password, full_name, email)
 VALUES ($1, $2, $3, $4)
-RETURNING username, hashed_password, full_name, email, password_changed_at, created_at
+RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, is_deleted, role, tier
 `
 
 type CreateUserParams struct {
@@ -38,12 +38,26 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.Email,
 		&i.PasswordChangedAt,
 		&i.CreatedAt,
+		&i.IsDeleted,
+		&i.Role,
+		&i.Tier,
 	)
 	return i, err
 }
 
+const deleteUser = `-- name: DeleteUser :exec
+UPDATE "users"
+SET is_deleted = true
+WHERE username = $1
+`
+
+func (q *Queries) DeleteUser(ctx context.Context, username string) error {
+	_, err := q.db.ExecContext(ctx, deleteUser, username)
+	return err
+}
+
 const getUser = `-- name: GetUser :one
-SELECT username, hashed_password, full_name, email, password_changed_at, created_at
+SELECT username, hashed_password, full_name, email, password_changed_at, created_at, is_deleted, role, tier
 FROM "users"
 WHERE username = $1
 LIMIT 1
@@ -59,10 +73,83 @@ func (q *Queries) GetUser(ctx context.Context, username string) (User, error) {
 		&i.Email,
 		&i.PasswordChangedAt,
 		&i.CreatedAt,
+		&i.IsDeleted,
+		&i.Role,
+		&i.Tier,
 	)
 	return i, err
 }
 
+const getUserForUpdate = `-- name: GetUserForUpdate :one
+SELECT username, hashed_password, full_name, email, password_changed_at, created_at, is_deleted, role, tier
+FROM "users"
+WHERE username = $1
+LIMIT 1 FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetUserForUpdate(ctx context.Context, username string) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserForUpdate, username)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.IsDeleted,
+		&i.Role,
+		&i.Tier,
+	)
+	return i, err
+}
+
+const listUsers = `-- name: ListUsers :many
+SELECT username, hashed_password, full_name, email, password_changed_at, created_at, is_deleted, role, tier
+FROM "users"
+WHERE username > $1
+ORDER BY username
+LIMIT $2
+`
+
+type ListUsersParams struct {
+	AfterUsername string `json:"after_username"`
+	PageSize      int32  `json:"page_size"`
+}
+
+func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error) {
+	rows, err := q.db.QueryContext(ctx, listUsers, arg.AfterUsername, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.Username,
+			&i.HashedPassword,
+			&i.FullName,
+			&i.Email,
+			&i.PasswordChangedAt,
+			&i.CreatedAt,
+			&i.IsDeleted,
+			&i.Role,
+			&i.Tier,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateUser = `-- name: UpdateUser :one
 UPDATE "users"
 SET hashed_password = coalesce($1, hashed_password),
@@ -70,7 +157,7 @@ SET hashed_password = coalesce($1, hashed_password),
   email = coalesce($3, email)
 WHERE username = $4
   AND coalesce($1, $2, $3) IS NOT NULL
-RETURNING username, hashed_password, full_name, email, password_changed_at, created_at
+RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, is_deleted, role, tier
 `
 
 type UpdateUserParams struct {
@@ -95,6 +182,9 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.Email,
 		&i.PasswordChangedAt,
 		&i.CreatedAt,
+		&i.IsDeleted,
+		&i.Role,
+		&i.Tier,
 	)
 	return i, err
 }
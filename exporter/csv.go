@@ -0,0 +1,54 @@
+package exporter
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+)
+
+var csvHeader = []string{"id", "date", "type", "description", "reference", "transfer_id", "counterparty_account_id", "amount", "balance", "currency"}
+
+type csvExporter struct{}
+
+func (csvExporter) ContentType() string { return "text/csv" }
+
+func (csvExporter) Extension() string { return "csv" }
+
+func (csvExporter) Export(w io.Writer, statement Statement) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+
+	_, err := statement.Lines(func(line Line) error {
+		record := []string{
+			strconv.FormatInt(line.ID, 10),
+			line.CreatedAt.UTC().Format(time.RFC3339),
+			string(line.Type),
+			line.Description,
+			line.ExternalRef.String,
+			optionalID(line.TransferID.Int64),
+			optionalID(line.Counterparty),
+			strconv.FormatInt(line.Amount, 10),
+			strconv.FormatInt(line.RunningBalance, 10),
+			statement.Account.Currency,
+		}
+		return cw.Write(record)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// optionalID leaves the column empty for ids that aren't set
+func optionalID(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.FormatInt(id, 10)
+}
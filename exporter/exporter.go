@@ -0,0 +1,64 @@
+package exporter
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatOFX  = "ofx"
+	FormatQFX  = "qfx"
+	FormatText = "text"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported export format")
+
+type Exporter interface {
+	// ContentType is the media type of the rendered statement
+	ContentType() string
+	// Extension is the file extension used when the statement is downloaded
+	Extension() string
+	// Export writes the statement into w a line at a time, as the lines are read from the database
+	Export(w io.Writer, statement Statement) error
+}
+
+// Statement is what gets exported, the account statement of a period whose lines are read as they are exported
+type Statement struct {
+	Account db.Account
+	From    time.Time
+	To      time.Time
+	db.StatementStream
+}
+
+// Line is a statement entry along with the other account of its transfer, if any
+type Line = db.StatementLine
+
+// Lines calls fn with every line of the statement in order until fn fails, and returns the closing balance
+func (s Statement) Lines(fn func(Line) error) (closingBalance int64, err error) {
+	closingBalance = s.OpeningBalance
+	err = s.EachLine(func(line Line) error {
+		closingBalance = line.RunningBalance
+		return fn(line)
+	})
+	return
+}
+
+// New returns the exporter of the format, one of csv, ofx, qfx or text
+func New(format string) (Exporter, error) {
+	switch format {
+	case FormatCSV:
+		return csvExporter{}, nil
+	case FormatOFX:
+		return ofxExporter{contentType: "application/x-ofx", extension: "ofx"}, nil
+	case FormatQFX:
+		return ofxExporter{contentType: "application/vnd.intu.qfx", extension: "qfx"}, nil
+	case FormatText:
+		return textExporter{}, nil
+	}
+	return nil, fmt.Errorf("%w %q, expected one of %s, %s, %s, %s", ErrUnsupportedFormat, format, FormatCSV, FormatOFX, FormatQFX, FormatText)
+}
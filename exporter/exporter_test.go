@@ -0,0 +1,244 @@
+package exporter
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/csv"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/util"
+	"github.com/stretchr/testify/require"
+)
+
+var testAccount = db.Account{ID: 7, Owner: "alice", Currency: util.USD}
+
+var testFrom = time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC)
+
+func testLines() []Line {
+	return []Line{
+		{
+			StatementEntry: db.StatementEntry{
+				Entry: db.Entry{
+					ID: 1, AccountID: testAccount.ID, Amount: 50, Type: db.EntryTypeDeposit,
+					ExternalRef: sql.NullString{String: "receipt-1", Valid: true}, Description: "deposit",
+					CreatedAt: testFrom.Add(time.Hour),
+				},
+				RunningBalance: 150,
+			},
+		},
+		{
+			StatementEntry: db.StatementEntry{
+				Entry: db.Entry{
+					ID: 2, AccountID: testAccount.ID, Amount: -70, Type: db.EntryTypeTransfer,
+					TransferID: sql.NullInt64{Int64: 3, Valid: true}, Description: "transfer to account 9 & co",
+					CreatedAt: testFrom.Add(2 * time.Hour),
+				},
+				RunningBalance: 80,
+			},
+			Counterparty: 9,
+		},
+	}
+}
+
+// newTestStatement returns a statement opening at 100 whose lines are streamed from lines
+func newTestStatement(lines []Line) Statement {
+	return Statement{
+		Account: testAccount,
+		From:    testFrom,
+		To:      testFrom.AddDate(0, 1, 0),
+		StatementStream: db.StatementStream{
+			OpeningBalance: 100,
+			EachLine: func(fn func(Line) error) error {
+				for _, line := range lines {
+					if err := fn(line); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		},
+	}
+}
+
+func TestNew(t *testing.T) {
+	for _, format := range []string{FormatCSV, FormatOFX, FormatQFX, FormatText} {
+		exp, err := New(format)
+		require.NoError(t, err)
+		require.NotEmpty(t, exp.ContentType())
+		require.NotEmpty(t, exp.Extension())
+	}
+
+	_, err := New("pdf")
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestLines(t *testing.T) {
+	var lines []Line
+	closingBalance, err := newTestStatement(testLines()).Lines(func(line Line) error {
+		lines = append(lines, line)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, testLines(), lines)
+	require.Equal(t, int64(80), closingBalance)
+
+	// An empty statement closes at its opening balance
+	closingBalance, err = newTestStatement(nil).Lines(func(line Line) error { return nil })
+	require.NoError(t, err)
+	require.Equal(t, int64(100), closingBalance)
+
+	// The lines stop at the first error
+	calls := 0
+	_, err = newTestStatement(testLines()).Lines(func(line Line) error {
+		calls++
+		return io.ErrShortWrite
+	})
+	require.ErrorIs(t, err, io.ErrShortWrite)
+	require.Equal(t, 1, calls)
+}
+
+func TestCSVExporter(t *testing.T) {
+	exp, err := New(FormatCSV)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, exp.Export(&buf, newTestStatement(testLines())))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	require.Equal(t, csvHeader, records[0])
+	require.Equal(t, []string{"1", "2022-11-01T01:00:00Z", "deposit", "deposit", "receipt-1", "", "", "50", "150", util.USD}, records[1])
+	require.Equal(t, []string{"2", "2022-11-01T02:00:00Z", "transfer", "transfer to account 9 & co", "", "3", "9", "-70", "80", util.USD}, records[2])
+}
+
+// ofxDocument reads back the document the OFX exporter writes
+type ofxDocument struct {
+	XMLName   xml.Name  `xml:"OFX"`
+	SignOn    ofxSignOn `xml:"SIGNONMSGSRSV1"`
+	Statement struct {
+		TrnUID  string    `xml:"TRNUID"`
+		Status  ofxStatus `xml:"STATUS"`
+		Content struct {
+			Currency     string     `xml:"CURDEF"`
+			Account      ofxAccount `xml:"BANKACCTFROM"`
+			Transactions struct {
+				Start string           `xml:"DTSTART"`
+				End   string           `xml:"DTEND"`
+				List  []ofxTransaction `xml:"STMTTRN"`
+			} `xml:"BANKTRANLIST"`
+			LedgerBalance ofxBalance `xml:"LEDGERBAL"`
+		} `xml:"STMTRS"`
+	} `xml:"BANKMSGSRSV1>STMTTRNRS"`
+}
+
+func TestOFXExporter(t *testing.T) {
+	exp, err := New(FormatOFX)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, exp.Export(&buf, newTestStatement(testLines())))
+	require.True(t, strings.HasPrefix(buf.String(), "<?xml"))
+
+	var doc ofxDocument
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &doc))
+
+	content := doc.Statement.Content
+	require.Equal(t, util.USD, content.Currency)
+	require.Equal(t, "7", content.Account.ID)
+	require.Equal(t, "20221101000000[0:GMT]", content.Transactions.Start)
+	require.Equal(t, "80", content.LedgerBalance.Amount)
+	require.Len(t, content.Transactions.List, 2)
+	require.Equal(t, "DEP", content.Transactions.List[0].Type)
+	require.Equal(t, "receipt-1", content.Transactions.List[0].RefNum)
+	require.Equal(t, "XFER", content.Transactions.List[1].Type)
+	require.Equal(t, "-70", content.Transactions.List[1].Amount)
+	require.Equal(t, "account 9", content.Transactions.List[1].Name)
+	require.Equal(t, "transfer to account 9 & co", content.Transactions.List[1].Memo)
+}
+
+func TestOFXTransactionTypeFee(t *testing.T) {
+	fee := Line{StatementEntry: db.StatementEntry{Entry: db.Entry{Amount: -5, Type: db.EntryTypeFee}}}
+	require.Equal(t, "FEE", ofxTransactionType(fee))
+
+	// The house account is credited with the fee
+	fee.Amount = 5
+	require.Equal(t, "CREDIT", ofxTransactionType(fee))
+}
+
+func TestTextExporter(t *testing.T) {
+	exp, err := New(FormatText)
+	require.NoError(t, err)
+
+	lines := testLines()
+	lines[1].Description = strings.Repeat("x", 40)
+	statement := newTestStatement(lines)
+
+	var buf bytes.Buffer
+	require.NoError(t, exp.Export(&buf, statement))
+
+	rendered := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Equal(t, "GOBANK ACCOUNT STATEMENT", rendered[0])
+	require.Contains(t, buf.String(), "Period:   2022-11-01 - 2022-12-01")
+
+	// Every row of the table has the same width, long descriptions are cut
+	rows := rendered[7:11]
+	for _, row := range append(rows, rendered[5]) {
+		require.Len(t, row, textRuleLength)
+	}
+	require.Contains(t, rows[0], "Opening balance")
+	require.Contains(t, rows[2], strings.Repeat("x", textDescription)+"  ")
+	require.Contains(t, rows[3], "Closing balance")
+}
+
+// writeRecorder keeps the size of the largest write it gets
+type writeRecorder struct {
+	total   int
+	largest int
+}
+
+func (w *writeRecorder) Write(p []byte) (int, error) {
+	w.total += len(p)
+	if len(p) > w.largest {
+		w.largest = len(p)
+	}
+	return len(p), nil
+}
+
+func TestExportWritesRows(t *testing.T) {
+	for _, format := range []string{FormatCSV, FormatOFX, FormatText} {
+		t.Run(format, func(t *testing.T) {
+			exp, err := New(format)
+			require.NoError(t, err)
+
+			var w writeRecorder
+			var writtenBeforeLast int
+
+			// The lines are produced one at a time, like they are read from the database
+			statement := newTestStatement(nil)
+			statement.EachLine = func(fn func(Line) error) error {
+				line := testLines()[0]
+				for i := 0; i < 1000; i++ {
+					if i == 999 {
+						writtenBeforeLast = w.total
+					}
+					line.ID = int64(i + 1)
+					if err := fn(line); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+
+			// The rows reach the writer while the lines are read, not as a single document at the end
+			require.NoError(t, exp.Export(&w, statement))
+			require.Positive(t, writtenBeforeLast)
+			require.Less(t, w.largest, w.total/10)
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package exporter
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+)
+
+// ofxBankID identifies the bank in BANKACCTFROM, personal-finance tools only use it to group accounts
+const ofxBankID = "GOBANK"
+
+const ofxHeader = `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<?OFX OFXHEADER="200" VERSION="211" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+`
+
+// ofxExporter renders an OFX 2.1.1 bank statement, QFX is the same document served under another media type
+type ofxExporter struct {
+	contentType string
+	extension   string
+}
+
+func (e ofxExporter) ContentType() string { return e.contentType }
+
+func (e ofxExporter) Extension() string { return e.extension }
+
+type ofxStatus struct {
+	Code     int    `xml:"CODE"`
+	Severity string `xml:"SEVERITY"`
+}
+
+type ofxTransaction struct {
+	Type   string `xml:"TRNTYPE"`
+	Posted string `xml:"DTPOSTED"`
+	Amount string `xml:"TRNAMT"`
+	FitID  string `xml:"FITID"`
+	RefNum string `xml:"REFNUM,omitempty"`
+	Name   string `xml:"NAME"`
+	Memo   string `xml:"MEMO,omitempty"`
+}
+
+type ofxSignOn struct {
+	Status   ofxStatus `xml:"SONRS>STATUS"`
+	Server   string    `xml:"SONRS>DTSERVER"`
+	Language string    `xml:"SONRS>LANGUAGE"`
+}
+
+type ofxAccount struct {
+	BankID string `xml:"BANKID"`
+	ID     string `xml:"ACCTID"`
+	Type   string `xml:"ACCTTYPE"`
+}
+
+type ofxBalance struct {
+	Amount string `xml:"BALAMT"`
+	AsOf   string `xml:"DTASOF"`
+}
+
+// ofxEncoder writes the document an element at a time, it stops at the first error and keeps it in err
+type ofxEncoder struct {
+	enc *xml.Encoder
+	err error
+}
+
+func (e *ofxEncoder) start(name string) {
+	if e.err == nil {
+		e.err = e.enc.EncodeToken(xml.StartElement{Name: xml.Name{Local: name}})
+	}
+}
+
+func (e *ofxEncoder) end(name string) {
+	if e.err == nil {
+		e.err = e.enc.EncodeToken(xml.EndElement{Name: xml.Name{Local: name}})
+	}
+}
+
+// element encodes v as a whole element, which flushes the document written so far to the output
+func (e *ofxEncoder) element(name string, v interface{}) {
+	if e.err == nil {
+		e.err = e.enc.EncodeElement(v, xml.StartElement{Name: xml.Name{Local: name}})
+	}
+}
+
+// Export writes the transactions out one STMTTRN at a time, the elements around them are opened and closed by hand.
+// LEDGERBAL comes after them, so the closing balance is known by then
+func (e ofxExporter) Export(w io.Writer, statement Statement) error {
+	if _, err := io.WriteString(w, ofxHeader); err != nil {
+		return err
+	}
+
+	doc := &ofxEncoder{enc: xml.NewEncoder(w)}
+	doc.enc.Indent("", "  ")
+
+	doc.start("OFX")
+	doc.element("SIGNONMSGSRSV1", ofxSignOn{
+		Status:   ofxStatus{Code: 0, Severity: "INFO"},
+		Server:   ofxTime(time.Now()),
+		Language: "ENG",
+	})
+
+	doc.start("BANKMSGSRSV1")
+	doc.start("STMTTRNRS")
+	doc.element("TRNUID", "0")
+	doc.element("STATUS", ofxStatus{Code: 0, Severity: "INFO"})
+
+	doc.start("STMTRS")
+	doc.element("CURDEF", statement.Account.Currency)
+	doc.element("BANKACCTFROM", ofxAccount{
+		BankID: ofxBankID,
+		ID:     strconv.FormatInt(statement.Account.ID, 10),
+		Type:   "CHECKING",
+	})
+
+	doc.start("BANKTRANLIST")
+	doc.element("DTSTART", ofxTime(statement.From))
+	doc.element("DTEND", ofxTime(statement.To))
+
+	closingBalance, err := statement.Lines(func(line Line) error {
+		doc.element("STMTTRN", ofxTransaction{
+			Type:   ofxTransactionType(line),
+			Posted: ofxTime(line.CreatedAt),
+			Amount: strconv.FormatInt(line.Amount, 10),
+			FitID:  strconv.FormatInt(line.ID, 10),
+			RefNum: line.ExternalRef.String,
+			Name:   ofxName(line),
+			Memo:   line.Description,
+		})
+		return doc.err
+	})
+
+	if err != nil {
+		return err
+	}
+
+	doc.end("BANKTRANLIST")
+	doc.element("LEDGERBAL", ofxBalance{
+		Amount: strconv.FormatInt(closingBalance, 10),
+		AsOf:   ofxTime(statement.To),
+	})
+	doc.end("STMTRS")
+	doc.end("STMTTRNRS")
+	doc.end("BANKMSGSRSV1")
+	doc.end("OFX")
+
+	if doc.err != nil {
+		return doc.err
+	}
+	return doc.enc.Flush()
+}
+
+func ofxTransactionType(line Line) string {
+	switch line.Type {
+	case db.EntryTypeDeposit:
+		return "DEP"
+	case db.EntryTypeTransfer:
+		return "XFER"
+	case db.EntryTypeFee:
+		// The house account credited with a fee gets a plain CREDIT
+		if line.Amount < 0 {
+			return "FEE"
+		}
+	}
+	if line.Amount < 0 {
+		return "DEBIT"
+	}
+	return "CREDIT"
+}
+
+// ofxName is the payee shown by personal-finance tools, NAME is limited to 32 characters
+func ofxName(line Line) string {
+	name := string(line.Type)
+	if line.Counterparty != 0 {
+		name = fmt.Sprintf("account %d", line.Counterparty)
+	}
+	return truncate(name, 32)
+}
+
+// ofxTime formats t as an OFX datetime in UTC
+func ofxTime(t time.Time) string {
+	return t.UTC().Format("20060102150405") + "[0:GMT]"
+}
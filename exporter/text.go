@@ -0,0 +1,68 @@
+package exporter
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	textDateLayout  = "2006-01-02"
+	textLineFormat  = "%-10s  %-10s  %-32s  %-16s  %14s  %14s\n"
+	textRuleLength  = 10 + 2 + 10 + 2 + 32 + 2 + 16 + 2 + 14 + 2 + 14
+	textDescription = 32
+	textReference   = 16
+)
+
+// textExporter renders a fixed-width statement, long descriptions and references are cut to fit their column
+type textExporter struct{}
+
+func (textExporter) ContentType() string { return "text/plain; charset=utf-8" }
+
+func (textExporter) Extension() string { return "txt" }
+
+func (textExporter) Export(w io.Writer, statement Statement) error {
+	bw := bufio.NewWriter(w)
+	rule := strings.Repeat("-", textRuleLength)
+
+	fmt.Fprintln(bw, "GOBANK ACCOUNT STATEMENT")
+	fmt.Fprintf(bw, "Account:  %d (%s)\n", statement.Account.ID, statement.Account.Currency)
+	fmt.Fprintf(bw, "Owner:    %s\n", statement.Account.Owner)
+	fmt.Fprintf(bw, "Period:   %s - %s\n", statement.From.UTC().Format(textDateLayout), statement.To.UTC().Format(textDateLayout))
+	fmt.Fprintln(bw, rule)
+	fmt.Fprintf(bw, textLineFormat, "DATE", "TYPE", "DESCRIPTION", "REFERENCE", "AMOUNT", "BALANCE")
+	fmt.Fprintln(bw, rule)
+	fmt.Fprintf(bw, textLineFormat, statement.From.UTC().Format(textDateLayout), "", "Opening balance", "", "", fmt.Sprint(statement.OpeningBalance))
+
+	// bw writes through to w every time its buffer fills up, so the rows don't pile up in memory
+	closingBalance, err := statement.Lines(func(line Line) error {
+		_, err := fmt.Fprintf(bw, textLineFormat,
+			line.CreatedAt.UTC().Format(textDateLayout),
+			line.Type,
+			truncate(line.Description, textDescription),
+			truncate(line.ExternalRef.String, textReference),
+			fmt.Sprint(line.Amount),
+			fmt.Sprint(line.RunningBalance),
+		)
+		return err
+	})
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(bw, textLineFormat, statement.To.UTC().Format(textDateLayout), "", "Closing balance", "", "", fmt.Sprint(closingBalance))
+	fmt.Fprintln(bw, rule)
+
+	return bw.Flush()
+}
+
+// truncate cuts s to n runes at most
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n])
+}
@@ -0,0 +1,115 @@
+package fx
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// FileProvider serves the rates of a json file, the file is checked for changes
+// at most once per interval and reloaded when it has been modified
+//
+//	{"updated_at": "2023-01-01T00:00:00Z", "rates": {"USD/EGP": 24.7, "USD/RUB": 70.3}}
+type FileProvider struct {
+	path     string
+	interval time.Duration
+
+	mu        sync.RWMutex
+	rates     map[Pair]int64
+	updatedAt time.Time
+	modTime   time.Time
+	checkedAt time.Time
+}
+
+type ratesFile struct {
+	UpdatedAt time.Time            `json:"updated_at"`
+	Rates     map[Pair]json.Number `json:"rates"`
+}
+
+// scaledRates parses the rates of the file exactly, without reading them into floats
+func (file ratesFile) scaledRates() (map[Pair]int64, error) {
+	rates := make(map[Pair]int64, len(file.Rates))
+	for pair, number := range file.Rates {
+		value, err := ParseRate(number.String())
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse rate of %s, %w", pair, err)
+		}
+		rates[pair] = value
+	}
+	return rates, nil
+}
+
+func NewFileProvider(path string, interval time.Duration) (RateProvider, error) {
+	provider := &FileProvider{path: path, interval: interval}
+	if err := provider.load(); err != nil {
+		return nil, err
+	}
+	return provider, nil
+}
+
+func (provider *FileProvider) Rate(from, to string) (Rate, error) {
+	provider.reloadIfModified()
+
+	provider.mu.RLock()
+	defer provider.mu.RUnlock()
+	return lookup(provider.rates, from, to, provider.updatedAt)
+}
+
+// reloadIfModified keeps serving the last loaded rates when the file can't be read or is invalid
+func (provider *FileProvider) reloadIfModified() {
+	provider.mu.RLock()
+	due := time.Since(provider.checkedAt) >= provider.interval
+	provider.mu.RUnlock()
+
+	if !due {
+		return
+	}
+
+	_ = provider.load()
+}
+
+func (provider *FileProvider) load() error {
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	provider.checkedAt = time.Now()
+
+	info, err := os.Stat(provider.path)
+	if err != nil {
+		return fmt.Errorf("cannot stat rates file, %w", err)
+	}
+
+	if provider.rates != nil && info.ModTime().Equal(provider.modTime) {
+		return nil
+	}
+
+	b, err := os.ReadFile(provider.path)
+	if err != nil {
+		return fmt.Errorf("cannot read rates file, %w", err)
+	}
+
+	var file ratesFile
+	if err = json.Unmarshal(b, &file); err != nil {
+		return fmt.Errorf("cannot parse rates file %s, %w", provider.path, err)
+	}
+
+	rates, err := file.scaledRates()
+	if err != nil {
+		return err
+	}
+
+	if err = validateRates(rates); err != nil {
+		return err
+	}
+
+	if file.UpdatedAt.IsZero() {
+		file.UpdatedAt = info.ModTime()
+	}
+
+	provider.rates = rates
+	provider.updatedAt = file.UpdatedAt
+	provider.modTime = info.ModTime()
+	return nil
+}
@@ -0,0 +1,142 @@
+package fx
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/escalopa/gobank/util"
+)
+
+const DefaultReloadInterval = time.Minute
+
+// RateScale is the fixed-point scale of rates, a rate is stored as an integer number of 1e-8 units so that
+// converting money never goes through a float
+const RateScale = 100_000_000
+
+type RateProvider interface {
+	// Rate returns how many units of `to` one unit of `from` buys
+	Rate(from, to string) (Rate, error)
+}
+
+type Rate struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	// Value is the rate scaled by RateScale, e.g. 29_000_000 for 0.29
+	Value     int64     `json:"value"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Convert converts amount of `from` currency into `to` currency,
+// the result is rounded down so a transfer never credits more than it was debited
+func (r Rate) Convert(amount int64) int64 {
+	converted := new(big.Int).Mul(big.NewInt(amount), big.NewInt(r.Value))
+	return converted.Div(converted, big.NewInt(RateScale)).Int64()
+}
+
+// Float64 returns the rate as a float, for display only
+func (r Rate) Float64() float64 {
+	return ScaledToFloat(r.Value)
+}
+
+// ScaledToFloat returns a rate scaled by RateScale as a float, for display only
+func ScaledToFloat(value int64) float64 {
+	return float64(value) / RateScale
+}
+
+// InverseRate returns the scaled rate of the opposite pair, rounded to the nearest unit
+func InverseRate(value int64) int64 {
+	if value <= 0 {
+		return 0
+	}
+	return roundScaled(big.NewRat(RateScale, value))
+}
+
+// ParseRate parses a decimal rate such as "24.7" exactly, then scales it by RateScale rounded to the nearest unit
+func ParseRate(s string) (int64, error) {
+	rate, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return 0, fmt.Errorf("invalid rate %q", s)
+	}
+
+	if rate.Cmp(big.NewRat(math.MaxInt64/RateScale, 1)) > 0 {
+		return 0, fmt.Errorf("rate %q is too large", s)
+	}
+	return roundScaled(rate), nil
+}
+
+// roundScaled returns r scaled by RateScale, halves are rounded away from zero
+func roundScaled(r *big.Rat) int64 {
+	scaled := new(big.Rat).Mul(r, big.NewRat(RateScale, 1))
+
+	quo, rem := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+	if rem.Lsh(rem.Abs(rem), 1).Cmp(scaled.Denom()) >= 0 {
+		quo.Add(quo, big.NewInt(int64(scaled.Sign())))
+	}
+	return quo.Int64()
+}
+
+var ErrRateNotFound = errors.New("exchange rate not found")
+
+// Pair is the key of a rate in the rates table, written as "FROM/TO" e.g. "USD/EGP"
+type Pair string
+
+func NewPair(from, to string) Pair {
+	return Pair(from + "/" + to)
+}
+
+func (p Pair) split() (from, to string, err error) {
+	parts := strings.Split(string(p), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid currency pair %q, expected FROM/TO", p)
+	}
+	return parts[0], parts[1], nil
+}
+
+// lookup finds the rate of the pair in the table, falling back to the inverse of the opposite pair
+func lookup(rates map[Pair]int64, from, to string, updatedAt time.Time) (Rate, error) {
+	if from == to {
+		return Rate{From: from, To: to, Value: RateScale, UpdatedAt: updatedAt}, nil
+	}
+
+	if value, ok := rates[NewPair(from, to)]; ok {
+		return Rate{From: from, To: to, Value: value, UpdatedAt: updatedAt}, nil
+	}
+
+	if value, ok := rates[NewPair(to, from)]; ok && InverseRate(value) > 0 {
+		return Rate{From: from, To: to, Value: InverseRate(value), UpdatedAt: updatedAt}, nil
+	}
+
+	return Rate{}, fmt.Errorf("%w from %s to %s", ErrRateNotFound, from, to)
+}
+
+func validateRates(rates map[Pair]int64) error {
+	for pair, value := range rates {
+		if _, _, err := pair.split(); err != nil {
+			return err
+		}
+		if value <= 0 {
+			return fmt.Errorf("rate of %s must be positive, provided: %v", pair, value)
+		}
+	}
+	return nil
+}
+
+// NewProvider creates the rate provider configured by FX_RATES_FILE,
+// when no file is set only same currency transfers are allowed
+func NewProvider(config *util.Config) (RateProvider, error) {
+	file := config.Get("FX_RATES_FILE")
+	if file == "" {
+		return NewStaticProvider(nil)
+	}
+
+	interval, err := config.GetDuration("FX_RATES_RELOAD_INTERVAL", DefaultReloadInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFileProvider(file, interval)
+}
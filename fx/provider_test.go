@@ -0,0 +1,127 @@
+package fx
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/escalopa/gobank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaticProvider(t *testing.T) {
+	provider, err := NewStaticProvider(map[Pair]int64{NewPair(util.USD, util.EGP): 25 * RateScale})
+	require.NoError(t, err)
+
+	rate, err := provider.Rate(util.USD, util.EGP)
+	require.NoError(t, err)
+	require.Equal(t, int64(25*RateScale), rate.Value)
+	require.Equal(t, int64(2500), rate.Convert(100))
+
+	// Inverse of the provided pair
+	rate, err = provider.Rate(util.EGP, util.USD)
+	require.NoError(t, err)
+	require.Equal(t, int64(RateScale/25), rate.Value)
+	require.Equal(t, int64(4), rate.Convert(100))
+
+	rate, err = provider.Rate(util.RUB, util.RUB)
+	require.NoError(t, err)
+	require.Equal(t, int64(RateScale), rate.Value)
+
+	_, err = provider.Rate(util.USD, util.RUB)
+	require.ErrorIs(t, err, ErrRateNotFound)
+
+	_, err = NewStaticProvider(map[Pair]int64{"USD": RateScale})
+	require.Error(t, err)
+
+	_, err = NewStaticProvider(map[Pair]int64{NewPair(util.USD, util.EGP): -RateScale})
+	require.Error(t, err)
+}
+
+func TestFileProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.json")
+	err := os.WriteFile(path, []byte(`{"updated_at": "2023-01-01T00:00:00Z", "rates": {"USD/EGP": 25}}`), 0o600)
+	require.NoError(t, err)
+
+	provider, err := NewFileProvider(path, 0)
+	require.NoError(t, err)
+
+	rate, err := provider.Rate(util.USD, util.EGP)
+	require.NoError(t, err)
+	require.Equal(t, int64(25*RateScale), rate.Value)
+	require.Equal(t, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), rate.UpdatedAt.UTC())
+
+	// Rates are reloaded once the file changes
+	err = os.WriteFile(path, []byte(`{"rates": {"USD/EGP": 30.29}}`), 0o600)
+	require.NoError(t, err)
+	modTime := time.Now().Add(time.Second)
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+
+	rate, err = provider.Rate(util.USD, util.EGP)
+	require.NoError(t, err)
+	require.Equal(t, int64(3_029_000_000), rate.Value)
+	require.WithinDuration(t, modTime, rate.UpdatedAt, time.Second)
+
+	// An invalid file keeps the last loaded rates
+	err = os.WriteFile(path, []byte(`invalid`), 0o600)
+	require.NoError(t, err)
+	modTime = modTime.Add(time.Second)
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+
+	rate, err = provider.Rate(util.USD, util.EGP)
+	require.NoError(t, err)
+	require.Equal(t, int64(3_029_000_000), rate.Value)
+
+	_, err = NewFileProvider(filepath.Join(t.TempDir(), "missing.json"), 0)
+	require.Error(t, err)
+}
+
+func TestRateConvert(t *testing.T) {
+	testCases := []struct {
+		name      string
+		rate      string
+		amount    int64
+		converted int64
+	}{
+		{name: "Exact", rate: "0.29", amount: 100, converted: 29},
+		{name: "RoundedDown", rate: "0.29", amount: 7, converted: 2},
+		{name: "ManyDecimals", rate: "1.23456789", amount: 100_000_000, converted: 123_456_789},
+		{name: "LargeAmount", rate: "24.7", amount: 1 << 50, converted: (1 << 50) * 247 / 10},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			value, err := ParseRate(tc.rate)
+			require.NoError(t, err)
+			require.Equal(t, tc.converted, Rate{Value: value}.Convert(tc.amount))
+		})
+	}
+}
+
+func TestParseRate(t *testing.T) {
+	value, err := ParseRate("0.29")
+	require.NoError(t, err)
+	require.Equal(t, int64(29_000_000), value)
+
+	// Rates are rounded to the nearest unit of RateScale
+	value, err = ParseRate("0.123456785")
+	require.NoError(t, err)
+	require.Equal(t, int64(12_345_679), value)
+
+	value, err = ParseRate("2.5e1")
+	require.NoError(t, err)
+	require.Equal(t, int64(25*RateScale), value)
+
+	_, err = ParseRate("rate")
+	require.Error(t, err)
+
+	_, err = ParseRate("1e20")
+	require.Error(t, err)
+
+	// The inverse of a rate is rounded to the nearest unit as well
+	require.Equal(t, int64(333_333_333), InverseRate(30_000_000))
+	require.Equal(t, int64(RateScale/4), InverseRate(4*RateScale))
+}
@@ -0,0 +1,21 @@
+package fx
+
+import "time"
+
+// StaticProvider serves a fixed table of rates scaled by RateScale
+type StaticProvider struct {
+	rates     map[Pair]int64
+	updatedAt time.Time
+}
+
+func NewStaticProvider(rates map[Pair]int64) (RateProvider, error) {
+	if err := validateRates(rates); err != nil {
+		return nil, err
+	}
+
+	return &StaticProvider{rates: rates, updatedAt: time.Now()}, nil
+}
+
+func (provider *StaticProvider) Rate(from, to string) (Rate, error) {
+	return lookup(provider.rates, from, to, provider.updatedAt)
+}
@@ -5,7 +5,11 @@ import (
 	"fmt"
 	"strings"
 
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/grpc/pb"
+	"github.com/escalopa/gobank/session"
 	"github.com/escalopa/gobank/token"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -19,13 +23,18 @@ const (
 func (server *GRPCServer) authenticateUser(ctx context.Context) (payload *token.Payload, err error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return nil, err
+		return nil, fmt.Errorf("missing metadata")
 	}
 
 	// check authorization header
-	authorizationHeader := md.Get(authorizationHeaderKey)
+	values := md.Get(authorizationHeaderKey)
+	if len(values) == 0 {
+		return nil, fmt.Errorf("missing authorization header")
+	}
+
+	authorizationHeader := strings.Fields(values[0])
 	if len(authorizationHeader) != 2 {
-		return nil, fmt.Errorf("invalid authorization header format: %s", err)
+		return nil, fmt.Errorf("invalid authorization header format")
 	}
 
 	// check authorization type
@@ -39,9 +48,83 @@ func (server *GRPCServer) authenticateUser(ctx context.Context) (payload *token.
 		return nil, fmt.Errorf("failed to verify token: %s", err)
 	}
 
+	// check the session of the token, the access tokens of revoked sessions are rejected
+	if err = session.CheckAccess(ctx, server.db, payload); err != nil {
+		return nil, fmt.Errorf("failed to check session: %w", err)
+	}
+
 	return
 }
 
 func unauthenticatedError(err error) error {
 	return status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
 }
+
+type payloadKey struct{}
+
+// methodRoles lists the roles allowed to call a method by its full name, those that aren't listed are open to
+// everyone and authenticate their users themselves. Only services the gateway doesn't serve can be listed, the
+// gateway calls the handlers in-process without going through the interceptors
+var methodRoles = serviceRoles(pb.AdminService_ServiceDesc, db.UserRoleAdmin)
+
+// serviceRoles restricts every method of the service to roles
+func serviceRoles(desc grpc.ServiceDesc, roles ...db.UserRole) map[string][]db.UserRole {
+	methods := make(map[string][]db.UserRole, len(desc.Methods))
+	for _, method := range desc.Methods {
+		methods[fmt.Sprintf("/%s/%s", desc.ServiceName, method.MethodName)] = roles
+	}
+	return methods
+}
+
+// authorizationInterceptor authenticates the calls to the services listed in methodRoles, rejects users
+// without one of their roles and hands the payload of the others to the handler through the context,
+// along with the audit actor of their changes
+func (server *GRPCServer) authorizationInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if roles, ok := methodRoles[info.FullMethod]; ok {
+		payload, err := server.authorizeUser(ctx, roles...)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx = context.WithValue(server.withActor(ctx, payload.Username, payload.SessionID), payloadKey{}, payload)
+	}
+
+	return handler(ctx, req)
+}
+
+// authorizeUser authenticates the caller and rejects them unless they have one of roles, the handlers of the
+// methods restricted to some roles call it themselves so the gateway can't skip the check. The role is loaded
+// from the database rather than taken from the token, so a demoted or deleted user loses access right away
+func (server *GRPCServer) authorizeUser(ctx context.Context, roles ...db.UserRole) (*token.Payload, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	current, err := session.CurrentRole(ctx, server.db, payload)
+	if err != nil {
+		if session.IsUnauthorized(err) {
+			return nil, unauthenticatedError(err)
+		}
+		return nil, status.Errorf(codes.Internal, "cannot get the role of user %s: %v", payload.Username, err)
+	}
+
+	if !hasRole(current, roles) {
+		return nil, status.Errorf(codes.PermissionDenied, "role %q isn't allowed, expected one of %v", current, roles)
+	}
+	return payload, nil
+}
+
+func hasRole(current db.UserRole, roles []db.UserRole) bool {
+	for _, role := range roles {
+		if current == role {
+			return true
+		}
+	}
+	return false
+}
+
+// authorizedPayload returns the payload authorizationInterceptor stored in the context
+func authorizedPayload(ctx context.Context) *token.Payload {
+	return ctx.Value(payloadKey{}).(*token.Payload)
+}
@@ -0,0 +1,80 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	mockdb "github.com/escalopa/gobank/db/mock"
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/token"
+	"github.com/escalopa/gobank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthorizationInterceptor(t *testing.T) {
+	testCases := []struct {
+		name   string
+		method string
+		role   db.UserRole
+		// current is the role of the user in the database, the role of the token when empty
+		current db.UserRole
+		code    codes.Code
+	}{
+		{name: "AdminAdminService", method: "/pb.AdminService/ListUsers", role: db.UserRoleAdmin, code: codes.OK},
+		{name: "TellerAdminService", method: "/pb.AdminService/ListUsers", role: db.UserRoleTeller, code: codes.PermissionDenied},
+		{name: "CustomerAdminService", method: "/pb.AdminService/ListUsers", role: db.UserRoleCustomer, code: codes.PermissionDenied},
+		{name: "DemotedAdmin", method: "/pb.AdminService/ListUsers", role: db.UserRoleAdmin, current: db.UserRoleCustomer, code: codes.PermissionDenied},
+		{name: "PromotedCustomer", method: "/pb.AdminService/ListUsers", role: db.UserRoleCustomer, current: db.UserRoleAdmin, code: codes.OK},
+		{name: "UnknownAdminMethod", method: "/pb.AdminService/ListUsersExtra", role: db.UserRoleCustomer, code: codes.OK},
+		{name: "CustomerOpenMethod", method: "/pb.BankService/GetAccount", role: db.UserRoleCustomer, code: codes.OK},
+		{name: "NoAuthorization", method: "/pb.AdminService/ListUsers", code: codes.Unauthenticated},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().IsSessionBlocked(gomock.Any(), gomock.Any()).AnyTimes().Return(false, nil)
+
+			username := util.RandomOwner()
+			current := tc.current
+			if current == "" {
+				current = tc.role
+			}
+			store.EXPECT().GetUser(gomock.Any(), gomock.Eq(username)).AnyTimes().Return(db.User{Username: username, Role: current}, nil)
+
+			maker, err := token.NewPasetoMaker(util.RandomString(32))
+			require.NoError(t, err)
+			server := &GRPCServer{db: store, tm: maker}
+
+			ctx := context.Background()
+			if tc.role != "" {
+				accessToken, _, err := maker.CreateToken(username, string(tc.role), uuid.New())
+				require.NoError(t, err)
+				md := metadata.Pairs(authorizationHeaderKey, fmt.Sprintf("%s %s", authorizationTypeBearer, accessToken))
+				ctx = metadata.NewIncomingContext(ctx, md)
+			}
+
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return nil, nil
+			}
+
+			_, err = server.authorizationInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tc.method}, handler)
+			require.Equal(t, tc.code, status.Code(err))
+			require.Equal(t, tc.code == codes.OK, called)
+		})
+	}
+}
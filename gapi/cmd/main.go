@@ -5,6 +5,7 @@ import (
 
 	db "github.com/escalopa/gobank/db/sqlc"
 	"github.com/escalopa/gobank/gapi"
+	"github.com/escalopa/gobank/risk"
 	"github.com/escalopa/gobank/util"
 )
 
@@ -14,7 +15,12 @@ func main() {
 
 	// Initialize the database
 	conn := db.InitDatabase(config)
-	store := db.NewStore(conn)
+
+	rules, err := risk.NewRules(config)
+	if err != nil {
+		log.Fatalf("cannot create risk rules, err: %s", err)
+	}
+	store := db.NewAuditedStore(conn, db.WithRiskRules(rules...))
 	grpcServer, err := gapi.NewServer(config, store)
 	if err != nil {
 		log.Fatalf("cannot create gRPC server, err: %s", err)
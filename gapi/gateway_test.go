@@ -0,0 +1,132 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	mockdb "github.com/escalopa/gobank/db/mock"
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/grpc/pb"
+	"github.com/escalopa/gobank/token"
+	"github.com/escalopa/gobank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGatewayEntryTx calls Deposit and Withdraw the way the gateway does, in-process and without the interceptors
+func TestGatewayEntryTx(t *testing.T) {
+	account := createRandomAccount(util.RandomOwner())
+	username := util.RandomOwner()
+	result := db.EntryTxResult{Account: account, Entry: db.Entry{AccountID: account.ID, Amount: 10}}
+
+	testCases := []struct {
+		name       string
+		path       string
+		role       db.UserRole
+		setupAuth  func(t *testing.T, request *http.Request, maker token.Maker)
+		buildStubs func(store *mockdb.MockStore)
+		code       int
+	}{
+		{
+			name: "TellerDeposit",
+			path: "deposits",
+			role: db.UserRoleTeller,
+			setupAuth: func(t *testing.T, request *http.Request, maker token.Maker) {
+				request.Header.Set("Authorization", bearerToken(t, maker, username, db.UserRoleTeller))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().DepositTx(gomock.Any(), gomock.Any()).Times(1).Return(result, nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "AdminWithdraw",
+			path: "withdrawals",
+			role: db.UserRoleAdmin,
+			setupAuth: func(t *testing.T, request *http.Request, maker token.Maker) {
+				request.Header.Set("Authorization", bearerToken(t, maker, username, db.UserRoleAdmin))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().WithdrawTx(gomock.Any(), gomock.Any()).Times(1).Return(result, nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name:      "NoAuthorizationDeposit",
+			path:      "deposits",
+			setupAuth: func(t *testing.T, request *http.Request, maker token.Maker) {},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DepositTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: http.StatusUnauthorized,
+		},
+		{
+			name:      "NoAuthorizationWithdraw",
+			path:      "withdrawals",
+			setupAuth: func(t *testing.T, request *http.Request, maker token.Maker) {},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().WithdrawTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: http.StatusUnauthorized,
+		},
+		{
+			name: "CustomerDeposit",
+			path: "deposits",
+			role: db.UserRoleCustomer,
+			setupAuth: func(t *testing.T, request *http.Request, maker token.Maker) {
+				request.Header.Set("Authorization", bearerToken(t, maker, username, db.UserRoleCustomer))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DepositTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: http.StatusForbidden,
+		},
+		{
+			name: "CustomerWithdraw",
+			path: "withdrawals",
+			role: db.UserRoleCustomer,
+			setupAuth: func(t *testing.T, request *http.Request, maker token.Maker) {
+				request.Header.Set("Authorization", bearerToken(t, maker, username, db.UserRoleCustomer))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().WithdrawTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: http.StatusForbidden,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+			store.EXPECT().IsSessionBlocked(gomock.Any(), gomock.Any()).AnyTimes().Return(false, nil)
+			if tc.role != "" {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(username)).Times(1).Return(db.User{Username: username, Role: tc.role}, nil)
+			}
+
+			server := newTestServer(t, store)
+			mux := runtime.NewServeMux()
+			require.NoError(t, pb.RegisterBankServiceHandlerServer(context.Background(), mux, server))
+
+			url := fmt.Sprintf("/v1/accounts/%d/%s", account.ID, tc.path)
+			request := httptest.NewRequest(http.MethodPost, url, strings.NewReader(`{"amount": 10}`))
+			tc.setupAuth(t, request, server.tm)
+
+			recorder := httptest.NewRecorder()
+			mux.ServeHTTP(recorder, request)
+			require.Equal(t, tc.code, recorder.Code, recorder.Body.String())
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/token"
+	"github.com/escalopa/gobank/util"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func newTestServer(t *testing.T, store db.Store) *GRPCServer {
+	testConfig := util.NewConfig()
+	testConfig.Set("SYMMETRIC_KEY", "12345678901234567890123456789012")
+
+	server, err := NewServer(testConfig, store)
+	require.NoError(t, err)
+	return server
+}
+
+func createRandomAccount(owner string) db.Account {
+	return db.Account{
+		ID:       util.RandomInteger(1, 1000),
+		Owner:    owner,
+		Balance:  util.RandomMoney(),
+		Currency: util.RandomCurrency(),
+		Status:   db.AccountStatusActive,
+	}
+}
+
+// bearerToken returns the authorization header of an access token of username with role
+func bearerToken(t *testing.T, maker token.Maker, username string, role db.UserRole) string {
+	accessToken, _, err := maker.CreateToken(username, string(role), uuid.New())
+	require.NoError(t, err)
+	return fmt.Sprintf("%s %s", authorizationTypeBearer, accessToken)
+}
+
+// contextWithToken returns the context of a call made by username with role
+func contextWithToken(t *testing.T, maker token.Maker, username string, role db.UserRole) context.Context {
+	md := metadata.Pairs(authorizationHeaderKey, bearerToken(t, maker, username, role))
+	return metadata.NewIncomingContext(context.Background(), md)
+}
@@ -1,8 +1,12 @@
 package gapi
 
 import (
+	"time"
+
 	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/fx"
 	"github.com/escalopa/gobank/grpc/pb"
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -13,5 +17,204 @@ func fromDBUserToPbUserResponse(user db.User) *pb.UserResponse {
 		Email:             user.Email,
 		PasswordChangedAt: timestamppb.New(user.PasswordChangedAt),
 		CreatedAt:         timestamppb.New(user.CreatedAt),
+		Role:              string(user.Role),
+	}
+}
+
+// fromDBSessionToPbSessionResponse maps session, currentID is the session of the access token of the call
+func fromDBSessionToPbSessionResponse(session db.Session, currentID uuid.UUID) *pb.SessionResponse {
+	return &pb.SessionResponse{
+		Id:        session.ID.String(),
+		UserAgent: session.UserAgent,
+		ClientIp:  session.ClientIp,
+		CreatedAt: timestamppb.New(session.CreatedAt),
+		ExpiresAt: timestamppb.New(session.ExpiresAt),
+		Current:   session.ID == currentID,
+	}
+}
+
+func fromDBAccountToPbAccountResponse(account db.Account) *pb.AccountResponse {
+	return &pb.AccountResponse{
+		Id:               account.ID,
+		Balance:          account.Balance,
+		Currency:         account.Currency,
+		CreatedAt:        timestamppb.New(account.CreatedAt),
+		OverdraftLimit:   account.OverdraftLimit,
+		Status:           string(account.Status),
+		AvailableBalance: account.AvailableBalance(),
+	}
+}
+
+func fromDBAccountToPbAdminAccountResponse(account db.Account) *pb.AdminAccountResponse {
+	return &pb.AdminAccountResponse{
+		Account:   fromDBAccountToPbAccountResponse(account),
+		Owner:     account.Owner,
+		IsDeleted: account.IsDeleted,
+	}
+}
+
+func fromDBEntryToPbEntryResponse(entry db.Entry) *pb.EntryResponse {
+	return &pb.EntryResponse{
+		Id:           entry.ID,
+		AccountId:    entry.AccountID,
+		Amount:       entry.Amount,
+		CreatedAt:    timestamppb.New(entry.CreatedAt),
+		Type:         string(entry.Type),
+		ExternalRef:  entry.ExternalRef.String,
+		TransferId:   entry.TransferID.Int64,
+		BalanceAfter: entry.BalanceAfter,
+		Description:  entry.Description,
+	}
+}
+
+func fromDBTransferToPbTransferResponse(transfer db.Transfer) *pb.TransferResponse {
+	res := &pb.TransferResponse{
+		Id:             transfer.ID,
+		FromAccountId:  transfer.FromAccountID,
+		ToAccountId:    transfer.ToAccountID,
+		Amount:         transfer.Amount,
+		CreatedAt:      timestamppb.New(transfer.CreatedAt),
+		ToAmount:       transfer.ToAmount,
+		FxRate:         fx.ScaledToFloat(transfer.FxRate),
+		FxRateAt:       timestamppb.New(transfer.FxRateAt),
+		ReversalOf:     transfer.ReversalOf.Int64,
+		ReversedAmount: transfer.ReversedAmount,
+		Status:         string(transfer.Status),
+		Fee:            transfer.FeeAmount,
+	}
+
+	if transfer.ReversedAt.Valid {
+		res.ReversedAt = timestamppb.New(transfer.ReversedAt.Time)
+	}
+	return res
+}
+
+// fromDBTransferToPbTransferReviewResponse adds the review of transfer to res, its response
+func fromDBTransferToPbTransferReviewResponse(res *pb.CreateTransferResponse, transfer db.Transfer) *pb.TransferReviewResponse {
+	review := &pb.TransferReviewResponse{
+		Transfer:   res,
+		RiskRule:   transfer.RiskRule.String,
+		RiskReason: transfer.RiskReason.String,
+		ReviewedBy: transfer.ReviewedBy.String,
+		ReviewNote: transfer.ReviewNote.String,
+	}
+
+	if transfer.ReviewedAt.Valid {
+		review.ReviewedAt = timestamppb.New(transfer.ReviewedAt.Time)
+	}
+	return review
+}
+
+func fromDBTransferTxResultToPbCreateTransferResponse(result db.TransferTxResult) *pb.CreateTransferResponse {
+	res := &pb.CreateTransferResponse{
+		Transfer:    fromDBTransferToPbTransferResponse(result.Transfer),
+		FromAccount: fromDBAccountToPbAccountResponse(result.FromAccount),
+		FromEntry:   fromDBEntryToPbEntryResponse(result.FromEntry),
+	}
+
+	if result.FeeEntry.ID != 0 {
+		res.FeeEntry = fromDBEntryToPbEntryResponse(result.FeeEntry)
+	}
+	return res
+}
+
+func fromDBReverseTransferTxResultToPbReverseTransferResponse(result db.ReverseTransferTxResult) *pb.ReverseTransferResponse {
+	return &pb.ReverseTransferResponse{
+		Original: fromDBTransferToPbTransferResponse(result.Original),
+		Reversal: fromDBTransferTxResultToPbCreateTransferResponse(result.Reversal),
+	}
+}
+
+func fromDBAccountStatusTxResultToPbCloseAccountResponse(result db.AccountStatusTxResult) *pb.CloseAccountResponse {
+	res := &pb.CloseAccountResponse{Account: fromDBAccountToPbAccountResponse(result.Account)}
+	if result.Sweep != nil {
+		res.Sweep = fromDBTransferTxResultToPbCreateTransferResponse(*result.Sweep)
+	}
+	return res
+}
+
+func fromDBAccountStatusChangeToPbAccountStatusChangeResponse(change db.AccountStatusChange) *pb.AccountStatusChangeResponse {
+	return &pb.AccountStatusChangeResponse{
+		FromStatus:      string(change.FromStatus),
+		ToStatus:        string(change.ToStatus),
+		ChangedBy:       change.ChangedBy,
+		Reason:          change.Reason,
+		SweepTransferId: change.SweepTransferID.Int64,
+		CreatedAt:       timestamppb.New(change.CreatedAt),
+	}
+}
+
+func fromDBAuditEventToPbAuditEventResponse(event db.AuditEvent) *pb.AuditEventResponse {
+	res := &pb.AuditEventResponse{
+		Id:            event.ID,
+		ActorUsername: event.ActorUsername,
+		ClientIp:      event.ClientIp,
+		UserAgent:     event.UserAgent,
+		Action:        event.Action,
+		TargetType:    event.TargetType,
+		TargetId:      event.TargetID,
+		Before:        string(event.Before),
+		After:         string(event.After),
+		CreatedAt:     timestamppb.New(event.CreatedAt),
+	}
+	if event.SessionID.Valid {
+		res.SessionId = event.SessionID.UUID.String()
+	}
+	return res
+}
+
+func fromDBEntryTxResultToPbEntryTxResponse(result db.EntryTxResult) *pb.EntryTxResponse {
+	return &pb.EntryTxResponse{
+		Account: fromDBAccountToPbAccountResponse(result.Account),
+		Entry:   fromDBEntryToPbEntryResponse(result.Entry),
+	}
+}
+
+func fromDBAccountStatementToPbAccountStatementResponse(account db.Account, from, to time.Time, statement db.AccountStatement) *pb.AccountStatementResponse {
+	res := &pb.AccountStatementResponse{
+		AccountId:      account.ID,
+		Currency:       account.Currency,
+		From:           timestamppb.New(from),
+		To:             timestamppb.New(to),
+		OpeningBalance: statement.OpeningBalance,
+		ClosingBalance: statement.ClosingBalance,
+	}
+
+	for _, entry := range statement.Entries {
+		res.Entries = append(res.Entries, &pb.StatementEntry{
+			Entry:          fromDBEntryToPbEntryResponse(entry.Entry),
+			RunningBalance: entry.RunningBalance,
+		})
+	}
+	return res
+}
+
+func fromDBScheduledTransferToPbScheduledTransferResponse(scheduled db.ScheduledTransfer) *pb.ScheduledTransferResponse {
+	res := &pb.ScheduledTransferResponse{
+		Id:            scheduled.ID,
+		FromAccountId: scheduled.FromAccountID,
+		ToAccountId:   scheduled.ToAccountID,
+		Amount:        scheduled.Amount,
+		Frequency:     string(scheduled.Frequency),
+		StartAt:       timestamppb.New(scheduled.StartAt),
+		NextRunAt:     timestamppb.New(scheduled.NextRunAt),
+		Status:        string(scheduled.Status),
+		CreatedAt:     timestamppb.New(scheduled.CreatedAt),
+	}
+
+	if scheduled.EndAt.Valid {
+		res.EndAt = timestamppb.New(scheduled.EndAt.Time)
+	}
+	return res
+}
+
+func fromDBScheduledTransferAttemptToPbScheduledTransferAttemptResponse(attempt db.ScheduledTransferAttempt) *pb.ScheduledTransferAttemptResponse {
+	return &pb.ScheduledTransferAttemptResponse{
+		Id:           attempt.ID,
+		Occurrence:   attempt.Occurrence,
+		ScheduledFor: timestamppb.New(attempt.ScheduledFor),
+		TransferId:   attempt.TransferID.Int64,
+		Error:        attempt.Error.String,
+		CreatedAt:    timestamppb.New(attempt.CreatedAt),
 	}
 }
@@ -4,6 +4,9 @@ import (
 	"context"
 	"log"
 
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/google/uuid"
+
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 )
@@ -13,11 +16,14 @@ const (
 	userAgent            = "user-agent"
 	grpcGatewayUserAgent = "grpcgateway-user-agent"
 	xForwardForHeader    = "x-forwarded-host"
+	// IdempotencyKeyHeader is forwarded by the gateway as is
+	IdempotencyKeyHeader = "idempotency-key"
 )
 
 type Metadata struct {
-	ClientIP  string
-	UserAgent string
+	ClientIP       string
+	UserAgent      string
+	IdempotencyKey string
 }
 
 func (server *GRPCServer) extractMetadata(ctx context.Context) *Metadata {
@@ -35,6 +41,9 @@ func (server *GRPCServer) extractMetadata(ctx context.Context) *Metadata {
 		if len(md[xForwardForHeader]) > 0 {
 			meta.ClientIP = md[xForwardForHeader][0]
 		}
+		if len(md[IdempotencyKeyHeader]) > 0 {
+			meta.IdempotencyKey = md[IdempotencyKeyHeader][0]
+		}
 	}
 
 	if p, ok := peer.FromContext(ctx); ok {
@@ -43,3 +52,10 @@ func (server *GRPCServer) extractMetadata(ctx context.Context) *Metadata {
 
 	return meta
 }
+
+// withActor returns a copy of ctx whose changes are recorded in the audit log as made by username
+// with the session sessionID, uuid.Nil when there is none yet, from the client of the call
+func (server *GRPCServer) withActor(ctx context.Context, username string, sessionID uuid.UUID) context.Context {
+	meta := server.extractMetadata(ctx)
+	return db.WithActor(ctx, db.Actor{Username: username, SessionID: sessionID, ClientIP: meta.ClientIP, UserAgent: meta.UserAgent})
+}
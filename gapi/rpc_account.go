@@ -0,0 +1,153 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/grpc/pb"
+	"github.com/escalopa/gobank/token"
+	"github.com/escalopa/gobank/util"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *GRPCServer) CreateAccount(ctx context.Context, req *pb.CreateAccountRequest) (*pb.AccountResponse, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+	ctx = server.withActor(ctx, payload.Username, payload.SessionID)
+
+	if !util.IsSupportedCurrency(req.GetCurrency()) {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported currency %s", req.GetCurrency())
+	}
+
+	account, err := server.db.CreateAccount(ctx, db.CreateAccountParams{
+		Owner:    payload.Username,
+		Balance:  server.startingBalance,
+		Currency: req.GetCurrency(),
+	})
+
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code.Name() {
+			case "unique_violation":
+				return nil, status.Errorf(codes.AlreadyExists, "account with currency %s already exists", req.GetCurrency())
+			case "foreign_key_violation":
+				return nil, status.Errorf(codes.PermissionDenied, "cannot create account: %v", err)
+			}
+		}
+		return nil, status.Errorf(codes.Internal, "cannot create account: %v", err)
+	}
+
+	res := fromDBAccountToPbAccountResponse(account)
+	return res, nil
+}
+
+func (server *GRPCServer) GetAccount(ctx context.Context, req *pb.AccountID) (*pb.AccountResponse, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	account, err := server.getOwnedAccount(ctx, payload, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	res := fromDBAccountToPbAccountResponse(account)
+	return res, nil
+}
+
+func (server *GRPCServer) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	var accounts []db.Account
+	if req.GetDeleted() {
+		accounts, err = server.db.GetDeletedAccounts(ctx, payload.Username)
+	} else {
+		accounts, err = server.db.GetAccounts(ctx, payload.Username)
+	}
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list accounts: %v", err)
+	}
+
+	res := &pb.ListAccountsResponse{}
+	for _, account := range accounts {
+		res.Accounts = append(res.Accounts, fromDBAccountToPbAccountResponse(account))
+	}
+	return res, nil
+}
+
+func (server *GRPCServer) DeleteAccount(ctx context.Context, req *pb.AccountID) (*empty.Empty, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+	ctx = server.withActor(ctx, payload.Username, payload.SessionID)
+
+	account, err := server.getOwnedAccount(ctx, payload, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.db.DeleteAccount(ctx, account.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot delete account %d: %v", account.ID, err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (server *GRPCServer) RestoreAccount(ctx context.Context, req *pb.AccountID) (*pb.AccountResponse, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+	ctx = server.withActor(ctx, payload.Username, payload.SessionID)
+
+	account, err := server.getOwnedAccount(ctx, payload, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.db.RestoreAccount(ctx, account.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot restore account %d: %v", account.ID, err)
+	}
+
+	account.IsDeleted = false
+	res := fromDBAccountToPbAccountResponse(account)
+	return res, nil
+}
+
+func (server *GRPCServer) getAccount(ctx context.Context, id int64) (db.Account, error) {
+	account, err := server.db.GetAccount(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return db.Account{}, status.Errorf(codes.NotFound, "account %d not found", id)
+		}
+		return db.Account{}, status.Errorf(codes.Internal, "cannot get account %d: %v", id, err)
+	}
+	return account, nil
+}
+
+// getOwnedAccount fetches the account and makes sure it belongs to the authenticated user
+func (server *GRPCServer) getOwnedAccount(ctx context.Context, payload *token.Payload, id int64) (db.Account, error) {
+	account, err := server.getAccount(ctx, id)
+	if err != nil {
+		return db.Account{}, err
+	}
+
+	if account.Owner != payload.Username {
+		return db.Account{}, status.Errorf(codes.PermissionDenied, "account %d doesn't belong to authenticated user", id)
+	}
+	return account, nil
+}
@@ -0,0 +1,76 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/grpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *GRPCServer) CloseAccount(ctx context.Context, req *pb.CloseAccountRequest) (*pb.CloseAccountResponse, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+	ctx = server.withActor(ctx, payload.Username, payload.SessionID)
+
+	if len(req.GetReason()) > 255 {
+		return nil, status.Errorf(codes.InvalidArgument, "reason must not exceed 255 characters")
+	}
+
+	account, err := server.getOwnedAccount(ctx, payload, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.CloseAccountTxParam{
+		AccountID: account.ID,
+		ChangedBy: account.Owner,
+		Reason:    req.GetReason(),
+	}
+
+	if req.GetSweepToAccountId() != 0 {
+		from, to, err := server.validateTransfer(ctx, account.ID, req.GetSweepToAccountId())
+		if err != nil {
+			return nil, err
+		}
+
+		arg.Rate, err = server.transferRate(from, to)
+		if err != nil {
+			return nil, err
+		}
+		arg.SweepToAccountID = to.ID
+	}
+
+	result, err := server.db.CloseAccountTx(ctx, arg)
+	if err != nil {
+		return nil, accountStatusTxError(account.ID, err)
+	}
+
+	return fromDBAccountStatusTxResultToPbCloseAccountResponse(result), nil
+}
+
+// accountStatusTxError maps the error of a status change of the account to a status, the limit and risk errors come
+// from the sweep
+func accountStatusTxError(id int64, err error) error {
+	var limitErr *db.TransferLimitError
+	if errors.As(err, &limitErr) {
+		return transferLimitError(limitErr)
+	}
+
+	var deniedErr *db.RiskDeniedError
+	if errors.As(err, &deniedErr) {
+		return riskDeniedError(deniedErr)
+	}
+
+	switch err {
+	case db.ErrInvalidStatusTransition, db.ErrAccountBalanceNotZero, db.ErrAccountHasHolds, db.ErrAccountFrozen, db.ErrAccountClosed, db.ErrInsufficientFunds:
+		return status.Errorf(codes.FailedPrecondition, "cannot change status of account %d: %v", id, err)
+	case db.ErrConvertedAmountTooSmall:
+		return status.Errorf(codes.InvalidArgument, "cannot change status of account %d: %v", id, err)
+	}
+	return status.Errorf(codes.Internal, "cannot change status of account %d: %v", id, err)
+}
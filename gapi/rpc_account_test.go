@@ -0,0 +1,332 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	mockdb "github.com/escalopa/gobank/db/mock"
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/grpc/pb"
+	"github.com/escalopa/gobank/token"
+	"github.com/escalopa/gobank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// rpcTestCase is a call made to a GRPCServer backed by a mocked store
+type rpcTestCase struct {
+	name       string
+	buildStubs func(store *mockdb.MockStore)
+	buildCtx   func(t *testing.T, maker token.Maker) context.Context
+	code       codes.Code
+}
+
+// runRPCTest stubs the store of tc and passes call the server and the context of tc, call returns the error of the rpc
+func runRPCTest(t *testing.T, tc rpcTestCase, call func(t *testing.T, server *GRPCServer, ctx context.Context) error) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	tc.buildStubs(store)
+	store.EXPECT().IsSessionBlocked(gomock.Any(), gomock.Any()).AnyTimes().Return(false, nil)
+
+	server := newTestServer(t, store)
+	err := call(t, server, tc.buildCtx(t, server.tm))
+	require.Equal(t, tc.code, status.Code(err), err)
+}
+
+func callerContext(username string) func(t *testing.T, maker token.Maker) context.Context {
+	return func(t *testing.T, maker token.Maker) context.Context {
+		return contextWithToken(t, maker, username, db.UserRoleCustomer)
+	}
+}
+
+func noAuthorization(t *testing.T, maker token.Maker) context.Context {
+	return context.Background()
+}
+
+func TestCreateAccountRPC(t *testing.T) {
+	username := util.RandomOwner()
+	account := createRandomAccount(username)
+
+	testCases := []struct {
+		currency string
+		rpcTestCase
+	}{
+		{
+			currency: account.Currency,
+			rpcTestCase: rpcTestCase{
+				name: "OK",
+				buildStubs: func(store *mockdb.MockStore) {
+					arg := db.CreateAccountParams{Owner: username, Currency: account.Currency}
+					store.EXPECT().CreateAccount(gomock.Any(), gomock.Eq(arg)).Times(1).Return(account, nil)
+				},
+				buildCtx: callerContext(username),
+				code:     codes.OK,
+			},
+		},
+		{
+			currency: "XYZ",
+			rpcTestCase: rpcTestCase{
+				name: "UnsupportedCurrency",
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().CreateAccount(gomock.Any(), gomock.Any()).Times(0)
+				},
+				buildCtx: callerContext(username),
+				code:     codes.InvalidArgument,
+			},
+		},
+		{
+			currency: account.Currency,
+			rpcTestCase: rpcTestCase{
+				name: "CurrencyTaken",
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().CreateAccount(gomock.Any(), gomock.Any()).Times(1).Return(db.Account{}, &pq.Error{Code: "23505"})
+				},
+				buildCtx: callerContext(username),
+				code:     codes.AlreadyExists,
+			},
+		},
+		{
+			currency: account.Currency,
+			rpcTestCase: rpcTestCase{
+				name: "InternalError",
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().CreateAccount(gomock.Any(), gomock.Any()).Times(1).Return(db.Account{}, sql.ErrConnDone)
+				},
+				buildCtx: callerContext(username),
+				code:     codes.Internal,
+			},
+		},
+		{
+			currency: account.Currency,
+			rpcTestCase: rpcTestCase{
+				name: "NoAuthorization",
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().CreateAccount(gomock.Any(), gomock.Any()).Times(0)
+				},
+				buildCtx: noAuthorization,
+				code:     codes.Unauthenticated,
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			runRPCTest(t, tc.rpcTestCase, func(t *testing.T, server *GRPCServer, ctx context.Context) error {
+				res, err := server.CreateAccount(ctx, &pb.CreateAccountRequest{Currency: tc.currency})
+				if err == nil {
+					require.Equal(t, account.ID, res.GetId())
+					require.Equal(t, account.Currency, res.GetCurrency())
+				}
+				return err
+			})
+		})
+	}
+}
+
+// ownedAccountTestCases are the cases of an rpc on the account of username that only checks it is theirs,
+// the stubs of the rpc itself are added by ok when the check passes
+func ownedAccountTestCases(username string, account db.Account, ok func(store *mockdb.MockStore)) []rpcTestCase {
+	return []rpcTestCase{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				ok(store)
+			},
+			buildCtx: callerContext(username),
+			code:     codes.OK,
+		},
+		{
+			name: "NotAccountOwner",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+			},
+			buildCtx: callerContext(util.RandomOwner()),
+			code:     codes.PermissionDenied,
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(db.Account{}, sql.ErrNoRows)
+			},
+			buildCtx: callerContext(username),
+			code:     codes.NotFound,
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(db.Account{}, sql.ErrConnDone)
+			},
+			buildCtx: callerContext(username),
+			code:     codes.Internal,
+		},
+		{
+			name: "NoAuthorization",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildCtx: noAuthorization,
+			code:     codes.Unauthenticated,
+		},
+	}
+}
+
+func TestGetAccountRPC(t *testing.T) {
+	username := util.RandomOwner()
+	account := createRandomAccount(username)
+
+	for _, tc := range ownedAccountTestCases(username, account, func(store *mockdb.MockStore) {}) {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			runRPCTest(t, tc, func(t *testing.T, server *GRPCServer, ctx context.Context) error {
+				res, err := server.GetAccount(ctx, &pb.AccountID{Id: account.ID})
+				if err == nil {
+					require.Equal(t, fromDBAccountToPbAccountResponse(account), res)
+				}
+				return err
+			})
+		})
+	}
+}
+
+func TestDeleteAccountRPC(t *testing.T) {
+	username := util.RandomOwner()
+	account := createRandomAccount(username)
+
+	testCases := ownedAccountTestCases(username, account, func(store *mockdb.MockStore) {
+		store.EXPECT().DeleteAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(nil)
+	})
+	testCases = append(testCases, rpcTestCase{
+		name: "DeleteError",
+		buildStubs: func(store *mockdb.MockStore) {
+			store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+			store.EXPECT().DeleteAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(sql.ErrConnDone)
+		},
+		buildCtx: callerContext(username),
+		code:     codes.Internal,
+	})
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			runRPCTest(t, tc, func(t *testing.T, server *GRPCServer, ctx context.Context) error {
+				_, err := server.DeleteAccount(ctx, &pb.AccountID{Id: account.ID})
+				return err
+			})
+		})
+	}
+}
+
+func TestRestoreAccountRPC(t *testing.T) {
+	username := util.RandomOwner()
+	account := createRandomAccount(username)
+	account.IsDeleted = true
+
+	testCases := ownedAccountTestCases(username, account, func(store *mockdb.MockStore) {
+		store.EXPECT().RestoreAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(nil)
+	})
+	testCases = append(testCases, rpcTestCase{
+		name: "RestoreError",
+		buildStubs: func(store *mockdb.MockStore) {
+			store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+			store.EXPECT().RestoreAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(sql.ErrConnDone)
+		},
+		buildCtx: callerContext(username),
+		code:     codes.Internal,
+	})
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			runRPCTest(t, tc, func(t *testing.T, server *GRPCServer, ctx context.Context) error {
+				res, err := server.RestoreAccount(ctx, &pb.AccountID{Id: account.ID})
+				if err == nil {
+					require.Equal(t, account.ID, res.GetId())
+				}
+				return err
+			})
+		})
+	}
+}
+
+func TestListAccountsRPC(t *testing.T) {
+	username := util.RandomOwner()
+	accounts := []db.Account{createRandomAccount(username), createRandomAccount(username)}
+
+	testCases := []struct {
+		deleted bool
+		rpcTestCase
+	}{
+		{
+			rpcTestCase: rpcTestCase{
+				name: "OK",
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccounts(gomock.Any(), gomock.Eq(username)).Times(1).Return(accounts, nil)
+					store.EXPECT().GetDeletedAccounts(gomock.Any(), gomock.Any()).Times(0)
+				},
+				buildCtx: callerContext(username),
+				code:     codes.OK,
+			},
+		},
+		{
+			deleted: true,
+			rpcTestCase: rpcTestCase{
+				name: "Deleted",
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetDeletedAccounts(gomock.Any(), gomock.Eq(username)).Times(1).Return(accounts, nil)
+					store.EXPECT().GetAccounts(gomock.Any(), gomock.Any()).Times(0)
+				},
+				buildCtx: callerContext(username),
+				code:     codes.OK,
+			},
+		},
+		{
+			rpcTestCase: rpcTestCase{
+				name: "InternalError",
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccounts(gomock.Any(), gomock.Eq(username)).Times(1).Return(nil, sql.ErrConnDone)
+				},
+				buildCtx: callerContext(username),
+				code:     codes.Internal,
+			},
+		},
+		{
+			rpcTestCase: rpcTestCase{
+				name: "NoAuthorization",
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccounts(gomock.Any(), gomock.Any()).Times(0)
+				},
+				buildCtx: noAuthorization,
+				code:     codes.Unauthenticated,
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			runRPCTest(t, tc.rpcTestCase, func(t *testing.T, server *GRPCServer, ctx context.Context) error {
+				res, err := server.ListAccounts(ctx, &pb.ListAccountsRequest{Deleted: tc.deleted})
+				if err == nil {
+					require.Len(t, res.GetAccounts(), len(accounts))
+					for i, account := range accounts {
+						require.Equal(t, fromDBAccountToPbAccountResponse(account), res.GetAccounts()[i])
+					}
+				}
+				return err
+			})
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/grpc/pb"
+	"github.com/escalopa/gobank/util"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// adminServer serves AdminService, authorizationInterceptor makes sure only admins reach it
+type adminServer struct {
+	pb.UnimplementedAdminServiceServer
+	server *GRPCServer
+}
+
+func (admin *adminServer) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	cursor, pageSize, err := admin.server.parsePage(req.GetPageSize(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := admin.server.db.ListUsers(ctx, db.ListUsersParams{
+		AfterUsername: cursor.Key,
+		PageSize:      pageSize + 1,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list users: %v", err)
+	}
+
+	res := &pb.ListUsersResponse{}
+	users, res.NextCursor = util.Paginate(users, pageSize, func(user db.User) util.Cursor {
+		return util.Cursor{Key: user.Username}
+	})
+
+	for _, user := range users {
+		res.Users = append(res.Users, fromDBUserToPbUserResponse(user))
+	}
+	return res, nil
+}
+
+func (admin *adminServer) GetAccount(ctx context.Context, req *pb.AccountID) (*pb.AdminAccountResponse, error) {
+	account, err := admin.server.getAccount(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return fromDBAccountToPbAdminAccountResponse(account), nil
+}
+
+func (admin *adminServer) BlockUserSessions(ctx context.Context, req *pb.BlockUserSessionsRequest) (*empty.Empty, error) {
+	user, err := admin.server.getUser(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = admin.server.db.BlockUserSessions(ctx, user.Username); err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot block sessions of user %s: %v", user.Username, err)
+	}
+	return &empty.Empty{}, nil
+}
+
+func (admin *adminServer) FreezeAccount(ctx context.Context, req *pb.AccountStatusRequest) (*pb.AdminAccountResponse, error) {
+	return admin.changeAccountStatus(ctx, req, db.AccountStatusFrozen)
+}
+
+func (admin *adminServer) UnfreezeAccount(ctx context.Context, req *pb.AccountStatusRequest) (*pb.AdminAccountResponse, error) {
+	return admin.changeAccountStatus(ctx, req, db.AccountStatusActive)
+}
+
+func (admin *adminServer) changeAccountStatus(ctx context.Context, req *pb.AccountStatusRequest, accountStatus db.AccountStatus) (*pb.AdminAccountResponse, error) {
+	payload := authorizedPayload(ctx)
+	if req.GetReason() == "" || len(req.GetReason()) > 255 {
+		return nil, status.Errorf(codes.InvalidArgument, "reason is required and must not exceed 255 characters")
+	}
+
+	account, err := admin.server.getAccount(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := admin.server.db.ChangeAccountStatusTx(ctx, db.ChangeAccountStatusTxParam{
+		AccountID: account.ID,
+		Status:    accountStatus,
+		ChangedBy: payload.Username,
+		Reason:    req.GetReason(),
+	})
+	if err != nil {
+		return nil, accountStatusTxError(account.ID, err)
+	}
+
+	return fromDBAccountToPbAdminAccountResponse(result.Account), nil
+}
+
+func (admin *adminServer) ListAccountStatusChanges(ctx context.Context, req *pb.AccountID) (*pb.ListAccountStatusChangesResponse, error) {
+	account, err := admin.server.getAccount(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := admin.server.db.ListAccountStatusChanges(ctx, account.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list status changes of account %d: %v", account.ID, err)
+	}
+
+	res := &pb.ListAccountStatusChangesResponse{}
+	for _, change := range changes {
+		res.Changes = append(res.Changes, fromDBAccountStatusChangeToPbAccountStatusChangeResponse(change))
+	}
+	return res, nil
+}
+
+func (admin *adminServer) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	cursor, pageSize, err := admin.server.parsePage(req.GetPageSize(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := admin.server.db.ListAuditEvents(ctx, db.ListAuditEventsParams{
+		AfterID:       cursor.ID,
+		ActorUsername: sql.NullString{String: req.GetActor(), Valid: req.GetActor() != ""},
+		Action:        sql.NullString{String: req.GetAction(), Valid: req.GetAction() != ""},
+		TargetType:    sql.NullString{String: req.GetTargetType(), Valid: req.GetTargetType() != ""},
+		TargetID:      sql.NullString{String: req.GetTargetId(), Valid: req.GetTargetId() != ""},
+		FromTime:      sql.NullTime{Time: req.GetFrom().AsTime(), Valid: req.GetFrom() != nil},
+		ToTime:        sql.NullTime{Time: req.GetTo().AsTime(), Valid: req.GetTo() != nil},
+		PageSize:      pageSize + 1,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list audit events: %v", err)
+	}
+
+	res := &pb.ListAuditEventsResponse{}
+	events, res.NextCursor = util.Paginate(events, pageSize, func(event db.AuditEvent) util.Cursor {
+		return util.Cursor{ID: event.ID}
+	})
+
+	for _, event := range events {
+		res.Events = append(res.Events, fromDBAuditEventToPbAuditEventResponse(event))
+	}
+	return res, nil
+}
+
+func (admin *adminServer) ReverseTransfer(ctx context.Context, req *pb.ReverseTransferRequest) (*pb.ReverseTransferResponse, error) {
+	if req.GetAmount() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "amount must not be negative, provided: %d", req.GetAmount())
+	}
+
+	return admin.server.reverseTransfer(ctx, req.GetId(), req.GetAmount())
+}
+
+func (admin *adminServer) ListPendingTransfers(ctx context.Context, req *pb.ListPendingTransfersRequest) (*pb.ListPendingTransfersResponse, error) {
+	cursor, pageSize, err := admin.server.parsePage(req.GetPageSize(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	transfers, err := admin.server.db.ListPendingTransfers(ctx, db.ListPendingTransfersParams{
+		AfterID:  cursor.ID,
+		PageSize: pageSize + 1,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list pending transfers: %v", err)
+	}
+
+	res := &pb.ListPendingTransfersResponse{}
+	transfers, res.NextCursor = util.Paginate(transfers, pageSize, func(transfer db.Transfer) util.Cursor {
+		return util.Cursor{ID: transfer.ID}
+	})
+
+	for _, transfer := range transfers {
+		transferRes := &pb.CreateTransferResponse{Transfer: fromDBTransferToPbTransferResponse(transfer)}
+		res.Transfers = append(res.Transfers, fromDBTransferToPbTransferReviewResponse(transferRes, transfer))
+	}
+	return res, nil
+}
+
+func (admin *adminServer) ApproveTransfer(ctx context.Context, req *pb.ReviewTransferRequest) (*pb.TransferReviewResponse, error) {
+	arg, err := reviewTransferParam(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := admin.server.db.ApproveTransferTx(ctx, arg)
+	if err != nil {
+		return nil, reviewTransferTxError(req.GetId(), err)
+	}
+
+	return fromDBTransferToPbTransferReviewResponse(fromDBTransferTxResultToPbCreateTransferResponse(result), result.Transfer), nil
+}
+
+func (admin *adminServer) RejectTransfer(ctx context.Context, req *pb.ReviewTransferRequest) (*pb.TransferReviewResponse, error) {
+	arg, err := reviewTransferParam(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := admin.server.db.RejectTransferTx(ctx, arg)
+	if err != nil {
+		return nil, reviewTransferTxError(req.GetId(), err)
+	}
+
+	transferRes := &pb.CreateTransferResponse{Transfer: fromDBTransferToPbTransferResponse(transfer)}
+	return fromDBTransferToPbTransferReviewResponse(transferRes, transfer), nil
+}
+
+// reviewTransferParam applies the same rules as the HTTP api to a review, made by the authorized admin
+func reviewTransferParam(ctx context.Context, req *pb.ReviewTransferRequest) (db.ReviewTransferTxParam, error) {
+	if len(req.GetNote()) > 255 {
+		return db.ReviewTransferTxParam{}, status.Errorf(codes.InvalidArgument, "note must not exceed 255 characters")
+	}
+
+	return db.ReviewTransferTxParam{
+		TransferID: req.GetId(),
+		ReviewedBy: authorizedPayload(ctx).Username,
+		Note:       req.GetNote(),
+	}, nil
+}
+
+func reviewTransferTxError(id int64, err error) error {
+	switch err {
+	case sql.ErrNoRows:
+		return status.Errorf(codes.NotFound, "transfer %d not found", id)
+	case db.ErrTransferNotPending, db.ErrInsufficientFunds, db.ErrAccountFrozen, db.ErrAccountClosed:
+		return status.Errorf(codes.FailedPrecondition, "cannot review transfer %d: %v", id, err)
+	}
+	return status.Errorf(codes.Internal, "cannot review transfer %d: %v", id, err)
+}
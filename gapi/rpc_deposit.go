@@ -0,0 +1,94 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/grpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// entryTxRoles are the roles allowed to deposit into and withdraw from accounts
+var entryTxRoles = []db.UserRole{db.UserRoleTeller, db.UserRoleAdmin}
+
+func (server *GRPCServer) Deposit(ctx context.Context, req *pb.DepositRequest) (*pb.EntryTxResponse, error) {
+	payload, err := server.authorizeUser(ctx, entryTxRoles...)
+	if err != nil {
+		return nil, err
+	}
+	ctx = server.withActor(ctx, payload.Username, payload.SessionID)
+
+	account, err := server.validateEntryTx(ctx, req.GetAccountId(), req.GetAmount(), req.GetExternalRef())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := server.db.DepositTx(ctx, db.DepositTxParam{
+		AccountID:   account.ID,
+		Amount:      req.GetAmount(),
+		ExternalRef: req.GetExternalRef(),
+	})
+
+	if err != nil {
+		if err == db.ErrAccountClosed {
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot deposit into account %d: %v", account.ID, err)
+		}
+		return nil, status.Errorf(codes.Internal, "cannot deposit into account %d: %v", account.ID, err)
+	}
+
+	res := fromDBEntryTxResultToPbEntryTxResponse(result)
+	return res, nil
+}
+
+func (server *GRPCServer) Withdraw(ctx context.Context, req *pb.WithdrawRequest) (*pb.EntryTxResponse, error) {
+	payload, err := server.authorizeUser(ctx, entryTxRoles...)
+	if err != nil {
+		return nil, err
+	}
+	ctx = server.withActor(ctx, payload.Username, payload.SessionID)
+
+	account, err := server.validateEntryTx(ctx, req.GetAccountId(), req.GetAmount(), req.GetExternalRef())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := server.db.WithdrawTx(ctx, db.WithdrawTxParam{
+		AccountID:   account.ID,
+		Amount:      req.GetAmount(),
+		ExternalRef: req.GetExternalRef(),
+	})
+
+	if err != nil {
+		switch err {
+		case db.ErrInsufficientFunds, db.ErrAccountFrozen, db.ErrAccountClosed:
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot withdraw from account %d: %v", account.ID, err)
+		}
+		return nil, status.Errorf(codes.Internal, "cannot withdraw from account %d: %v", account.ID, err)
+	}
+
+	res := fromDBEntryTxResultToPbEntryTxResponse(result)
+	return res, nil
+}
+
+// validateEntryTx applies the same rules as the HTTP api before a deposit or a withdrawal, the caller is a teller
+// or an admin
+func (server *GRPCServer) validateEntryTx(ctx context.Context, accountID, amount int64, externalRef string) (db.Account, error) {
+	if amount < 1 {
+		return db.Account{}, status.Errorf(codes.InvalidArgument, "amount must be positive, provided: %d", amount)
+	}
+
+	if len(externalRef) > 255 {
+		return db.Account{}, status.Errorf(codes.InvalidArgument, "external_ref must not exceed 255 characters")
+	}
+
+	account, err := server.getAccount(ctx, accountID)
+	if err != nil {
+		return db.Account{}, err
+	}
+
+	if account.IsDeleted {
+		return db.Account{}, status.Errorf(codes.FailedPrecondition, "account %d is deleted", account.ID)
+	}
+	return account, nil
+}
@@ -0,0 +1,49 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/grpc/pb"
+	"github.com/escalopa/gobank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *GRPCServer) ListEntries(ctx context.Context, req *pb.ListEntriesRequest) (*pb.ListEntriesResponse, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	cursor, pageSize, err := server.parsePage(req.GetPageSize(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := server.getOwnedAccount(ctx, payload, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := server.db.ListEntries(ctx, db.ListEntriesParams{
+		AccountID:      account.ID,
+		AfterCreatedAt: cursor.CreatedAt,
+		AfterID:        cursor.ID,
+		PageSize:       pageSize + 1,
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list entries: %v", err)
+	}
+
+	res := &pb.ListEntriesResponse{}
+	entries, res.NextCursor = util.Paginate(entries, pageSize, func(entry db.Entry) util.Cursor {
+		return util.Cursor{CreatedAt: entry.CreatedAt, ID: entry.ID}
+	})
+
+	for _, entry := range entries {
+		res.Entries = append(res.Entries, fromDBEntryToPbEntryResponse(entry))
+	}
+	return res, nil
+}
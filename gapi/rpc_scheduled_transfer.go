@@ -0,0 +1,234 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/fx"
+	"github.com/escalopa/gobank/grpc/pb"
+	"github.com/escalopa/gobank/token"
+	"github.com/escalopa/gobank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *GRPCServer) CreateScheduledTransfer(ctx context.Context, req *pb.CreateScheduledTransferRequest) (*pb.ScheduledTransferResponse, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+	ctx = server.withActor(ctx, payload.Username, payload.SessionID)
+
+	if req.GetAmount() < 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "amount must be positive, provided: %d", req.GetAmount())
+	}
+
+	if req.GetStartAt() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "start_at is required")
+	}
+
+	arg := db.CreateScheduledTransferParams{
+		Owner:         payload.Username,
+		FromAccountID: req.GetFromAccountId(),
+		ToAccountID:   req.GetToAccountId(),
+		Amount:        req.GetAmount(),
+		Frequency:     db.TransferFrequency(req.GetFrequency()),
+		StartAt:       req.GetStartAt().AsTime(),
+		EndAt:         sql.NullTime{Time: req.GetEndAt().AsTime(), Valid: req.GetEndAt() != nil},
+	}
+
+	if err := arg.Validate(time.Now()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	fromAccount, toAccount, err := server.validateTransfer(ctx, req.GetFromAccountId(), req.GetToAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	if fromAccount.Owner != payload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "account %d doesn't belong to authenticated user", fromAccount.ID)
+	}
+
+	// The rate is taken when each occurrence runs, the pair must be known upfront though
+	if fromAccount.Currency != toAccount.Currency {
+		if _, err := server.rates.Rate(fromAccount.Currency, toAccount.Currency); err != nil {
+			if errors.Is(err, fx.ErrRateNotFound) {
+				return nil, status.Errorf(codes.InvalidArgument, "cannot convert %s to %s: %v", fromAccount.Currency, toAccount.Currency, err)
+			}
+			return nil, status.Errorf(codes.Internal, "cannot get exchange rate: %v", err)
+		}
+	}
+
+	scheduled, err := server.db.CreateScheduledTransfer(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot create scheduled transfer: %v", err)
+	}
+
+	res := fromDBScheduledTransferToPbScheduledTransferResponse(scheduled)
+	return res, nil
+}
+
+func (server *GRPCServer) GetScheduledTransfer(ctx context.Context, req *pb.ScheduledTransferID) (*pb.ScheduledTransferResponse, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	scheduled, err := server.getOwnedScheduledTransfer(ctx, payload, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	res := fromDBScheduledTransferToPbScheduledTransferResponse(scheduled)
+	return res, nil
+}
+
+func (server *GRPCServer) ListScheduledTransfers(ctx context.Context, req *pb.ListScheduledTransfersRequest) (*pb.ListScheduledTransfersResponse, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	schedules, err := server.db.ListScheduledTransfers(ctx, payload.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list scheduled transfers: %v", err)
+	}
+
+	res := &pb.ListScheduledTransfersResponse{}
+	for _, scheduled := range schedules {
+		res.ScheduledTransfers = append(res.ScheduledTransfers, fromDBScheduledTransferToPbScheduledTransferResponse(scheduled))
+	}
+	return res, nil
+}
+
+func (server *GRPCServer) UpdateScheduledTransfer(ctx context.Context, req *pb.UpdateScheduledTransferRequest) (*pb.ScheduledTransferResponse, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+	ctx = server.withActor(ctx, payload.Username, payload.SessionID)
+
+	if req.GetAmount() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "amount must be positive, provided: %d", req.GetAmount())
+	}
+
+	switch db.ScheduledTransferStatus(req.GetStatus()) {
+	case "", db.ScheduledTransferStatusActive, db.ScheduledTransferStatusPaused:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown status %q, expected active or paused", req.GetStatus())
+	}
+
+	scheduled, err := server.getOwnedScheduledTransfer(ctx, payload, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.UpdateScheduledTransferParams{
+		ID:     scheduled.ID,
+		Amount: sql.NullInt64{Int64: req.GetAmount(), Valid: req.GetAmount() != 0},
+		Status: db.NullScheduledTransferStatus{ScheduledTransferStatus: db.ScheduledTransferStatus(req.GetStatus()), Valid: req.GetStatus() != ""},
+	}
+
+	if req.GetEndAt() != nil {
+		if err := scheduled.ValidateEnd(req.GetEndAt().AsTime()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		arg.EndAt = sql.NullTime{Time: req.GetEndAt().AsTime(), Valid: true}
+	}
+
+	return server.saveScheduledTransfer(ctx, scheduled, arg)
+}
+
+func (server *GRPCServer) CancelScheduledTransfer(ctx context.Context, req *pb.ScheduledTransferID) (*pb.ScheduledTransferResponse, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+	ctx = server.withActor(ctx, payload.Username, payload.SessionID)
+
+	scheduled, err := server.getOwnedScheduledTransfer(ctx, payload, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return server.saveScheduledTransfer(ctx, scheduled, db.UpdateScheduledTransferParams{
+		ID:     scheduled.ID,
+		Status: db.NullScheduledTransferStatus{ScheduledTransferStatus: db.ScheduledTransferStatusCancelled, Valid: true},
+	})
+}
+
+func (server *GRPCServer) ListScheduledTransferAttempts(ctx context.Context, req *pb.ListScheduledTransferAttemptsRequest) (*pb.ListScheduledTransferAttemptsResponse, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	cursor, pageSize, err := server.parsePage(req.GetPageSize(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	scheduled, err := server.getOwnedScheduledTransfer(ctx, payload, req.GetScheduledTransferId())
+	if err != nil {
+		return nil, err
+	}
+
+	attempts, err := server.db.ListScheduledTransferAttempts(ctx, db.ListScheduledTransferAttemptsParams{
+		ScheduledTransferID: scheduled.ID,
+		AfterCreatedAt:      cursor.CreatedAt,
+		AfterID:             cursor.ID,
+		PageSize:            pageSize + 1,
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list scheduled transfer attempts: %v", err)
+	}
+
+	res := &pb.ListScheduledTransferAttemptsResponse{}
+	attempts, res.NextCursor = util.Paginate(attempts, pageSize, func(attempt db.ScheduledTransferAttempt) util.Cursor {
+		return util.Cursor{CreatedAt: attempt.CreatedAt, ID: attempt.ID}
+	})
+
+	for _, attempt := range attempts {
+		res.Attempts = append(res.Attempts, fromDBScheduledTransferAttemptToPbScheduledTransferAttemptResponse(attempt))
+	}
+	return res, nil
+}
+
+// getOwnedScheduledTransfer fetches the scheduled transfer and makes sure it belongs to the authenticated user
+func (server *GRPCServer) getOwnedScheduledTransfer(ctx context.Context, payload *token.Payload, id int64) (db.ScheduledTransfer, error) {
+	scheduled, err := server.db.GetScheduledTransfer(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return db.ScheduledTransfer{}, status.Errorf(codes.NotFound, "scheduled transfer %d not found", id)
+		}
+		return db.ScheduledTransfer{}, status.Errorf(codes.Internal, "cannot get scheduled transfer %d: %v", id, err)
+	}
+
+	if scheduled.Owner != payload.Username {
+		return db.ScheduledTransfer{}, status.Errorf(codes.PermissionDenied, "scheduled transfer %d doesn't belong to authenticated user", id)
+	}
+	return scheduled, nil
+}
+
+// saveScheduledTransfer applies arg to the scheduled transfer, as long as it hasn't been closed in the meantime
+func (server *GRPCServer) saveScheduledTransfer(ctx context.Context, scheduled db.ScheduledTransfer, arg db.UpdateScheduledTransferParams) (*pb.ScheduledTransferResponse, error) {
+	if !scheduled.IsOpen() {
+		return nil, status.Errorf(codes.FailedPrecondition, "scheduled transfer %d is %s, only active and paused ones can be updated", scheduled.ID, scheduled.Status)
+	}
+
+	updated, err := server.db.UpdateScheduledTransfer(ctx, arg)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.FailedPrecondition, "scheduled transfer %d is closed, only active and paused ones can be updated", scheduled.ID)
+		}
+		return nil, status.Errorf(codes.Internal, "cannot update scheduled transfer: %v", err)
+	}
+
+	res := fromDBScheduledTransferToPbScheduledTransferResponse(updated)
+	return res, nil
+}
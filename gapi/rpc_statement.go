@@ -0,0 +1,49 @@
+package gapi
+
+import (
+	"context"
+	"time"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/grpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *GRPCServer) GetAccountStatement(ctx context.Context, req *pb.AccountStatementRequest) (*pb.AccountStatementResponse, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if req.GetFrom() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "from is required")
+	}
+
+	from, to := req.GetFrom().AsTime(), time.Now()
+	if req.GetTo() != nil {
+		to = req.GetTo().AsTime()
+	}
+
+	if !to.After(from) {
+		return nil, status.Errorf(codes.InvalidArgument, "to must be after from")
+	}
+
+	account, err := server.getOwnedAccount(ctx, payload, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	statement, err := server.db.AccountStatementTx(ctx, db.AccountStatementParam{
+		AccountID: account.ID,
+		From:      from,
+		To:        to,
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot get statement of account %d: %v", account.ID, err)
+	}
+
+	res := fromDBAccountStatementToPbAccountStatementResponse(account, from, to, statement)
+	return res, nil
+}
@@ -0,0 +1,385 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/fx"
+	"github.com/escalopa/gobank/grpc/pb"
+	"github.com/escalopa/gobank/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (server *GRPCServer) CreateTransfer(ctx context.Context, req *pb.CreateTransferRequest) (*pb.CreateTransferResponse, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+	ctx = server.withActor(ctx, payload.Username, payload.SessionID)
+
+	if req.GetAmount() < 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "amount must be positive, provided: %d", req.GetAmount())
+	}
+
+	fromAccount, toAccount, err := server.validateTransfer(ctx, req.GetFromAccountId(), req.GetToAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	if fromAccount.Owner != payload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "account %d doesn't belong to authenticated user", fromAccount.ID)
+	}
+
+	arg := db.TransferTxParam{
+		FromAccountID: req.GetFromAccountId(),
+		ToAccountID:   req.GetToAccountId(),
+		Amount:        req.GetAmount(),
+	}
+
+	arg.Rate, err = server.transferRate(fromAccount, toAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	if key := server.extractMetadata(ctx).IdempotencyKey; key != "" {
+		arg.Idempotency = &db.IdempotencyParam{
+			Key:       key,
+			Username:  payload.Username,
+			Retention: server.idempotencyRetention,
+		}
+	}
+
+	result, err := server.db.TransferTx(ctx, arg)
+	if err != nil {
+		var limitErr *db.TransferLimitError
+		if errors.As(err, &limitErr) {
+			return nil, transferLimitError(limitErr)
+		}
+
+		var deniedErr *db.RiskDeniedError
+		if errors.As(err, &deniedErr) {
+			return nil, riskDeniedError(deniedErr)
+		}
+
+		switch err {
+		case db.ErrIdempotencyKeyReused:
+			return nil, status.Errorf(codes.AlreadyExists, "cannot create transfer: %v", err)
+		case db.ErrInsufficientFunds, db.ErrAccountFrozen, db.ErrAccountClosed:
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot create transfer: %v", err)
+		case db.ErrConvertedAmountTooSmall:
+			return nil, status.Errorf(codes.InvalidArgument, "cannot create transfer: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "cannot create transfer: %v", err)
+	}
+
+	res := fromDBTransferTxResultToPbCreateTransferResponse(result)
+	return res, nil
+}
+
+// transferLimitError reports the breached limit and its remaining allowance in the metadata of an ErrorInfo detail
+func transferLimitError(limitErr *db.TransferLimitError) error {
+	metadata := map[string]string{
+		"limit":     string(limitErr.Limit),
+		"max":       strconv.FormatInt(limitErr.Max, 10),
+		"remaining": strconv.FormatInt(limitErr.Remaining, 10),
+	}
+	if limitErr.ResetsAt != nil {
+		metadata["resets_at"] = limitErr.ResetsAt.Format(time.RFC3339)
+	}
+
+	return errorWithInfo(codes.ResourceExhausted, limitErr, "TRANSFER_LIMIT_EXCEEDED", metadata)
+}
+
+// riskDeniedError reports the risk rule that denied the transfer and why in the metadata of an ErrorInfo detail
+func riskDeniedError(deniedErr *db.RiskDeniedError) error {
+	return errorWithInfo(codes.PermissionDenied, deniedErr, "TRANSFER_DENIED", map[string]string{
+		"rule":   deniedErr.Rule,
+		"reason": deniedErr.Reason,
+	})
+}
+
+func errorWithInfo(code codes.Code, err error, reason string, metadata map[string]string) error {
+	st := status.Newf(code, "cannot create transfer: %v", err)
+	detailed, detailsErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   "gobank",
+		Metadata: metadata,
+	})
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// validateTransfer applies the same rules as the HTTP api before moving money between two accounts
+func (server *GRPCServer) validateTransfer(ctx context.Context, fromAccountID, toAccountID int64) (from db.Account, to db.Account, err error) {
+	if fromAccountID == toAccountID {
+		err = status.Errorf(codes.InvalidArgument, "can't transfer to the same account, from_account_id=%d, to_account_id=%d", fromAccountID, toAccountID)
+		return
+	}
+
+	from, err = server.getAccount(ctx, fromAccountID)
+	if err != nil {
+		return
+	}
+
+	to, err = server.getAccount(ctx, toAccountID)
+	if err != nil {
+		return
+	}
+
+	if from.IsDeleted {
+		err = status.Errorf(codes.FailedPrecondition, "account %d is deleted", from.ID)
+		return
+	}
+
+	if to.IsDeleted {
+		err = status.Errorf(codes.FailedPrecondition, "account %d is deleted", to.ID)
+		return
+	}
+
+	// TransferTx checks the statuses again, in case they change before the transfer is made
+	if err = from.CanDebit(); err != nil {
+		err = status.Errorf(codes.FailedPrecondition, "account %d: %v", from.ID, err)
+		return
+	}
+
+	if err = to.CanCredit(); err != nil {
+		err = status.Errorf(codes.FailedPrecondition, "account %d: %v", to.ID, err)
+		return
+	}
+
+	return
+}
+
+// transferRate gets the rate converting the currency of from into the one of to, nil when they are the same
+func (server *GRPCServer) transferRate(from, to db.Account) (*fx.Rate, error) {
+	if from.Currency == to.Currency {
+		return nil, nil
+	}
+
+	rate, err := server.rates.Rate(from.Currency, to.Currency)
+	if err != nil {
+		if errors.Is(err, fx.ErrRateNotFound) {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot convert %s to %s: %v", from.Currency, to.Currency, err)
+		}
+		return nil, status.Errorf(codes.Internal, "cannot get exchange rate: %v", err)
+	}
+	return &rate, nil
+}
+
+func (server *GRPCServer) QuoteTransfer(ctx context.Context, req *pb.QuoteTransferRequest) (*pb.TransferQuoteResponse, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if req.GetAmount() < 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "amount must be positive, provided: %d", req.GetAmount())
+	}
+
+	fromAccount, toAccount, err := server.validateTransfer(ctx, req.GetFromAccountId(), req.GetToAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	if fromAccount.Owner != payload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "account %d doesn't belong to authenticated user", fromAccount.ID)
+	}
+
+	rate, err := server.transferRate(fromAccount, toAccount)
+	if err != nil {
+		return nil, err
+	}
+	if rate == nil {
+		rate = &fx.Rate{From: fromAccount.Currency, To: toAccount.Currency, Value: fx.RateScale, UpdatedAt: time.Now()}
+	}
+
+	toAmount := rate.Convert(req.GetAmount())
+	if toAmount < 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot quote transfer: %v", db.ErrConvertedAmountTooSmall)
+	}
+
+	fee, err := server.db.QuoteTransferFee(ctx, fromAccount, toAccount, req.GetAmount())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot quote transfer fee: %v", err)
+	}
+
+	return &pb.TransferQuoteResponse{
+		FromAccountId: fromAccount.ID,
+		ToAccountId:   toAccount.ID,
+		Currency:      fromAccount.Currency,
+		Amount:        req.GetAmount(),
+		Fee:           fee.Amount,
+		Total:         req.GetAmount() + fee.Amount,
+		ToCurrency:    toAccount.Currency,
+		ToAmount:      toAmount,
+		FxRate:        rate.Float64(),
+		FxRateAt:      timestamppb.New(rate.UpdatedAt),
+	}, nil
+}
+
+func (server *GRPCServer) RefundTransfer(ctx context.Context, req *pb.ReverseTransferRequest) (*pb.ReverseTransferResponse, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+	ctx = server.withActor(ctx, payload.Username, payload.SessionID)
+
+	if req.GetAmount() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "amount must not be negative, provided: %d", req.GetAmount())
+	}
+
+	transfer, err := server.db.GetTransfer(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "transfer %d not found", req.GetId())
+		}
+		return nil, status.Errorf(codes.Internal, "cannot get transfer %d: %v", req.GetId(), err)
+	}
+
+	// Only the recipient can refund the transfer
+	if _, err = server.getOwnedAccount(ctx, payload, transfer.ToAccountID); err != nil {
+		return nil, err
+	}
+
+	if err = transfer.CanBeRefunded(time.Now(), server.refundWindow); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot refund transfer %d: %v", transfer.ID, err)
+	}
+
+	return server.reverseTransfer(ctx, transfer.ID, req.GetAmount())
+}
+
+// reverseTransfer gives amount of the transfer back to its sender, all that is left when amount is 0
+func (server *GRPCServer) reverseTransfer(ctx context.Context, id, amount int64) (*pb.ReverseTransferResponse, error) {
+	result, err := server.db.ReverseTransferTx(ctx, db.ReverseTransferTxParam{
+		TransferID: id,
+		Amount:     amount,
+	})
+
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return nil, status.Errorf(codes.NotFound, "transfer %d not found", id)
+		case db.ErrTransferReversed:
+			return nil, status.Errorf(codes.AlreadyExists, "cannot reverse transfer %d: %v", id, err)
+		case db.ErrInsufficientFunds, db.ErrAccountFrozen, db.ErrAccountClosed, db.ErrTransferNotCompleted:
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot reverse transfer %d: %v", id, err)
+		case db.ErrTransferIsReversal, db.ErrReversalExceedsTransfer, db.ErrConvertedAmountTooSmall:
+			return nil, status.Errorf(codes.InvalidArgument, "cannot reverse transfer %d: %v", id, err)
+		}
+		return nil, status.Errorf(codes.Internal, "cannot reverse transfer %d: %v", id, err)
+	}
+
+	return fromDBReverseTransferTxResultToPbReverseTransferResponse(result), nil
+}
+
+func (server *GRPCServer) ListTransfers(ctx context.Context, req *pb.ListTransfersRequest) (*pb.ListTransfersResponse, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	cursor, pageSize, err := server.parsePage(req.GetPageSize(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := server.getOwnedAccount(ctx, payload, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	transfers, err := server.db.ListTransfers(ctx, db.ListTransfersParams{
+		AccountID:      account.ID,
+		AfterCreatedAt: cursor.CreatedAt,
+		AfterID:        cursor.ID,
+		PageSize:       pageSize + 1,
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list transfers: %v", err)
+	}
+
+	res := &pb.ListTransfersResponse{}
+	transfers, res.NextCursor = util.Paginate(transfers, pageSize, func(transfer db.Transfer) util.Cursor {
+		return util.Cursor{CreatedAt: transfer.CreatedAt, ID: transfer.ID}
+	})
+
+	for _, transfer := range transfers {
+		res.Transfers = append(res.Transfers, fromDBTransferToPbTransferResponse(transfer))
+	}
+	return res, nil
+}
+
+func (server *GRPCServer) SearchTransfers(ctx context.Context, req *pb.SearchTransfersRequest) (*pb.ListTransfersResponse, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	cursor, pageSize, err := server.parsePage(req.GetPageSize(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetOrder() != "" && req.GetOrder() != "asc" && req.GetOrder() != "desc" {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown order %q, expected asc or desc", req.GetOrder())
+	}
+
+	account, err := server.getOwnedAccount(ctx, payload, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.SearchTransfersParam{
+		AccountID:      account.ID,
+		Direction:      db.TransferDirection(req.GetDirection()),
+		CounterpartyID: sql.NullInt64{Int64: req.GetCounterpartyAccountId(), Valid: req.GetCounterpartyAccountId() != 0},
+		MinAmount:      sql.NullInt64{Int64: req.GetMinAmount(), Valid: req.GetMinAmount() != 0},
+		MaxAmount:      sql.NullInt64{Int64: req.GetMaxAmount(), Valid: req.GetMaxAmount() != 0},
+		From:           sql.NullTime{Time: req.GetFrom().AsTime(), Valid: req.GetFrom() != nil},
+		To:             sql.NullTime{Time: req.GetTo().AsTime(), Valid: req.GetTo() != nil},
+		Sort:           db.TransferSort(req.GetSort()),
+		Descending:     req.GetOrder() == "desc",
+		After:          cursor,
+		PageSize:       pageSize + 1,
+	}
+
+	transfers, err := server.db.SearchTransfers(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidTransferSearch) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "cannot search transfers: %v", err)
+	}
+
+	res := &pb.ListTransfersResponse{}
+	transfers, res.NextCursor = util.Paginate(transfers, pageSize, arg.NextCursor)
+
+	for _, transfer := range transfers {
+		res.Transfers = append(res.Transfers, fromDBTransferToPbTransferResponse(transfer))
+	}
+	return res, nil
+}
+
+// parsePage applies the same page_size and cursor rules as the HTTP api
+func (server *GRPCServer) parsePage(requestedPageSize int32, token string) (util.Cursor, int32, error) {
+	pageSize, err := util.PageSize(requestedPageSize, server.maxPageSize)
+	if err != nil {
+		return util.Cursor{}, 0, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	cursor, err := util.DecodeCursor(token)
+	if err != nil {
+		return util.Cursor{}, 0, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return cursor, pageSize, nil
+}
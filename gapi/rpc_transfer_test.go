@@ -0,0 +1,355 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	mockdb "github.com/escalopa/gobank/db/mock"
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/grpc/pb"
+	"github.com/escalopa/gobank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCreateTransferRPC(t *testing.T) {
+	username := util.RandomOwner()
+	from := createRandomAccount(username)
+	to := createRandomAccount(util.RandomOwner())
+	to.ID = from.ID + 1
+	to.Currency = from.Currency
+
+	var amount int64 = 10
+	arg := db.TransferTxParam{FromAccountID: from.ID, ToAccountID: to.ID, Amount: amount}
+	result := db.TransferTxResult{
+		Transfer:    db.Transfer{ID: 1, FromAccountID: from.ID, ToAccountID: to.ID, Amount: amount},
+		FromAccount: from,
+		FromEntry:   db.Entry{ID: 1, AccountID: from.ID, Amount: -amount},
+	}
+
+	// getAccounts stubs the lookups of both accounts of the transfer
+	getAccounts := func(store *mockdb.MockStore, from, to db.Account) {
+		store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(from.ID)).Times(1).Return(from, nil)
+		store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(to.ID)).Times(1).Return(to, nil)
+	}
+
+	testCases := []struct {
+		req *pb.CreateTransferRequest
+		rpcTestCase
+	}{
+		{
+			req: &pb.CreateTransferRequest{FromAccountId: from.ID, ToAccountId: to.ID, Amount: amount},
+			rpcTestCase: rpcTestCase{
+				name: "OK",
+				buildStubs: func(store *mockdb.MockStore) {
+					getAccounts(store, from, to)
+					store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(result, nil)
+				},
+				buildCtx: callerContext(username),
+				code:     codes.OK,
+			},
+		},
+		{
+			req: &pb.CreateTransferRequest{FromAccountId: from.ID, ToAccountId: to.ID, Amount: amount},
+			rpcTestCase: rpcTestCase{
+				name: "NotAccountOwner",
+				buildStubs: func(store *mockdb.MockStore) {
+					getAccounts(store, from, to)
+					store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				buildCtx: callerContext(to.Owner),
+				code:     codes.PermissionDenied,
+			},
+		},
+		{
+			req: &pb.CreateTransferRequest{FromAccountId: from.ID, ToAccountId: to.ID, Amount: amount},
+			rpcTestCase: rpcTestCase{
+				name: "CurrencyMismatch",
+				buildStubs: func(store *mockdb.MockStore) {
+					// No exchange rates are configured, so only same currency transfers are allowed
+					to := to
+					to.Currency = util.USD
+					if from.Currency == util.USD {
+						to.Currency = util.EGP
+					}
+					getAccounts(store, from, to)
+					store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				buildCtx: callerContext(username),
+				code:     codes.InvalidArgument,
+			},
+		},
+		{
+			req: &pb.CreateTransferRequest{FromAccountId: from.ID, ToAccountId: to.ID, Amount: amount},
+			rpcTestCase: rpcTestCase{
+				name: "FromAccountDeleted",
+				buildStubs: func(store *mockdb.MockStore) {
+					from := from
+					from.IsDeleted = true
+					getAccounts(store, from, to)
+					store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				buildCtx: callerContext(username),
+				code:     codes.FailedPrecondition,
+			},
+		},
+		{
+			req: &pb.CreateTransferRequest{FromAccountId: from.ID, ToAccountId: to.ID, Amount: amount},
+			rpcTestCase: rpcTestCase{
+				name: "ToAccountDeleted",
+				buildStubs: func(store *mockdb.MockStore) {
+					to := to
+					to.IsDeleted = true
+					getAccounts(store, from, to)
+					store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				buildCtx: callerContext(username),
+				code:     codes.FailedPrecondition,
+			},
+		},
+		{
+			req: &pb.CreateTransferRequest{FromAccountId: from.ID, ToAccountId: to.ID, Amount: amount},
+			rpcTestCase: rpcTestCase{
+				name: "FromAccountFrozen",
+				buildStubs: func(store *mockdb.MockStore) {
+					from := from
+					from.Status = db.AccountStatusFrozen
+					getAccounts(store, from, to)
+					store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				buildCtx: callerContext(username),
+				code:     codes.FailedPrecondition,
+			},
+		},
+		{
+			req: &pb.CreateTransferRequest{FromAccountId: from.ID, ToAccountId: to.ID, Amount: amount},
+			rpcTestCase: rpcTestCase{
+				name: "ToAccountClosed",
+				buildStubs: func(store *mockdb.MockStore) {
+					to := to
+					to.Status = db.AccountStatusClosed
+					getAccounts(store, from, to)
+					store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				buildCtx: callerContext(username),
+				code:     codes.FailedPrecondition,
+			},
+		},
+		{
+			req: &pb.CreateTransferRequest{FromAccountId: from.ID, ToAccountId: to.ID, Amount: amount},
+			rpcTestCase: rpcTestCase{
+				name: "FrozenMeanwhile",
+				buildStubs: func(store *mockdb.MockStore) {
+					getAccounts(store, from, to)
+					store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(1).Return(db.TransferTxResult{}, db.ErrAccountFrozen)
+				},
+				buildCtx: callerContext(username),
+				code:     codes.FailedPrecondition,
+			},
+		},
+		{
+			req: &pb.CreateTransferRequest{FromAccountId: from.ID, ToAccountId: to.ID, Amount: amount},
+			rpcTestCase: rpcTestCase{
+				name: "FromAccountNotFound",
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(from.ID)).Times(1).Return(db.Account{}, sql.ErrNoRows)
+					store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				buildCtx: callerContext(username),
+				code:     codes.NotFound,
+			},
+		},
+		{
+			req: &pb.CreateTransferRequest{FromAccountId: from.ID, ToAccountId: to.ID, Amount: amount},
+			rpcTestCase: rpcTestCase{
+				name: "ToAccountNotFound",
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(from.ID)).Times(1).Return(from, nil)
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(to.ID)).Times(1).Return(db.Account{}, sql.ErrNoRows)
+					store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				buildCtx: callerContext(username),
+				code:     codes.NotFound,
+			},
+		},
+		{
+			req: &pb.CreateTransferRequest{FromAccountId: from.ID, ToAccountId: from.ID, Amount: amount},
+			rpcTestCase: rpcTestCase{
+				name: "SameAccount",
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				},
+				buildCtx: callerContext(username),
+				code:     codes.InvalidArgument,
+			},
+		},
+		{
+			req: &pb.CreateTransferRequest{FromAccountId: from.ID, ToAccountId: to.ID},
+			rpcTestCase: rpcTestCase{
+				name: "NoAmount",
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				},
+				buildCtx: callerContext(username),
+				code:     codes.InvalidArgument,
+			},
+		},
+		{
+			req: &pb.CreateTransferRequest{FromAccountId: from.ID, ToAccountId: to.ID, Amount: amount},
+			rpcTestCase: rpcTestCase{
+				name: "InsufficientFunds",
+				buildStubs: func(store *mockdb.MockStore) {
+					getAccounts(store, from, to)
+					store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(1).Return(db.TransferTxResult{}, db.ErrInsufficientFunds)
+				},
+				buildCtx: callerContext(username),
+				code:     codes.FailedPrecondition,
+			},
+		},
+		{
+			req: &pb.CreateTransferRequest{FromAccountId: from.ID, ToAccountId: to.ID, Amount: amount},
+			rpcTestCase: rpcTestCase{
+				name: "InternalError",
+				buildStubs: func(store *mockdb.MockStore) {
+					getAccounts(store, from, to)
+					store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(1).Return(db.TransferTxResult{}, sql.ErrConnDone)
+				},
+				buildCtx: callerContext(username),
+				code:     codes.Internal,
+			},
+		},
+		{
+			req: &pb.CreateTransferRequest{FromAccountId: from.ID, ToAccountId: to.ID, Amount: amount},
+			rpcTestCase: rpcTestCase{
+				name: "NoAuthorization",
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+					store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+				},
+				buildCtx: noAuthorization,
+				code:     codes.Unauthenticated,
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			runRPCTest(t, tc.rpcTestCase, func(t *testing.T, server *GRPCServer, ctx context.Context) error {
+				res, err := server.CreateTransfer(ctx, tc.req)
+				if err == nil {
+					require.Equal(t, fromDBTransferTxResultToPbCreateTransferResponse(result), res)
+				}
+				return err
+			})
+		})
+	}
+}
+
+func TestListTransfersRPC(t *testing.T) {
+	username := util.RandomOwner()
+	account := createRandomAccount(username)
+
+	transfers := []db.Transfer{
+		{ID: 2, FromAccountID: account.ID, ToAccountID: account.ID + 1, Amount: 10},
+		{ID: 1, FromAccountID: account.ID + 1, ToAccountID: account.ID, Amount: 20},
+	}
+
+	testCases := []struct {
+		req *pb.ListTransfersRequest
+		rpcTestCase
+	}{
+		{
+			req: &pb.ListTransfersRequest{AccountId: account.ID, PageSize: 5},
+			rpcTestCase: rpcTestCase{
+				name: "OK",
+				buildStubs: func(store *mockdb.MockStore) {
+					arg := db.ListTransfersParams{AccountID: account.ID, PageSize: 6}
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+					store.EXPECT().ListTransfers(gomock.Any(), gomock.Eq(arg)).Times(1).Return(transfers, nil)
+				},
+				buildCtx: callerContext(username),
+				code:     codes.OK,
+			},
+		},
+		{
+			req: &pb.ListTransfersRequest{AccountId: account.ID},
+			rpcTestCase: rpcTestCase{
+				name: "NotAccountOwner",
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+					store.EXPECT().ListTransfers(gomock.Any(), gomock.Any()).Times(0)
+				},
+				buildCtx: callerContext(util.RandomOwner()),
+				code:     codes.PermissionDenied,
+			},
+		},
+		{
+			req: &pb.ListTransfersRequest{AccountId: account.ID},
+			rpcTestCase: rpcTestCase{
+				name: "NotFound",
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(db.Account{}, sql.ErrNoRows)
+					store.EXPECT().ListTransfers(gomock.Any(), gomock.Any()).Times(0)
+				},
+				buildCtx: callerContext(username),
+				code:     codes.NotFound,
+			},
+		},
+		{
+			req: &pb.ListTransfersRequest{AccountId: account.ID, Cursor: "not-a-cursor"},
+			rpcTestCase: rpcTestCase{
+				name: "InvalidCursor",
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				},
+				buildCtx: callerContext(username),
+				code:     codes.InvalidArgument,
+			},
+		},
+		{
+			req: &pb.ListTransfersRequest{AccountId: account.ID},
+			rpcTestCase: rpcTestCase{
+				name: "InternalError",
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+					store.EXPECT().ListTransfers(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				},
+				buildCtx: callerContext(username),
+				code:     codes.Internal,
+			},
+		},
+		{
+			req: &pb.ListTransfersRequest{AccountId: account.ID},
+			rpcTestCase: rpcTestCase{
+				name: "NoAuthorization",
+				buildStubs: func(store *mockdb.MockStore) {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				},
+				buildCtx: noAuthorization,
+				code:     codes.Unauthenticated,
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			runRPCTest(t, tc.rpcTestCase, func(t *testing.T, server *GRPCServer, ctx context.Context) error {
+				res, err := server.ListTransfers(ctx, tc.req)
+				if err == nil {
+					require.Len(t, res.GetTransfers(), len(transfers))
+					require.Empty(t, res.GetNextCursor())
+					for i, transfer := range transfers {
+						require.Equal(t, fromDBTransferToPbTransferResponse(transfer), res.GetTransfers()[i])
+					}
+				}
+				return err
+			})
+		})
+	}
+}
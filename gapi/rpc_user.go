@@ -7,7 +7,10 @@ import (
 
 	db "github.com/escalopa/gobank/db/sqlc"
 	"github.com/escalopa/gobank/grpc/pb"
+	"github.com/escalopa/gobank/session"
 	"github.com/escalopa/gobank/util"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/uuid"
 	"github.com/lib/pq"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -21,26 +24,31 @@ func (server *GRPCServer) Login(ctx context.Context, req *pb.LoginRequest) (*pb.
 		return nil, err
 	}
 
+	if user.IsDeleted {
+		return nil, status.Errorf(codes.NotFound, "user %s is deleted", req.GetUsername())
+	}
+
 	// Check User's Password
 	err = util.CheckHashedPassword(user.HashedPassword, req.GetPassword())
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "incorrect password")
 	}
 
-	// Generate New Access Token for User
-	accessToken, accessPayload, err := server.tm.CreateToken(user.Username)
+	// Generate New Refresh Token for User
+	refreshToken, refreshPayload, err := server.tm.CreateRefreshToken(user.Username, string(user.Role))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "cannot create access token: %v", err)
+		return nil, status.Errorf(codes.Internal, "cannot create refresh token: %v", err)
 	}
 
-	// Generate New Access Token for User
-	refreshToken, refreshPayload, err := server.tm.CreateRefreshToken(user.Username)
+	// Generate New Access Token for User, for the session of the refresh token
+	accessToken, accessPayload, err := server.tm.CreateToken(user.Username, string(user.Role), refreshPayload.ID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "cannot create refresh token: %v", err)
+		return nil, status.Errorf(codes.Internal, "cannot create access token: %v", err)
 	}
 
 	// Create new session for User
 	md := server.extractMetadata(ctx)
+	ctx = server.withActor(ctx, user.Username, refreshPayload.ID)
 	session, err := server.db.CreateSession(ctx, db.CreateSessionParams{
 		ID:           refreshPayload.ID,
 		Username:     req.GetUsername(),
@@ -48,6 +56,8 @@ func (server *GRPCServer) Login(ctx context.Context, req *pb.LoginRequest) (*pb.
 		UserAgent:    md.UserAgent,
 		ClientIp:     md.ClientIP,
 		ExpiresAt:    refreshPayload.ExpireAt,
+		// Every login starts a new family of sessions
+		FamilyID: refreshPayload.ID,
 	})
 
 	if err != nil {
@@ -65,12 +75,63 @@ func (server *GRPCServer) Login(ctx context.Context, req *pb.LoginRequest) (*pb.
 	return res, nil
 }
 
+// Logout blocks the session of the access token of the call, along with the sessions renewed from the same login.
+// RevokeSession revokes another session
+func (server *GRPCServer) Logout(ctx context.Context, _ *pb.LogoutRequest) (*empty.Empty, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+	ctx = server.withActor(ctx, payload.Username, payload.SessionID)
+
+	// authenticateUser already checked that the session exists
+	session, err := server.db.GetSession(ctx, payload.SessionID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot get session %s: %v", payload.SessionID, err)
+	}
+
+	err = server.db.BlockSessionFamily(ctx, session.FamilyID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot block session %s: %v", session.ID, err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (server *GRPCServer) RenewAccessToken(ctx context.Context, req *pb.RenewAccessTokenRequest) (*pb.RenewAccessTokenResponse, error) {
+	md := server.extractMetadata(ctx)
+	renewal, err := session.Renew(ctx, server.db, server.tm, req.GetRefreshToken(), session.Client{
+		UserAgent: md.UserAgent,
+		ClientIP:  md.ClientIP,
+	})
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "session not found")
+		}
+		if session.IsUnauthorized(err) {
+			return nil, unauthenticatedError(err)
+		}
+		return nil, status.Errorf(codes.Internal, "cannot renew access token: %v", err)
+	}
+
+	res := &pb.RenewAccessTokenResponse{
+		SessionId:             renewal.SessionID.String(),
+		AccessToken:           renewal.AccessToken,
+		RefreshToken:          renewal.RefreshToken,
+		AccessTokenExpiresAt:  timestamppb.New(renewal.AccessPayload.ExpireAt),
+		RefreshTokenExpiresAt: timestamppb.New(renewal.RefreshPayload.ExpireAt),
+	}
+	return res, nil
+}
+
 func (server *GRPCServer) CreateUser(ctx context.Context, req *pb.UserRequest) (*pb.UserResponse, error) {
 	hashPassword, err := util.GenerateHashPassword(req.Password)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "cannot hash password")
 	}
 
+	ctx = server.withActor(ctx, req.GetUsername(), uuid.Nil)
 	user, err := server.db.CreateUser(ctx, db.CreateUserParams{
 		Username:       req.GetUsername(),
 		HashedPassword: hashPassword,
@@ -111,6 +172,28 @@ func (server *GRPCServer) GetUser(ctx context.Context, req *pb.Username) (*pb.Us
 	return res, nil
 }
 
+func (server *GRPCServer) DeleteUser(ctx context.Context, req *pb.Username) (*empty.Empty, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+	ctx = server.withActor(ctx, payload.Username, payload.SessionID)
+
+	if req.GetUsername() != payload.Username {
+		return nil, status.Error(codes.Unauthenticated, "requested username doesn't match the provided in token")
+	}
+
+	err = server.db.DeleteUserTx(ctx, payload.Username)
+	if err != nil {
+		if err == db.ErrNonZeroBalance {
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot delete user: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "cannot delete user: %v", err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
 func (server *GRPCServer) getUser(ctx context.Context, username string) (db.User, error) {
 	user, err := server.db.GetUser(ctx, username)
 	if err != nil {
@@ -128,6 +211,7 @@ func (server *GRPCServer) UpdateUser(ctx context.Context, req *pb.UserUpdateRequ
 	if err != nil {
 		return nil, unauthenticatedError(err)
 	}
+	ctx = server.withActor(ctx, payload.Username, payload.SessionID)
 
 	// Get user from database
 	user, err := server.getUser(ctx, payload.Username)
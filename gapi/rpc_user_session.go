@@ -0,0 +1,90 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/grpc/pb"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *GRPCServer) ListSessions(ctx context.Context, _ *empty.Empty) (*pb.ListSessionsResponse, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	sessions, err := server.db.ListActiveUserSessions(ctx, payload.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list sessions: %v", err)
+	}
+
+	res := &pb.ListSessionsResponse{Sessions: []*pb.SessionResponse{}}
+	for _, session := range sessions {
+		res.Sessions = append(res.Sessions, fromDBSessionToPbSessionResponse(session, payload.SessionID))
+	}
+	return res, nil
+}
+
+// RevokeSession blocks the session along with the sessions renewed from the same login
+func (server *GRPCServer) RevokeSession(ctx context.Context, req *pb.SessionID) (*empty.Empty, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+	ctx = server.withActor(ctx, payload.Username, payload.SessionID)
+
+	sessionID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+
+	session, err := server.db.GetSession(ctx, sessionID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "session %s not found", sessionID)
+		}
+		return nil, status.Errorf(codes.Internal, "cannot get session %s: %v", sessionID, err)
+	}
+
+	if session.Username != payload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "session %s doesn't belong to authenticated user", sessionID)
+	}
+
+	err = server.db.BlockSessionFamily(ctx, session.FamilyID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot revoke session %s: %v", sessionID, err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// RevokeOtherSessions blocks every session of the user but the one of the access token of the call
+func (server *GRPCServer) RevokeOtherSessions(ctx context.Context, _ *empty.Empty) (*empty.Empty, error) {
+	payload, err := server.authenticateUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+	ctx = server.withActor(ctx, payload.Username, payload.SessionID)
+
+	// authenticateUser already checked that the session exists
+	current, err := server.db.GetSession(ctx, payload.SessionID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot get session %s: %v", payload.SessionID, err)
+	}
+
+	err = server.db.BlockOtherUserSessions(ctx, db.BlockOtherUserSessionsParams{
+		Username: payload.Username,
+		FamilyID: current.FamilyID,
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot revoke sessions: %v", err)
+	}
+
+	return &empty.Empty{}, nil
+}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"time"
 
 	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/fx"
 	"github.com/escalopa/gobank/grpc/pb"
 	"github.com/escalopa/gobank/token"
 	"github.com/escalopa/gobank/util"
@@ -17,22 +19,60 @@ type GRPCServer struct {
 	config *util.Config
 	db     db.Store
 	tm     token.Maker
+	rates  fx.RateProvider
 	pb.UnimplementedBankServiceServer
+
+	idempotencyRetention time.Duration
+	// refundWindow is how long after a transfer its recipient can refund it
+	refundWindow time.Duration
+	// startingBalance is credited to every new account
+	startingBalance int64
+	// maxPageSize caps the page_size of list requests
+	maxPageSize int32
 }
 
 func NewServer(config *util.Config, store db.Store) (*GRPCServer, error) {
-	maker, err := token.NewPasetoMaker(config.Get("SYMMETRIC_KEY"))
+	maker, err := token.NewMaker(config)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create tokenMaker for grpcServer, %w", err)
 	}
 
-	grpcServer := &GRPCServer{config: config, tm: maker, db: store}
+	retention, err := config.GetDuration("IDEMPOTENCY_KEY_RETENTION", 24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	refundWindow, err := config.GetDuration("TRANSFER_REFUND_WINDOW", 7*24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	startingBalance, err := config.GetInt64("ACCOUNT_STARTING_BALANCE", 0)
+	if err != nil {
+		return nil, err
+	}
+	if startingBalance < 0 {
+		return nil, fmt.Errorf("ACCOUNT_STARTING_BALANCE must not be negative, provided: %d", startingBalance)
+	}
+
+	maxPageSize, err := util.LoadMaxPageSize(config)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := fx.NewProvider(config)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create rate provider for grpcServer, %w", err)
+	}
+
+	grpcServer := &GRPCServer{config: config, tm: maker, db: store, rates: rates, idempotencyRetention: retention, refundWindow: refundWindow, startingBalance: startingBalance, maxPageSize: maxPageSize}
 	return grpcServer, nil
 }
 
 func (server *GRPCServer) Start(address string) error {
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(server.authorizationInterceptor))
 	pb.RegisterBankServiceServer(grpcServer, server)
+	pb.RegisterAdminServiceServer(grpcServer, &adminServer{server: server})
 	reflection.Register(grpcServer)
 
 	listener, err := net.Listen("tcp", address)
@@ -5,10 +5,12 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"strings"
 
 	db "github.com/escalopa/gobank/db/sqlc"
 	"github.com/escalopa/gobank/gapi"
 	"github.com/escalopa/gobank/grpc/pb"
+	"github.com/escalopa/gobank/risk"
 	"github.com/escalopa/gobank/util"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -20,7 +22,12 @@ func main() {
 
 	// Initialize the database
 	conn := db.InitDatabase(config)
-	store := db.NewStore(conn)
+
+	rules, err := risk.NewRules(config)
+	if err != nil {
+		log.Fatalf("cannot create risk rules, err: %s", err)
+	}
+	store := db.NewAuditedStore(conn, db.WithRiskRules(rules...))
 
 	grpcServer, err := gapi.NewServer(config, store)
 	if err != nil {
@@ -36,7 +43,7 @@ func main() {
 		},
 	})
 
-	grpcMux := runtime.NewServeMux(jsonOpts)
+	grpcMux := runtime.NewServeMux(jsonOpts, runtime.WithIncomingHeaderMatcher(headerMatcher))
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -61,6 +68,14 @@ func main() {
 	}
 }
 
+// headerMatcher forwards the idempotency key besides the default headers
+func headerMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, gapi.IdempotencyKeyHeader) {
+		return gapi.IdempotencyKeyHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 func setupSwagger(mux *http.ServeMux, config *util.Config) {
 	dir := config.Get("SWAGGER_DIRECTORY")
 	if dir == "" {
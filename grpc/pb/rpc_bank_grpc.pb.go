@@ -26,11 +26,43 @@ type BankServiceClient interface {
 	// Auth gRPC calls
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	RenewAccessToken(ctx context.Context, in *RenewAccessTokenRequest, opts ...grpc.CallOption) (*RenewAccessTokenResponse, error)
+	// Session gRPC calls
+	ListSessions(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, in *SessionID, opts ...grpc.CallOption) (*empty.Empty, error)
+	RevokeOtherSessions(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
 	// User gRPC calls
 	CreateUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*UserResponse, error)
 	GetUser(ctx context.Context, in *Username, opts ...grpc.CallOption) (*UserResponse, error)
 	UpdateUser(ctx context.Context, in *UserUpdateRequest, opts ...grpc.CallOption) (*UserResponse, error)
 	DeleteUser(ctx context.Context, in *Username, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Account gRPC calls
+	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*AccountResponse, error)
+	GetAccount(ctx context.Context, in *AccountID, opts ...grpc.CallOption) (*AccountResponse, error)
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	DeleteAccount(ctx context.Context, in *AccountID, opts ...grpc.CallOption) (*empty.Empty, error)
+	RestoreAccount(ctx context.Context, in *AccountID, opts ...grpc.CallOption) (*AccountResponse, error)
+	CloseAccount(ctx context.Context, in *CloseAccountRequest, opts ...grpc.CallOption) (*CloseAccountResponse, error)
+	// Only tellers and admins can deposit into or withdraw from an account
+	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*EntryTxResponse, error)
+	Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*EntryTxResponse, error)
+	ListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error)
+	GetAccountStatement(ctx context.Context, in *AccountStatementRequest, opts ...grpc.CallOption) (*AccountStatementResponse, error)
+	// Transfer gRPC calls
+	CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*CreateTransferResponse, error)
+	// The fee and the converted amount of a transfer, without making it
+	QuoteTransfer(ctx context.Context, in *QuoteTransferRequest, opts ...grpc.CallOption) (*TransferQuoteResponse, error)
+	// Only the recipient can refund a transfer, within TRANSFER_REFUND_WINDOW after it was made
+	RefundTransfer(ctx context.Context, in *ReverseTransferRequest, opts ...grpc.CallOption) (*ReverseTransferResponse, error)
+	ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error)
+	SearchTransfers(ctx context.Context, in *SearchTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error)
+	// Scheduled transfer gRPC calls
+	CreateScheduledTransfer(ctx context.Context, in *CreateScheduledTransferRequest, opts ...grpc.CallOption) (*ScheduledTransferResponse, error)
+	GetScheduledTransfer(ctx context.Context, in *ScheduledTransferID, opts ...grpc.CallOption) (*ScheduledTransferResponse, error)
+	ListScheduledTransfers(ctx context.Context, in *ListScheduledTransfersRequest, opts ...grpc.CallOption) (*ListScheduledTransfersResponse, error)
+	UpdateScheduledTransfer(ctx context.Context, in *UpdateScheduledTransferRequest, opts ...grpc.CallOption) (*ScheduledTransferResponse, error)
+	CancelScheduledTransfer(ctx context.Context, in *ScheduledTransferID, opts ...grpc.CallOption) (*ScheduledTransferResponse, error)
+	ListScheduledTransferAttempts(ctx context.Context, in *ListScheduledTransferAttemptsRequest, opts ...grpc.CallOption) (*ListScheduledTransferAttemptsResponse, error)
 }
 
 type bankServiceClient struct {
@@ -59,6 +91,42 @@ func (c *bankServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts
 	return out, nil
 }
 
+func (c *bankServiceClient) RenewAccessToken(ctx context.Context, in *RenewAccessTokenRequest, opts ...grpc.CallOption) (*RenewAccessTokenResponse, error) {
+	out := new(RenewAccessTokenResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankService/RenewAccessToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) ListSessions(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankService/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) RevokeSession(ctx context.Context, in *SessionID, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.BankService/RevokeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) RevokeOtherSessions(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.BankService/RevokeOtherSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bankServiceClient) CreateUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*UserResponse, error) {
 	out := new(UserResponse)
 	err := c.cc.Invoke(ctx, "/pb.BankService/CreateUser", in, out, opts...)
@@ -95,6 +163,195 @@ func (c *bankServiceClient) DeleteUser(ctx context.Context, in *Username, opts .
 	return out, nil
 }
 
+func (c *bankServiceClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*AccountResponse, error) {
+	out := new(AccountResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankService/CreateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) GetAccount(ctx context.Context, in *AccountID, opts ...grpc.CallOption) (*AccountResponse, error) {
+	out := new(AccountResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankService/GetAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	out := new(ListAccountsResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankService/ListAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) DeleteAccount(ctx context.Context, in *AccountID, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.BankService/DeleteAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) RestoreAccount(ctx context.Context, in *AccountID, opts ...grpc.CallOption) (*AccountResponse, error) {
+	out := new(AccountResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankService/RestoreAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) CloseAccount(ctx context.Context, in *CloseAccountRequest, opts ...grpc.CallOption) (*CloseAccountResponse, error) {
+	out := new(CloseAccountResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankService/CloseAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*EntryTxResponse, error) {
+	out := new(EntryTxResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankService/Deposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*EntryTxResponse, error) {
+	out := new(EntryTxResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankService/Withdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) ListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error) {
+	out := new(ListEntriesResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankService/ListEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) GetAccountStatement(ctx context.Context, in *AccountStatementRequest, opts ...grpc.CallOption) (*AccountStatementResponse, error) {
+	out := new(AccountStatementResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankService/GetAccountStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*CreateTransferResponse, error) {
+	out := new(CreateTransferResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankService/CreateTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) QuoteTransfer(ctx context.Context, in *QuoteTransferRequest, opts ...grpc.CallOption) (*TransferQuoteResponse, error) {
+	out := new(TransferQuoteResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankService/QuoteTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) RefundTransfer(ctx context.Context, in *ReverseTransferRequest, opts ...grpc.CallOption) (*ReverseTransferResponse, error) {
+	out := new(ReverseTransferResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankService/RefundTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error) {
+	out := new(ListTransfersResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankService/ListTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) SearchTransfers(ctx context.Context, in *SearchTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error) {
+	out := new(ListTransfersResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankService/SearchTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) CreateScheduledTransfer(ctx context.Context, in *CreateScheduledTransferRequest, opts ...grpc.CallOption) (*ScheduledTransferResponse, error) {
+	out := new(ScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankService/CreateScheduledTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) GetScheduledTransfer(ctx context.Context, in *ScheduledTransferID, opts ...grpc.CallOption) (*ScheduledTransferResponse, error) {
+	out := new(ScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankService/GetScheduledTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) ListScheduledTransfers(ctx context.Context, in *ListScheduledTransfersRequest, opts ...grpc.CallOption) (*ListScheduledTransfersResponse, error) {
+	out := new(ListScheduledTransfersResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankService/ListScheduledTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) UpdateScheduledTransfer(ctx context.Context, in *UpdateScheduledTransferRequest, opts ...grpc.CallOption) (*ScheduledTransferResponse, error) {
+	out := new(ScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankService/UpdateScheduledTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) CancelScheduledTransfer(ctx context.Context, in *ScheduledTransferID, opts ...grpc.CallOption) (*ScheduledTransferResponse, error) {
+	out := new(ScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankService/CancelScheduledTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) ListScheduledTransferAttempts(ctx context.Context, in *ListScheduledTransferAttemptsRequest, opts ...grpc.CallOption) (*ListScheduledTransferAttemptsResponse, error) {
+	out := new(ListScheduledTransferAttemptsResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankService/ListScheduledTransferAttempts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BankServiceServer is the server API for BankService service.
 // All implementations must embed UnimplementedBankServiceServer
 // for forward compatibility
@@ -102,11 +359,43 @@ type BankServiceServer interface {
 	// Auth gRPC calls
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
 	Logout(context.Context, *LogoutRequest) (*empty.Empty, error)
+	RenewAccessToken(context.Context, *RenewAccessTokenRequest) (*RenewAccessTokenResponse, error)
+	// Session gRPC calls
+	ListSessions(context.Context, *empty.Empty) (*ListSessionsResponse, error)
+	RevokeSession(context.Context, *SessionID) (*empty.Empty, error)
+	RevokeOtherSessions(context.Context, *empty.Empty) (*empty.Empty, error)
 	// User gRPC calls
 	CreateUser(context.Context, *UserRequest) (*UserResponse, error)
 	GetUser(context.Context, *Username) (*UserResponse, error)
 	UpdateUser(context.Context, *UserUpdateRequest) (*UserResponse, error)
 	DeleteUser(context.Context, *Username) (*empty.Empty, error)
+	// Account gRPC calls
+	CreateAccount(context.Context, *CreateAccountRequest) (*AccountResponse, error)
+	GetAccount(context.Context, *AccountID) (*AccountResponse, error)
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	DeleteAccount(context.Context, *AccountID) (*empty.Empty, error)
+	RestoreAccount(context.Context, *AccountID) (*AccountResponse, error)
+	CloseAccount(context.Context, *CloseAccountRequest) (*CloseAccountResponse, error)
+	// Only tellers and admins can deposit into or withdraw from an account
+	Deposit(context.Context, *DepositRequest) (*EntryTxResponse, error)
+	Withdraw(context.Context, *WithdrawRequest) (*EntryTxResponse, error)
+	ListEntries(context.Context, *ListEntriesRequest) (*ListEntriesResponse, error)
+	GetAccountStatement(context.Context, *AccountStatementRequest) (*AccountStatementResponse, error)
+	// Transfer gRPC calls
+	CreateTransfer(context.Context, *CreateTransferRequest) (*CreateTransferResponse, error)
+	// The fee and the converted amount of a transfer, without making it
+	QuoteTransfer(context.Context, *QuoteTransferRequest) (*TransferQuoteResponse, error)
+	// Only the recipient can refund a transfer, within TRANSFER_REFUND_WINDOW after it was made
+	RefundTransfer(context.Context, *ReverseTransferRequest) (*ReverseTransferResponse, error)
+	ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error)
+	SearchTransfers(context.Context, *SearchTransfersRequest) (*ListTransfersResponse, error)
+	// Scheduled transfer gRPC calls
+	CreateScheduledTransfer(context.Context, *CreateScheduledTransferRequest) (*ScheduledTransferResponse, error)
+	GetScheduledTransfer(context.Context, *ScheduledTransferID) (*ScheduledTransferResponse, error)
+	ListScheduledTransfers(context.Context, *ListScheduledTransfersRequest) (*ListScheduledTransfersResponse, error)
+	UpdateScheduledTransfer(context.Context, *UpdateScheduledTransferRequest) (*ScheduledTransferResponse, error)
+	CancelScheduledTransfer(context.Context, *ScheduledTransferID) (*ScheduledTransferResponse, error)
+	ListScheduledTransferAttempts(context.Context, *ListScheduledTransferAttemptsRequest) (*ListScheduledTransferAttemptsResponse, error)
 	mustEmbedUnimplementedBankServiceServer()
 }
 
@@ -120,6 +409,18 @@ func (UnimplementedBankServiceServer) Login(context.Context, *LoginRequest) (*Lo
 func (UnimplementedBankServiceServer) Logout(context.Context, *LogoutRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
 }
+func (UnimplementedBankServiceServer) RenewAccessToken(context.Context, *RenewAccessTokenRequest) (*RenewAccessTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewAccessToken not implemented")
+}
+func (UnimplementedBankServiceServer) ListSessions(context.Context, *empty.Empty) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedBankServiceServer) RevokeSession(context.Context, *SessionID) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedBankServiceServer) RevokeOtherSessions(context.Context, *empty.Empty) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeOtherSessions not implemented")
+}
 func (UnimplementedBankServiceServer) CreateUser(context.Context, *UserRequest) (*UserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
 }
@@ -132,6 +433,69 @@ func (UnimplementedBankServiceServer) UpdateUser(context.Context, *UserUpdateReq
 func (UnimplementedBankServiceServer) DeleteUser(context.Context, *Username) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
+func (UnimplementedBankServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*AccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (UnimplementedBankServiceServer) GetAccount(context.Context, *AccountID) (*AccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (UnimplementedBankServiceServer) ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
+}
+func (UnimplementedBankServiceServer) DeleteAccount(context.Context, *AccountID) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (UnimplementedBankServiceServer) RestoreAccount(context.Context, *AccountID) (*AccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAccount not implemented")
+}
+func (UnimplementedBankServiceServer) CloseAccount(context.Context, *CloseAccountRequest) (*CloseAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseAccount not implemented")
+}
+func (UnimplementedBankServiceServer) Deposit(context.Context, *DepositRequest) (*EntryTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (UnimplementedBankServiceServer) Withdraw(context.Context, *WithdrawRequest) (*EntryTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+}
+func (UnimplementedBankServiceServer) ListEntries(context.Context, *ListEntriesRequest) (*ListEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEntries not implemented")
+}
+func (UnimplementedBankServiceServer) GetAccountStatement(context.Context, *AccountStatementRequest) (*AccountStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountStatement not implemented")
+}
+func (UnimplementedBankServiceServer) CreateTransfer(context.Context, *CreateTransferRequest) (*CreateTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransfer not implemented")
+}
+func (UnimplementedBankServiceServer) QuoteTransfer(context.Context, *QuoteTransferRequest) (*TransferQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteTransfer not implemented")
+}
+func (UnimplementedBankServiceServer) RefundTransfer(context.Context, *ReverseTransferRequest) (*ReverseTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundTransfer not implemented")
+}
+func (UnimplementedBankServiceServer) ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransfers not implemented")
+}
+func (UnimplementedBankServiceServer) SearchTransfers(context.Context, *SearchTransfersRequest) (*ListTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTransfers not implemented")
+}
+func (UnimplementedBankServiceServer) CreateScheduledTransfer(context.Context, *CreateScheduledTransferRequest) (*ScheduledTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScheduledTransfer not implemented")
+}
+func (UnimplementedBankServiceServer) GetScheduledTransfer(context.Context, *ScheduledTransferID) (*ScheduledTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledTransfer not implemented")
+}
+func (UnimplementedBankServiceServer) ListScheduledTransfers(context.Context, *ListScheduledTransfersRequest) (*ListScheduledTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledTransfers not implemented")
+}
+func (UnimplementedBankServiceServer) UpdateScheduledTransfer(context.Context, *UpdateScheduledTransferRequest) (*ScheduledTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScheduledTransfer not implemented")
+}
+func (UnimplementedBankServiceServer) CancelScheduledTransfer(context.Context, *ScheduledTransferID) (*ScheduledTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledTransfer not implemented")
+}
+func (UnimplementedBankServiceServer) ListScheduledTransferAttempts(context.Context, *ListScheduledTransferAttemptsRequest) (*ListScheduledTransferAttemptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledTransferAttempts not implemented")
+}
 func (UnimplementedBankServiceServer) mustEmbedUnimplementedBankServiceServer() {}
 
 // UnsafeBankServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -181,6 +545,78 @@ func _BankService_Logout_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BankService_RenewAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).RenewAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/RenewAccessToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).RenewAccessToken(ctx, req.(*RenewAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).ListSessions(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/RevokeSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).RevokeSession(ctx, req.(*SessionID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_RevokeOtherSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).RevokeOtherSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/RevokeOtherSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).RevokeOtherSessions(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BankService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserRequest)
 	if err := dec(in); err != nil {
@@ -253,37 +689,515 @@ func _BankService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
-// BankService_ServiceDesc is the grpc.ServiceDesc for BankService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var BankService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.BankService",
-	HandlerType: (*BankServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Login",
-			Handler:    _BankService_Login_Handler,
-		},
-		{
-			MethodName: "Logout",
-			Handler:    _BankService_Logout_Handler,
-		},
-		{
-			MethodName: "CreateUser",
-			Handler:    _BankService_CreateUser_Handler,
-		},
-		{
-			MethodName: "GetUser",
-			Handler:    _BankService_GetUser_Handler,
-		},
-		{
-			MethodName: "UpdateUser",
-			Handler:    _BankService_UpdateUser_Handler,
+func _BankService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/CreateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/GetAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).GetAccount(ctx, req.(*AccountID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/ListAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/DeleteAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).DeleteAccount(ctx, req.(*AccountID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_RestoreAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).RestoreAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/RestoreAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).RestoreAccount(ctx, req.(*AccountID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_CloseAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).CloseAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/CloseAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).CloseAccount(ctx, req.(*CloseAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/Deposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).Deposit(ctx, req.(*DepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/Withdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).Withdraw(ctx, req.(*WithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_ListEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).ListEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/ListEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).ListEntries(ctx, req.(*ListEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_GetAccountStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).GetAccountStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/GetAccountStatement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).GetAccountStatement(ctx, req.(*AccountStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_CreateTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).CreateTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/CreateTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).CreateTransfer(ctx, req.(*CreateTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_QuoteTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).QuoteTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/QuoteTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).QuoteTransfer(ctx, req.(*QuoteTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_RefundTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).RefundTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/RefundTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).RefundTransfer(ctx, req.(*ReverseTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_ListTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).ListTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/ListTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).ListTransfers(ctx, req.(*ListTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_SearchTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).SearchTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/SearchTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).SearchTransfers(ctx, req.(*SearchTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_CreateScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduledTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).CreateScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/CreateScheduledTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).CreateScheduledTransfer(ctx, req.(*CreateScheduledTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_GetScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledTransferID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).GetScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/GetScheduledTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).GetScheduledTransfer(ctx, req.(*ScheduledTransferID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_ListScheduledTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).ListScheduledTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/ListScheduledTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).ListScheduledTransfers(ctx, req.(*ListScheduledTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_UpdateScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduledTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).UpdateScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/UpdateScheduledTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).UpdateScheduledTransfer(ctx, req.(*UpdateScheduledTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_CancelScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledTransferID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).CancelScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/CancelScheduledTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).CancelScheduledTransfer(ctx, req.(*ScheduledTransferID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_ListScheduledTransferAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledTransferAttemptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).ListScheduledTransferAttempts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankService/ListScheduledTransferAttempts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).ListScheduledTransferAttempts(ctx, req.(*ListScheduledTransferAttemptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BankService_ServiceDesc is the grpc.ServiceDesc for BankService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BankService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.BankService",
+	HandlerType: (*BankServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Login",
+			Handler:    _BankService_Login_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _BankService_Logout_Handler,
+		},
+		{
+			MethodName: "RenewAccessToken",
+			Handler:    _BankService_RenewAccessToken_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _BankService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _BankService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeOtherSessions",
+			Handler:    _BankService_RevokeOtherSessions_Handler,
+		},
+		{
+			MethodName: "CreateUser",
+			Handler:    _BankService_CreateUser_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _BankService_GetUser_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _BankService_UpdateUser_Handler,
 		},
 		{
 			MethodName: "DeleteUser",
 			Handler:    _BankService_DeleteUser_Handler,
 		},
+		{
+			MethodName: "CreateAccount",
+			Handler:    _BankService_CreateAccount_Handler,
+		},
+		{
+			MethodName: "GetAccount",
+			Handler:    _BankService_GetAccount_Handler,
+		},
+		{
+			MethodName: "ListAccounts",
+			Handler:    _BankService_ListAccounts_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _BankService_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "RestoreAccount",
+			Handler:    _BankService_RestoreAccount_Handler,
+		},
+		{
+			MethodName: "CloseAccount",
+			Handler:    _BankService_CloseAccount_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _BankService_Deposit_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _BankService_Withdraw_Handler,
+		},
+		{
+			MethodName: "ListEntries",
+			Handler:    _BankService_ListEntries_Handler,
+		},
+		{
+			MethodName: "GetAccountStatement",
+			Handler:    _BankService_GetAccountStatement_Handler,
+		},
+		{
+			MethodName: "CreateTransfer",
+			Handler:    _BankService_CreateTransfer_Handler,
+		},
+		{
+			MethodName: "QuoteTransfer",
+			Handler:    _BankService_QuoteTransfer_Handler,
+		},
+		{
+			MethodName: "RefundTransfer",
+			Handler:    _BankService_RefundTransfer_Handler,
+		},
+		{
+			MethodName: "ListTransfers",
+			Handler:    _BankService_ListTransfers_Handler,
+		},
+		{
+			MethodName: "SearchTransfers",
+			Handler:    _BankService_SearchTransfers_Handler,
+		},
+		{
+			MethodName: "CreateScheduledTransfer",
+			Handler:    _BankService_CreateScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "GetScheduledTransfer",
+			Handler:    _BankService_GetScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "ListScheduledTransfers",
+			Handler:    _BankService_ListScheduledTransfers_Handler,
+		},
+		{
+			MethodName: "UpdateScheduledTransfer",
+			Handler:    _BankService_UpdateScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "CancelScheduledTransfer",
+			Handler:    _BankService_CancelScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "ListScheduledTransferAttempts",
+			Handler:    _BankService_ListScheduledTransferAttempts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc_bank.proto",
@@ -0,0 +1,39 @@
+package risk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+)
+
+// BurstRule denies a transfer out of an account that already made Count transfers in the last Window,
+// the ones pending review included
+type BurstRule struct {
+	Count  int32
+	Window time.Duration
+}
+
+func (r BurstRule) Name() string {
+	return "burst"
+}
+
+func (r BurstRule) Assess(ctx context.Context, q db.Querier, transfer db.RiskTransfer) (db.RiskDecision, error) {
+	count, err := q.CountTransfersSince(ctx, db.CountTransfersSinceParams{
+		AccountID: transfer.From.ID,
+		Since:     transfer.Now.Add(-r.Window),
+	})
+	if err != nil {
+		return db.RiskDecision{}, err
+	}
+
+	if count < r.Count {
+		return db.RiskDecision{Verdict: db.RiskAllow}, nil
+	}
+
+	return db.RiskDecision{
+		Verdict: db.RiskDeny,
+		Reason:  fmt.Sprintf("%d transfers were made in the last %s, at most %d are allowed", count, r.Window, r.Count),
+	}, nil
+}
@@ -0,0 +1,44 @@
+package risk
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+)
+
+// NewCounterpartyRule puts into review the first transfer of at least the amount of its currency from a user to an
+// account of someone else
+type NewCounterpartyRule struct {
+	// Amounts are the smallest amounts reviewed by currency of the sender account, other currencies are never reviewed
+	Amounts map[string]int64
+}
+
+func (r NewCounterpartyRule) Name() string {
+	return "new_counterparty"
+}
+
+func (r NewCounterpartyRule) Assess(ctx context.Context, q db.Querier, transfer db.RiskTransfer) (db.RiskDecision, error) {
+	amount, ok := r.Amounts[transfer.From.Currency]
+	if !ok || transfer.Amount < amount || transfer.From.Owner == transfer.To.Owner {
+		return db.RiskDecision{Verdict: db.RiskAllow}, nil
+	}
+
+	// Any completed transfer from an account of the user makes the account a known counterparty
+	count, err := q.CountOwnerTransfersTo(ctx, db.CountOwnerTransfersToParams{
+		ToAccountID: transfer.To.ID,
+		Owner:       transfer.From.Owner,
+	})
+	if err != nil {
+		return db.RiskDecision{}, err
+	}
+
+	if count > 0 {
+		return db.RiskDecision{Verdict: db.RiskAllow}, nil
+	}
+
+	return db.RiskDecision{
+		Verdict: db.RiskReview,
+		Reason:  fmt.Sprintf("first transfer of %d %s to account %d, at least %d", transfer.Amount, transfer.From.Currency, transfer.To.ID, amount),
+	}, nil
+}
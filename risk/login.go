@@ -0,0 +1,47 @@
+package risk
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+)
+
+// NewIPLoginRule puts into review a transfer of at least the amount of its currency made less than Window after its
+// sender logged in from an ip none of their earlier sessions used. The first session of a user has no earlier ones to
+// compare with, it is never taken as a new ip
+type NewIPLoginRule struct {
+	// Amounts are the smallest amounts reviewed by currency of the sender account, other currencies are never reviewed
+	Amounts map[string]int64
+	Window  time.Duration
+}
+
+func (r NewIPLoginRule) Name() string {
+	return "new_ip_login"
+}
+
+func (r NewIPLoginRule) Assess(ctx context.Context, q db.Querier, transfer db.RiskTransfer) (db.RiskDecision, error) {
+	amount, ok := r.Amounts[transfer.From.Currency]
+	if !ok || transfer.Amount < amount {
+		return db.RiskDecision{Verdict: db.RiskAllow}, nil
+	}
+
+	session, err := q.GetNewIPSession(ctx, db.GetNewIPSessionParams{
+		Username: transfer.From.Owner,
+		Since:    transfer.Now.Add(-r.Window),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return db.RiskDecision{Verdict: db.RiskAllow}, nil
+		}
+		return db.RiskDecision{}, err
+	}
+
+	return db.RiskDecision{
+		Verdict: db.RiskReview,
+		Reason: fmt.Sprintf("transfer of %d %s, at least %d, made %s after a login from the new ip %s",
+			transfer.Amount, transfer.From.Currency, amount, transfer.Now.Sub(session.CreatedAt).Round(time.Second), session.ClientIp),
+	}, nil
+}
@@ -0,0 +1,86 @@
+package risk
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/util"
+)
+
+const (
+	DefaultBurstWindow = time.Minute
+	DefaultNewIPWindow = time.Hour
+)
+
+// NewRules creates the risk rules configured by RISK_NEW_COUNTERPARTY_AMOUNTS, RISK_BURST_COUNT, RISK_BURST_WINDOW,
+// RISK_NEW_IP_AMOUNTS and RISK_NEW_IP_WINDOW in the order they run. Every rule is off until its amounts or count
+// are set, the amounts are per currency since the same amount means very different sums from one to the other
+func NewRules(config *util.Config) ([]db.RiskRule, error) {
+	var rules []db.RiskRule
+
+	counterpartyAmounts, err := getAmounts(config, "RISK_NEW_COUNTERPARTY_AMOUNTS")
+	if err != nil {
+		return nil, err
+	}
+	if len(counterpartyAmounts) > 0 {
+		rules = append(rules, NewCounterpartyRule{Amounts: counterpartyAmounts})
+	}
+
+	burstCount, err := config.GetInt64("RISK_BURST_COUNT", 0)
+	if err != nil {
+		return nil, err
+	}
+	burstWindow, err := config.GetDuration("RISK_BURST_WINDOW", DefaultBurstWindow)
+	if err != nil {
+		return nil, err
+	}
+	if burstCount > 0 {
+		rules = append(rules, BurstRule{Count: int32(burstCount), Window: burstWindow})
+	}
+
+	newIPAmounts, err := getAmounts(config, "RISK_NEW_IP_AMOUNTS")
+	if err != nil {
+		return nil, err
+	}
+	newIPWindow, err := config.GetDuration("RISK_NEW_IP_WINDOW", DefaultNewIPWindow)
+	if err != nil {
+		return nil, err
+	}
+	if len(newIPAmounts) > 0 {
+		rules = append(rules, NewIPLoginRule{Amounts: newIPAmounts, Window: newIPWindow})
+	}
+
+	return rules, nil
+}
+
+// getAmounts parses the value of the key as a list of positive amounts per currency, e.g. "USD:50000,EUR:45000",
+// nil is returned when the key is not set
+func getAmounts(config *util.Config, key string) (map[string]int64, error) {
+	var amounts map[string]int64
+
+	for _, item := range config.GetList(key) {
+		currency, value, ok := strings.Cut(item, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid amount %q for %s, expected <currency>:<amount>", item, key)
+		}
+
+		currency = strings.TrimSpace(currency)
+		if !util.IsSupportedCurrency(currency) {
+			return nil, fmt.Errorf("unsupported currency %q for %s", currency, key)
+		}
+
+		amount, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil || amount < 1 {
+			return nil, fmt.Errorf("amount of %s for %s must be a positive integer, provided: %q", currency, key, value)
+		}
+
+		if amounts == nil {
+			amounts = make(map[string]int64)
+		}
+		amounts[currency] = amount
+	}
+	return amounts, nil
+}
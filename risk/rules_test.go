@@ -0,0 +1,235 @@
+package risk
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/escalopa/gobank/db/mock"
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func randomRiskTransfer(amount int64) db.RiskTransfer {
+	return db.RiskTransfer{
+		From:   db.Account{ID: util.RandomInteger(1, 1000), Owner: util.RandomOwner(), Currency: util.USD},
+		To:     db.Account{ID: util.RandomInteger(1001, 2000), Owner: util.RandomOwner()},
+		Amount: amount,
+		Now:    time.Now(),
+	}
+}
+
+func TestNewCounterpartyRule(t *testing.T) {
+	rule := NewCounterpartyRule{Amounts: map[string]int64{util.USD: 100}}
+
+	testCases := []struct {
+		name       string
+		transfer   func() db.RiskTransfer
+		buildStubs func(store *mockdb.MockStore)
+		verdict    db.RiskVerdict
+	}{
+		{
+			name:     "BelowAmount",
+			transfer: func() db.RiskTransfer { return randomRiskTransfer(99) },
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CountOwnerTransfersTo(gomock.Any(), gomock.Any()).Times(0)
+			},
+			verdict: db.RiskAllow,
+		},
+		{
+			name: "OtherCurrency",
+			transfer: func() db.RiskTransfer {
+				transfer := randomRiskTransfer(1_000_000)
+				transfer.From.Currency = util.RUB
+				return transfer
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CountOwnerTransfersTo(gomock.Any(), gomock.Any()).Times(0)
+			},
+			verdict: db.RiskAllow,
+		},
+		{
+			name: "OwnAccount",
+			transfer: func() db.RiskTransfer {
+				transfer := randomRiskTransfer(100)
+				transfer.To.Owner = transfer.From.Owner
+				return transfer
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CountOwnerTransfersTo(gomock.Any(), gomock.Any()).Times(0)
+			},
+			verdict: db.RiskAllow,
+		},
+		{
+			name:     "KnownCounterparty",
+			transfer: func() db.RiskTransfer { return randomRiskTransfer(100) },
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CountOwnerTransfersTo(gomock.Any(), gomock.Any()).Times(1).Return(int32(2), nil)
+			},
+			verdict: db.RiskAllow,
+		},
+		{
+			name:     "NewCounterparty",
+			transfer: func() db.RiskTransfer { return randomRiskTransfer(100) },
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CountOwnerTransfersTo(gomock.Any(), gomock.Any()).Times(1).Return(int32(0), nil)
+			},
+			verdict: db.RiskReview,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			decision, err := rule.Assess(context.Background(), store, tc.transfer())
+			require.NoError(t, err)
+			require.Equal(t, tc.verdict, decision.Verdict)
+		})
+	}
+}
+
+func TestBurstRule(t *testing.T) {
+	rule := BurstRule{Count: 3, Window: time.Minute}
+	transfer := randomRiskTransfer(1)
+
+	testCases := []struct {
+		name    string
+		count   int32
+		verdict db.RiskVerdict
+	}{
+		{name: "BelowCount", count: 2, verdict: db.RiskAllow},
+		{name: "AtCount", count: 3, verdict: db.RiskDeny},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().
+				CountTransfersSince(gomock.Any(), gomock.Eq(db.CountTransfersSinceParams{
+					AccountID: transfer.From.ID,
+					Since:     transfer.Now.Add(-time.Minute),
+				})).
+				Times(1).Return(tc.count, nil)
+
+			decision, err := rule.Assess(context.Background(), store, transfer)
+			require.NoError(t, err)
+			require.Equal(t, tc.verdict, decision.Verdict)
+		})
+	}
+}
+
+func TestNewIPLoginRule(t *testing.T) {
+	rule := NewIPLoginRule{Amounts: map[string]int64{util.USD: 100}, Window: time.Hour}
+
+	testCases := []struct {
+		name       string
+		amount     int64
+		buildStubs func(store *mockdb.MockStore)
+		verdict    db.RiskVerdict
+	}{
+		{
+			name:   "BelowAmount",
+			amount: 99,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetNewIPSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			verdict: db.RiskAllow,
+		},
+		{
+			name:   "KnownIP",
+			amount: 100,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetNewIPSession(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.GetNewIPSessionRow{}, sql.ErrNoRows)
+			},
+			verdict: db.RiskAllow,
+		},
+		{
+			name:   "NewIP",
+			amount: 100,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetNewIPSession(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.GetNewIPSessionRow{ClientIp: "10.0.0.1", CreatedAt: time.Now().Add(-time.Minute)}, nil)
+			},
+			verdict: db.RiskReview,
+		},
+		{
+			name:   "InternalError",
+			amount: 100,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetNewIPSession(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.GetNewIPSessionRow{}, sql.ErrConnDone)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			decision, err := rule.Assess(context.Background(), store, randomRiskTransfer(tc.amount))
+			if tc.verdict == "" {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.verdict, decision.Verdict)
+		})
+	}
+}
+
+func TestNewRules(t *testing.T) {
+	// Every rule is off until it is configured
+	rules, err := NewRules(util.NewConfig())
+	require.NoError(t, err)
+	require.Empty(t, rules)
+
+	config := util.NewConfig()
+	config.Set("RISK_NEW_COUNTERPARTY_AMOUNTS", "USD:50000, RUB:45000")
+	config.Set("RISK_NEW_IP_AMOUNTS", "USD:20000")
+	config.Set("RISK_NEW_IP_WINDOW", "30m")
+
+	rules, err = NewRules(config)
+	require.NoError(t, err)
+	require.Equal(t, []db.RiskRule{
+		NewCounterpartyRule{Amounts: map[string]int64{util.USD: 50000, util.RUB: 45000}},
+		NewIPLoginRule{Amounts: map[string]int64{util.USD: 20000}, Window: 30 * time.Minute},
+	}, rules)
+
+	config.Set("RISK_BURST_COUNT", "5")
+	rules, err = NewRules(config)
+	require.NoError(t, err)
+	require.Equal(t, BurstRule{Count: 5, Window: DefaultBurstWindow}, rules[1])
+
+	for _, amounts := range []string{"50000", "XYZ:50000", "USD:0", "USD:lots"} {
+		config.Set("RISK_NEW_IP_AMOUNTS", amounts)
+		_, err = NewRules(config)
+		require.Error(t, err, amounts)
+	}
+
+	config.Set("RISK_NEW_IP_AMOUNTS", "USD:20000")
+	config.Set("RISK_BURST_WINDOW", "soon")
+	_, err = NewRules(config)
+	require.Error(t, err)
+}
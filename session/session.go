@@ -0,0 +1,176 @@
+// Package session renews access tokens with the refresh tokens of sessions, it is shared by the HTTP and gRPC servers
+// so that both check sessions the same way
+package session
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/token"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidRefreshToken     = errors.New("invalid refresh token")
+	ErrBlockedRefreshToken     = errors.New("refresh token is blocked")
+	ErrMismatchedRefreshTokens = errors.New("refresh token doesn't match with stored refresh token")
+	ErrExpiredRefreshToken     = errors.New("refresh token has expired")
+	ErrRevokedSession          = errors.New("session of the access token is revoked")
+	ErrDeletedUser             = errors.New("user of the access token is deleted")
+)
+
+// Client is the client renewing the token, it is stored with the new session
+type Client struct {
+	UserAgent string
+	ClientIP  string
+}
+
+type Renewal struct {
+	// SessionID is the session of the new refresh token
+	SessionID      uuid.UUID
+	AccessToken    string
+	AccessPayload  *token.Payload
+	RefreshToken   string
+	RefreshPayload *token.Payload
+}
+
+// Check returns why the refresh token with payload can't be renewed with session, nil when it can
+func Check(session db.Session, refreshToken string, payload *token.Payload, now time.Time) error {
+	if session.IsBlocked {
+		return ErrBlockedRefreshToken
+	}
+
+	if now.After(session.ExpiresAt) {
+		return ErrExpiredRefreshToken
+	}
+
+	if session.Username != payload.Username {
+		return ErrMismatchedRefreshTokens
+	}
+
+	if session.RefreshToken != refreshToken {
+		return ErrMismatchedRefreshTokens
+	}
+
+	return nil
+}
+
+// Renew issues a new access token and rotates the refresh token of its session.
+// The new tokens carry the current role of the user rather than the one of the refresh token.
+// sql.ErrNoRows is returned when the session of the refresh token doesn't exist, db.ErrRefreshTokenReused when the
+// refresh token was already renewed, and the errors of this package when it can't be renewed otherwise
+func Renew(ctx context.Context, store db.Store, maker token.Maker, refreshToken string, client Client) (Renewal, error) {
+	refreshPayload, err := maker.VerifyToken(refreshToken)
+	if err != nil {
+		return Renewal{}, fmt.Errorf("%w: %s", ErrInvalidRefreshToken, err)
+	}
+
+	session, err := store.GetSession(ctx, refreshPayload.ID)
+	if err != nil {
+		return Renewal{}, err
+	}
+
+	if err = Check(session, refreshToken, refreshPayload, time.Now()); err != nil {
+		return Renewal{}, err
+	}
+
+	// The role of the user is reloaded, a role changed since the login applies from the next renewal
+	user, err := store.GetUser(ctx, session.Username)
+	if err != nil {
+		return Renewal{}, err
+	}
+	role := string(user.Role)
+
+	// Rotate the refresh token, the renewed one can't be used again
+	var renewal Renewal
+	renewal.RefreshToken, renewal.RefreshPayload, err = maker.CreateRefreshToken(session.Username, role)
+	if err != nil {
+		return Renewal{}, err
+	}
+
+	renewal.AccessToken, renewal.AccessPayload, err = maker.CreateToken(session.Username, role, renewal.RefreshPayload.ID)
+	if err != nil {
+		return Renewal{}, err
+	}
+
+	ctx = db.WithActor(ctx, db.Actor{Username: session.Username, ClientIP: client.ClientIP, UserAgent: client.UserAgent})
+	result, err := store.RenewSessionTx(ctx, db.RenewSessionTxParam{
+		SessionID: session.ID,
+		Session: db.CreateSessionParams{
+			ID:           renewal.RefreshPayload.ID,
+			Username:     session.Username,
+			RefreshToken: renewal.RefreshToken,
+			UserAgent:    client.UserAgent,
+			ClientIp:     client.ClientIP,
+			ExpiresAt:    renewal.RefreshPayload.ExpireAt,
+		},
+	})
+	if err != nil {
+		return Renewal{}, err
+	}
+
+	renewal.SessionID = result.Session.ID
+	return renewal, nil
+}
+
+// CheckAccess returns ErrRevokedSession when the session of the access token with payload is blocked or gone,
+// so that the access tokens of a revoked session are rejected before they expire
+func CheckAccess(ctx context.Context, store db.Store, payload *token.Payload) error {
+	// Tokens issued before access tokens carried their session can't be revoked
+	if payload.SessionID == uuid.Nil {
+		return ErrRevokedSession
+	}
+
+	blocked, err := store.IsSessionBlocked(ctx, payload.SessionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrRevokedSession
+		}
+		return err
+	}
+
+	if blocked {
+		return ErrRevokedSession
+	}
+	return nil
+}
+
+// CurrentRole returns the role the user of the access token with payload has now, the token carries the role of the
+// user when it was issued which stays until it expires. ErrDeletedUser is returned when the user is gone
+func CurrentRole(ctx context.Context, store db.Store, payload *token.Payload) (db.UserRole, error) {
+	user, err := store.GetUser(ctx, payload.Username)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrDeletedUser
+		}
+		return "", err
+	}
+
+	if user.IsDeleted {
+		return "", ErrDeletedUser
+	}
+	return user.Role, nil
+}
+
+// IsUnauthorized tells whether err means that the token can't be used, as opposed to an internal error
+func IsUnauthorized(err error) bool {
+	for _, target := range []error{
+		ErrInvalidRefreshToken,
+		ErrBlockedRefreshToken,
+		ErrMismatchedRefreshTokens,
+		ErrExpiredRefreshToken,
+		ErrRevokedSession,
+		ErrDeletedUser,
+		db.ErrRefreshTokenReused,
+		db.ErrSessionBlocked,
+	} {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,265 @@
+package session
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/escalopa/gobank/db/mock"
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/token"
+	"github.com/escalopa/gobank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func newRefreshSession(t *testing.T, maker token.Maker) (db.Session, string) {
+	refreshToken, payload, err := maker.CreateRefreshToken(util.RandomOwner(), "customer")
+	require.NoError(t, err)
+
+	return db.Session{
+		ID:           payload.ID,
+		Username:     payload.Username,
+		RefreshToken: refreshToken,
+		ExpiresAt:    payload.ExpireAt,
+		FamilyID:     payload.ID,
+	}, refreshToken
+}
+
+func TestCheck(t *testing.T) {
+	maker, err := token.NewPasetoMaker(util.RandomString(32))
+	require.NoError(t, err)
+
+	session, refreshToken := newRefreshSession(t, maker)
+	payload, err := maker.VerifyToken(refreshToken)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		update func(session *db.Session)
+		err    error
+	}{
+		{name: "OK", update: func(session *db.Session) {}},
+		{name: "Blocked", update: func(session *db.Session) { session.IsBlocked = true }, err: ErrBlockedRefreshToken},
+		{name: "Expired", update: func(session *db.Session) { session.ExpiresAt = time.Now().Add(-time.Minute) }, err: ErrExpiredRefreshToken},
+		{name: "MismatchedUsername", update: func(session *db.Session) { session.Username = util.RandomOwner() }, err: ErrMismatchedRefreshTokens},
+		{name: "MismatchedToken", update: func(session *db.Session) { session.RefreshToken = "other" }, err: ErrMismatchedRefreshTokens},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			checked := session
+			tc.update(&checked)
+			require.Equal(t, tc.err, Check(checked, refreshToken, payload, time.Now()))
+		})
+	}
+}
+
+func TestRenew(t *testing.T) {
+	maker, err := token.NewPasetoMaker(util.RandomString(32))
+	require.NoError(t, err)
+
+	session, refreshToken := newRefreshSession(t, maker)
+	client := Client{UserAgent: "go-test", ClientIP: "127.0.0.1"}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+	store.EXPECT().
+		GetUser(gomock.Any(), gomock.Eq(session.Username)).
+		Times(1).
+		Return(db.User{Username: session.Username, Role: db.UserRoleCustomer}, nil)
+	store.EXPECT().
+		RenewSessionTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(ctx context.Context, arg db.RenewSessionTxParam) (db.RenewSessionTxResult, error) {
+			require.Equal(t, session.Username, db.ActorFromContext(ctx).Username)
+			require.Equal(t, session.ID, arg.SessionID)
+			require.Equal(t, client.ClientIP, arg.Session.ClientIp)
+			return db.RenewSessionTxResult{Session: db.Session{ID: arg.Session.ID}, FamilyID: session.FamilyID}, nil
+		})
+
+	renewal, err := Renew(context.Background(), store, maker, refreshToken, client)
+	require.NoError(t, err)
+	require.Equal(t, renewal.RefreshPayload.ID, renewal.SessionID)
+	require.NotEqual(t, refreshToken, renewal.RefreshToken)
+	require.Equal(t, session.Username, renewal.AccessPayload.Username)
+	require.Equal(t, renewal.SessionID, renewal.AccessPayload.SessionID)
+	require.Equal(t, string(db.UserRoleCustomer), renewal.AccessPayload.Role)
+
+	// Tokens that don't verify never reach the store
+	_, err = Renew(context.Background(), store, maker, "invalid", client)
+	require.ErrorIs(t, err, ErrInvalidRefreshToken)
+	require.True(t, IsUnauthorized(err))
+
+	store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(db.Session{}, sql.ErrNoRows)
+	_, err = Renew(context.Background(), store, maker, refreshToken, client)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	require.False(t, IsUnauthorized(err))
+}
+
+func TestRenewChangedRole(t *testing.T) {
+	maker, err := token.NewPasetoMaker(util.RandomString(32))
+	require.NoError(t, err)
+
+	// The user logged in as a customer and was made an admin since
+	session, refreshToken := newRefreshSession(t, maker)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+	store.EXPECT().
+		GetUser(gomock.Any(), gomock.Eq(session.Username)).
+		Times(1).
+		Return(db.User{Username: session.Username, Role: db.UserRoleAdmin}, nil)
+	store.EXPECT().
+		RenewSessionTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, arg db.RenewSessionTxParam) (db.RenewSessionTxResult, error) {
+			return db.RenewSessionTxResult{Session: db.Session{ID: arg.Session.ID}, FamilyID: session.FamilyID}, nil
+		})
+
+	renewal, err := Renew(context.Background(), store, maker, refreshToken, Client{})
+	require.NoError(t, err)
+	require.Equal(t, string(db.UserRoleAdmin), renewal.AccessPayload.Role)
+	require.Equal(t, string(db.UserRoleAdmin), renewal.RefreshPayload.Role)
+
+	// A user that is gone can't renew its tokens
+	store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+	store.EXPECT().GetUser(gomock.Any(), gomock.Eq(session.Username)).Times(1).Return(db.User{}, sql.ErrNoRows)
+	store.EXPECT().RenewSessionTx(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err = Renew(context.Background(), store, maker, refreshToken, Client{})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestCheckAccess(t *testing.T) {
+	maker, err := token.NewPasetoMaker(util.RandomString(32))
+	require.NoError(t, err)
+
+	_, payload, err := maker.CreateToken(util.RandomOwner(), "customer", uuid.New())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore)
+		err        error
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().IsSessionBlocked(gomock.Any(), gomock.Eq(payload.SessionID)).Times(1).Return(false, nil)
+			},
+		},
+		{
+			name: "Blocked",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().IsSessionBlocked(gomock.Any(), gomock.Eq(payload.SessionID)).Times(1).Return(true, nil)
+			},
+			err: ErrRevokedSession,
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().IsSessionBlocked(gomock.Any(), gomock.Eq(payload.SessionID)).Times(1).Return(false, sql.ErrNoRows)
+			},
+			err: ErrRevokedSession,
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().IsSessionBlocked(gomock.Any(), gomock.Eq(payload.SessionID)).Times(1).Return(false, sql.ErrConnDone)
+			},
+			err: sql.ErrConnDone,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+			require.Equal(t, tc.err, CheckAccess(context.Background(), store, payload))
+		})
+	}
+
+	// Tokens without a session never reach the store
+	withoutSession := *payload
+	withoutSession.SessionID = uuid.Nil
+	require.Equal(t, ErrRevokedSession, CheckAccess(context.Background(), nil, &withoutSession))
+}
+
+func TestCurrentRole(t *testing.T) {
+	maker, err := token.NewPasetoMaker(util.RandomString(32))
+	require.NoError(t, err)
+
+	// The token was issued while the user was an admin
+	_, payload, err := maker.CreateToken(util.RandomOwner(), string(db.UserRoleAdmin), uuid.New())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore)
+		role       db.UserRole
+		err        error
+	}{
+		{
+			name: "Demoted",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(payload.Username)).Times(1).
+					Return(db.User{Username: payload.Username, Role: db.UserRoleCustomer}, nil)
+			},
+			role: db.UserRoleCustomer,
+		},
+		{
+			name: "Deleted",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(payload.Username)).Times(1).
+					Return(db.User{Username: payload.Username, Role: db.UserRoleAdmin, IsDeleted: true}, nil)
+			},
+			err: ErrDeletedUser,
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(payload.Username)).Times(1).Return(db.User{}, sql.ErrNoRows)
+			},
+			err: ErrDeletedUser,
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(payload.Username)).Times(1).Return(db.User{}, sql.ErrConnDone)
+			},
+			err: sql.ErrConnDone,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			role, err := CurrentRole(context.Background(), store, payload)
+			require.Equal(t, tc.err, err)
+			require.Equal(t, tc.role, role)
+		})
+	}
+}
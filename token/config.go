@@ -0,0 +1,49 @@
+package token
+
+import (
+	"fmt"
+
+	"github.com/escalopa/gobank/util"
+)
+
+// Types of tokens of TOKEN_TYPE
+const (
+	TypePaseto = "paseto"
+	TypeJWT    = "jwt"
+)
+
+// NewMaker returns the maker of the TOKEN_TYPE of config, paseto or jwt. Paseto tokens are encrypted with
+// SYMMETRIC_KEY. JWTs are signed with SYMMETRIC_KEY too, unless TOKEN_KEYRING_DIR is set, then they are signed
+// with the TOKEN_SIGNING_KEY_ID key of the keyring loaded from it, the TOKEN_RETIRED_KEY_IDS keys being retired.
+// TOKEN_TYPE defaults to jwt when TOKEN_KEYRING_DIR is set and to paseto otherwise
+func NewMaker(config *util.Config) (Maker, error) {
+	dir := config.Get("TOKEN_KEYRING_DIR")
+
+	tokenType := config.Get("TOKEN_TYPE")
+	if tokenType == "" {
+		tokenType = TypePaseto
+		if dir != "" {
+			tokenType = TypeJWT
+		}
+	}
+
+	switch tokenType {
+	case TypePaseto:
+		if dir != "" {
+			return nil, fmt.Errorf("paseto tokens are encrypted with SYMMETRIC_KEY, TOKEN_KEYRING_DIR only signs jwt tokens")
+		}
+		return NewPasetoMaker(config.Get("SYMMETRIC_KEY"))
+	case TypeJWT:
+		if dir == "" {
+			return NewJWTMaker(config.Get("SYMMETRIC_KEY"))
+		}
+
+		keyring, err := LoadKeyring(dir, config.Get("TOKEN_SIGNING_KEY_ID"), config.GetList("TOKEN_RETIRED_KEY_IDS"))
+		if err != nil {
+			return nil, fmt.Errorf("cannot load keyring, %w", err)
+		}
+		return NewEdDSAMaker(keyring)
+	default:
+		return nil, fmt.Errorf("TOKEN_TYPE must be %s or %s, provided: %s", TypePaseto, TypeJWT, tokenType)
+	}
+}
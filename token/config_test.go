@@ -0,0 +1,57 @@
+package token
+
+import (
+	"testing"
+
+	"github.com/escalopa/gobank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMaker(t *testing.T) {
+	dir := t.TempDir()
+	key := newRandomKey(t, "2023-01")
+	writeKey(t, dir, key, true)
+
+	testCases := []struct {
+		name   string
+		config map[string]string
+		maker  Maker
+		err    bool
+	}{
+		{name: "Default", config: map[string]string{}, maker: &PasetoMaker{}},
+		{name: "Paseto", config: map[string]string{"TOKEN_TYPE": TypePaseto}, maker: &PasetoMaker{}},
+		{name: "JWT", config: map[string]string{"TOKEN_TYPE": TypeJWT}, maker: &JWTMaker{}},
+		{
+			name:   "Keyring",
+			config: map[string]string{"TOKEN_KEYRING_DIR": dir, "TOKEN_SIGNING_KEY_ID": key.ID},
+			maker:  &EdDSAMaker{},
+		},
+		{
+			name:   "PasetoKeyring",
+			config: map[string]string{"TOKEN_TYPE": TypePaseto, "TOKEN_KEYRING_DIR": dir, "TOKEN_SIGNING_KEY_ID": key.ID},
+			err:    true,
+		},
+		{name: "Unknown", config: map[string]string{"TOKEN_TYPE": "unknown"}, err: true},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			config := util.NewConfig()
+			config.Set("SYMMETRIC_KEY", util.RandomString(32))
+			for key, value := range tc.config {
+				config.Set(key, value)
+			}
+
+			maker, err := NewMaker(config)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.IsType(t, tc.maker, maker)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package token
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+)
+
+// keyIDHeader is the JWT header of the ID of the key that signed the token
+const keyIDHeader = "kid"
+
+// EdDSAMaker signs JWTs with the Ed25519 signing key of a keyring, the tokens can be verified with the
+// public keys of the keyring alone
+type EdDSAMaker struct {
+	keyring *Keyring
+	clock   Clock
+}
+
+func NewEdDSAMaker(keyring *Keyring) (PublicKeyMaker, error) {
+	return NewEdDSAMakerWithClock(keyring, time.Now)
+}
+
+// NewEdDSAMakerWithClock returns an EdDSAMaker that issues and verifies tokens at the times told by clock
+func NewEdDSAMakerWithClock(keyring *Keyring, clock Clock) (PublicKeyMaker, error) {
+	if keyring == nil {
+		return nil, fmt.Errorf("keyring is required")
+	}
+
+	return &EdDSAMaker{keyring, clock}, nil
+}
+
+func (maker *EdDSAMaker) CreateToken(username string, role string, sessionID uuid.UUID) (string, *Payload, error) {
+	payload, err := newPayload(username, role, AccessTokenExpiration, maker.clock())
+	if err != nil {
+		return "", payload, err
+	}
+	payload.SessionID = sessionID
+
+	token, err := maker.sign(payload)
+	return token, payload, err
+}
+
+func (maker *EdDSAMaker) CreateRefreshToken(username string, role string) (string, *Payload, error) {
+	payload, err := newPayload(username, role, RefreshTokenExpiration, maker.clock())
+	if err != nil {
+		return "", payload, err
+	}
+
+	token, err := maker.sign(payload)
+	return token, payload, err
+}
+
+func (maker *EdDSAMaker) sign(payload *Payload) (string, error) {
+	key := maker.keyring.SigningKey()
+
+	jwtToken := jwt.NewWithClaims(jwt.SigningMethodEdDSA, payload)
+	jwtToken.Header[keyIDHeader] = key.ID
+	return jwtToken.SignedString(key.Private)
+}
+
+func (maker *EdDSAMaker) VerifyToken(token string) (*Payload, error) {
+	keyFunc := func(token *jwt.Token) (interface{}, error) {
+		// Only EdDSA, so that the public key can't be used as the secret of another algorithm
+		if _, ok := token.Method.(*jwt.SigningMethodEd25519); !ok {
+			return nil, ErrTokenInvalid
+		}
+
+		id, _ := token.Header[keyIDHeader].(string)
+		return maker.keyring.VerificationKey(id)
+	}
+
+	// The expiry is checked against the clock of the maker below
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{jwt.SigningMethodEdDSA.Alg()}), jwt.WithoutClaimsValidation())
+	jwtToken, err := parser.ParseWithClaims(token, &Payload{}, keyFunc)
+	if err != nil {
+		if errors.Is(err, ErrKeyUnknown) || errors.Is(err, ErrKeyRetired) {
+			return nil, fmt.Errorf("%w: %s", ErrTokenInvalid, errors.Unwrap(err))
+		}
+		return nil, ErrTokenInvalid
+	}
+
+	payload, ok := jwtToken.Claims.(*Payload)
+	if !ok {
+		return nil, ErrTokenInvalid
+	}
+
+	if err = payload.validAt(maker.clock()); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// PublicKeys returns the public keys that verify the tokens of the maker
+func (maker *EdDSAMaker) PublicKeys() JWKS {
+	return maker.keyring.JWKS()
+}
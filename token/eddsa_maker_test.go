@@ -0,0 +1,132 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/escalopa/gobank/util"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEdDSAMaker(t *testing.T) {
+	key := newRandomKey(t, "2023-01")
+	keyring, err := NewKeyring(key.ID, key)
+	require.NoError(t, err)
+
+	maker, err := NewEdDSAMaker(keyring)
+	require.NoError(t, err)
+
+	username := util.RandomOwner()
+	role := "admin"
+	sessionID := uuid.New()
+	issuedAt := time.Now()
+
+	token, payload, err := maker.CreateToken(username, role, sessionID)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+	require.NotEmpty(t, payload)
+
+	payload, err = maker.VerifyToken(token)
+	require.NoError(t, err)
+	require.NotZero(t, payload.ID)
+
+	require.Equal(t, payload.Username, username)
+	require.Equal(t, payload.Role, role)
+	require.Equal(t, payload.SessionID, sessionID)
+	require.WithinDuration(t, payload.IssuedAt, issuedAt, time.Second)
+
+	refreshToken, refreshPayload, err := maker.CreateRefreshToken(username, role)
+	require.NoError(t, err)
+
+	payload, err = maker.VerifyToken(refreshToken)
+	require.NoError(t, err)
+	require.Equal(t, refreshPayload.ID, payload.ID)
+}
+
+func TestEdDSAMakerRotation(t *testing.T) {
+	previous := newRandomKey(t, "2023-01")
+	current := newRandomKey(t, "2023-06")
+
+	keyring, err := NewKeyring(previous.ID, previous)
+	require.NoError(t, err)
+	maker, err := NewEdDSAMaker(keyring)
+	require.NoError(t, err)
+
+	token, _, err := maker.CreateToken(util.RandomOwner(), "customer", uuid.New())
+	require.NoError(t, err)
+
+	// The tokens of the previous key are still accepted once the current one signs
+	keyring, err = NewKeyring(current.ID, current, Key{ID: previous.ID, Public: previous.Public})
+	require.NoError(t, err)
+	rotated, err := NewEdDSAMaker(keyring)
+	require.NoError(t, err)
+
+	_, err = rotated.VerifyToken(token)
+	require.NoError(t, err)
+
+	// Until the previous key is retired
+	keyring, err = NewKeyring(current.ID, current, Key{ID: previous.ID, Public: previous.Public, Retired: true})
+	require.NoError(t, err)
+	retired, err := NewEdDSAMaker(keyring)
+	require.NoError(t, err)
+
+	payload, err := retired.VerifyToken(token)
+	require.ErrorIs(t, err, ErrTokenInvalid)
+	require.Nil(t, payload)
+}
+
+func TestEdDSAMakerInvalid(t *testing.T) {
+	key := newRandomKey(t, "2023-01")
+	keyring, err := NewKeyring(key.ID, key)
+	require.NoError(t, err)
+	maker, err := NewEdDSAMaker(keyring)
+	require.NoError(t, err)
+
+	payload, err := NewPayload(util.RandomOwner(), "customer", time.Minute)
+	require.NoError(t, err)
+
+	// A token signed with the public key as an HMAC secret
+	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, payload)
+	jwtToken.Header[keyIDHeader] = key.ID
+	token, err := jwtToken.SignedString([]byte(key.Public))
+	require.NoError(t, err)
+
+	payload, err = maker.VerifyToken(token)
+	require.ErrorIs(t, err, ErrTokenInvalid)
+	require.Nil(t, payload)
+
+	// A token signed by a key outside of the keyring
+	other := newRandomKey(t, key.ID)
+	otherKeyring, err := NewKeyring(other.ID, other)
+	require.NoError(t, err)
+	otherMaker, err := NewEdDSAMaker(otherKeyring)
+	require.NoError(t, err)
+
+	token, _, err = otherMaker.CreateToken(util.RandomOwner(), "customer", uuid.New())
+	require.NoError(t, err)
+
+	payload, err = maker.VerifyToken(token)
+	require.ErrorIs(t, err, ErrTokenInvalid)
+	require.Nil(t, payload)
+}
+
+func TestEdDSAMakerExpired(t *testing.T) {
+	key := newRandomKey(t, "2023-01")
+	keyring, err := NewKeyring(key.ID, key)
+	require.NoError(t, err)
+
+	pastMaker, err := NewEdDSAMakerWithClock(keyring, pastClock())
+	require.NoError(t, err)
+
+	token, _, err := pastMaker.CreateToken(util.RandomOwner(), "customer", uuid.New())
+	require.NoError(t, err)
+
+	maker, err := NewEdDSAMaker(keyring)
+	require.NoError(t, err)
+
+	payload, err := maker.VerifyToken(token)
+	require.EqualError(t, err, ErrTokenExpired.Error())
+	require.Nil(t, payload)
+}
@@ -1,56 +1,76 @@
 package token
 
 import (
-	"errors"
 	"fmt"
+	"time"
 
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
 )
 
 const minSecretKeyLen = 32
 
+// jwtSigningMethod is the only algorithm JWTMaker signs and accepts tokens with
+var jwtSigningMethod = jwt.SigningMethodHS512
+
 type JWTMaker struct {
 	secretKey string
+	clock     Clock
 }
 
 func NewJWTMaker(secretKey string) (Maker, error) {
+	return NewJWTMakerWithClock(secretKey, time.Now)
+}
+
+// NewJWTMakerWithClock returns a JWTMaker that issues and verifies tokens at the times told by clock
+func NewJWTMakerWithClock(secretKey string, clock Clock) (Maker, error) {
 	if len(secretKey) < minSecretKeyLen {
 		return nil, fmt.Errorf("secretKet len is less than the min value %d", minSecretKeyLen)
 	}
 
-	return &JWTMaker{secretKey}, nil
+	return &JWTMaker{secretKey, clock}, nil
 }
 
-func (jwtMaker *JWTMaker) CreateToken(username string) (string, *Payload, error) {
-	payload, err := NewPayload(username, AccessTokenExpiration)
+func (jwtMaker *JWTMaker) CreateToken(username string, role string, sessionID uuid.UUID) (string, *Payload, error) {
+	payload, err := newPayload(username, role, AccessTokenExpiration, jwtMaker.clock())
 	if err != nil {
 		return "", payload, err
 	}
+	payload.SessionID = sessionID
 
-	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS512, payload)
-	token, err := jwtToken.SignedString([]byte(jwtMaker.secretKey))
+	token, err := jwtMaker.sign(payload)
 	return token, payload, err
 }
 
-func (JWTMaker *JWTMaker) CreateRefreshToken(username string) (string, *Payload, error) {
-	return "", nil, nil
+func (jwtMaker *JWTMaker) CreateRefreshToken(username string, role string) (string, *Payload, error) {
+	payload, err := newPayload(username, role, RefreshTokenExpiration, jwtMaker.clock())
+	if err != nil {
+		return "", payload, err
+	}
+
+	token, err := jwtMaker.sign(payload)
+	return token, payload, err
+}
+
+func (jwtMaker *JWTMaker) sign(payload *Payload) (string, error) {
+	jwtToken := jwt.NewWithClaims(jwtSigningMethod, payload)
+	return jwtToken.SignedString([]byte(jwtMaker.secretKey))
 }
 
 func (jwtMaker *JWTMaker) VerifyToken(token string) (*Payload, error) {
 	keyFunc := func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodECDSA); ok {
+		// Only HMAC, "none" and the asymmetric algorithms would let anyone who knows the token format forge one
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, ErrTokenInvalid
 		}
 
 		return []byte(jwtMaker.secretKey), nil
 	}
 
-	jwtToken, err := jwt.ParseWithClaims(token, &Payload{}, keyFunc)
+	// The expiry is checked against the clock of the maker below
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{jwtSigningMethod.Alg()}), jwt.WithoutClaimsValidation())
+	jwtToken, err := parser.ParseWithClaims(token, &Payload{}, keyFunc)
 	if err != nil {
-		verr, ok := err.(*jwt.ValidationError)
-		if ok && errors.Is(verr, ErrTokenExpired) {
-			return nil, ErrTokenExpired
-		}
 		return nil, ErrTokenInvalid
 	}
 
@@ -59,5 +79,9 @@ func (jwtMaker *JWTMaker) VerifyToken(token string) (*Payload, error) {
 		return nil, ErrTokenInvalid
 	}
 
+	if err = payload.validAt(jwtMaker.clock()); err != nil {
+		return nil, err
+	}
+
 	return payload, nil
 }
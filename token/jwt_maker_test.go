@@ -1,11 +1,14 @@
 package token
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
 	"testing"
 	"time"
 
 	"github.com/escalopa/gobank/util"
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 )
 
@@ -14,9 +17,11 @@ func TestJWTMaker(t *testing.T) {
 	require.NoError(t, err)
 
 	username := util.RandomOwner()
+	role := "admin"
+	sessionID := uuid.New()
 	issuedAt := time.Now()
 
-	token, payload, err := JWTMaker.CreateToken(username)
+	token, payload, err := JWTMaker.CreateToken(username, role, sessionID)
 	require.NoError(t, err)
 	require.NotEmpty(t, token)
 	require.NotEmpty(t, payload)
@@ -27,11 +32,13 @@ func TestJWTMaker(t *testing.T) {
 	require.NotZero(t, payload.ID)
 
 	require.Equal(t, payload.Username, username)
+	require.Equal(t, payload.Role, role)
+	require.Equal(t, payload.SessionID, sessionID)
 	require.WithinDuration(t, payload.IssuedAt, issuedAt, time.Second)
 }
 
 func TestJWTMakerInvalid(t *testing.T) {
-	payload, err := NewPayload(util.RandomOwner(), time.Minute)
+	payload, err := NewPayload(util.RandomOwner(), "customer", time.Minute)
 	require.NoError(t, err)
 
 	jwtToken := jwt.NewWithClaims(jwt.SigningMethodNone, payload)
@@ -46,17 +53,71 @@ func TestJWTMakerInvalid(t *testing.T) {
 	require.Nil(t, payload)
 }
 
-// func TestJWTMakerExpired(t *testing.T) {
-// 	JWTMaker, err := NewJWTMaker(util.RandomString(32))
-// 	require.NoError(t, err)
+// pastClock returns a clock that is behind the real time by more than the expiration of refresh tokens
+func pastClock() Clock {
+	return func() time.Time {
+		return time.Now().Add(-RefreshTokenExpiration - time.Minute)
+	}
+}
+
+func TestJWTMakerExpired(t *testing.T) {
+	secretKey := util.RandomString(32)
+	pastMaker, err := NewJWTMakerWithClock(secretKey, pastClock())
+	require.NoError(t, err)
+
+	token, payload, err := pastMaker.CreateToken(util.RandomOwner(), "customer", uuid.New())
+	require.NoError(t, err)
+	require.NotEmpty(t, payload)
+
+	// The maker that created the token still accepts it at its time
+	_, err = pastMaker.VerifyToken(token)
+	require.NoError(t, err)
+
+	JWTMaker, err := NewJWTMaker(secretKey)
+	require.NoError(t, err)
 
-// 	username := util.RandomOwner()
+	payload, err = JWTMaker.VerifyToken(token)
+	require.EqualError(t, err, ErrTokenExpired.Error())
+	require.Nil(t, payload)
+
+	refreshToken, _, err := pastMaker.CreateRefreshToken(util.RandomOwner(), "customer")
+	require.NoError(t, err)
+
+	payload, err = JWTMaker.VerifyToken(refreshToken)
+	require.EqualError(t, err, ErrTokenExpired.Error())
+	require.Nil(t, payload)
+}
 
-// 	token, payload, err := JWTMaker.CreateToken(username)
-// 	require.NoError(t, err)
-// 	require.NotEmpty(t, payload)
+func TestJWTMakerRefreshToken(t *testing.T) {
+	JWTMaker, err := NewJWTMaker(util.RandomString(32))
+	require.NoError(t, err)
 
-// 	payload, err = JWTMaker.VerifyToken(token)
-// 	require.EqualError(t, err, ErrTokenExpired.Error())
-// 	require.Nil(t, payload)
-// }
+	username := util.RandomOwner()
+	token, payload, err := JWTMaker.CreateRefreshToken(username, "customer")
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+	require.WithinDuration(t, time.Now().Add(RefreshTokenExpiration), payload.ExpireAt, time.Second)
+
+	verified, err := JWTMaker.VerifyToken(token)
+	require.NoError(t, err)
+	require.Equal(t, payload.ID, verified.ID)
+	require.Equal(t, username, verified.Username)
+}
+
+func TestJWTMakerAsymmetric(t *testing.T) {
+	JWTMaker, err := NewJWTMaker(util.RandomString(32))
+	require.NoError(t, err)
+
+	payload, err := NewPayload(util.RandomOwner(), "customer", time.Minute)
+	require.NoError(t, err)
+
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodEdDSA, payload).SignedString(private)
+	require.NoError(t, err)
+
+	payload, err = JWTMaker.VerifyToken(token)
+	require.EqualError(t, err, ErrTokenInvalid.Error())
+	require.Nil(t, payload)
+}
@@ -0,0 +1,176 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+var (
+	ErrKeyUnknown = errors.New("token key is unknown")
+	ErrKeyRetired = errors.New("token key is retired")
+)
+
+// keyFileExt is the extension of the key files of a keyring directory, the name of a file is the ID of its key
+const keyFileExt = ".pem"
+
+// Key is an Ed25519 key of a Keyring, Private is nil for the keys that only verify tokens
+type Key struct {
+	ID      string
+	Public  ed25519.PublicKey
+	Private ed25519.PrivateKey
+	// Retired keys don't verify tokens anymore
+	Retired bool
+}
+
+// Keyring holds the key that signs new tokens along with the keys that still verify the tokens signed before
+// a rotation, so that rotating the signing key doesn't log everyone out
+type Keyring struct {
+	signing *Key
+	keys    map[string]*Key
+}
+
+// NewKeyring returns a keyring of keys that signs with the key signingKeyID, which must have a private key
+func NewKeyring(signingKeyID string, keys ...Key) (*Keyring, error) {
+	keyring := &Keyring{keys: make(map[string]*Key, len(keys))}
+	for i := range keys {
+		key := keys[i]
+		if key.ID == "" {
+			return nil, fmt.Errorf("token key without an ID")
+		}
+		if _, ok := keyring.keys[key.ID]; ok {
+			return nil, fmt.Errorf("token key %s is duplicated", key.ID)
+		}
+		keyring.keys[key.ID] = &key
+	}
+
+	signing, ok := keyring.keys[signingKeyID]
+	if !ok {
+		return nil, fmt.Errorf("signing key %q isn't in the keyring", signingKeyID)
+	}
+	if signing.Private == nil {
+		return nil, fmt.Errorf("signing key %s has no private key", signingKeyID)
+	}
+	if signing.Retired {
+		return nil, fmt.Errorf("signing key %s is retired", signingKeyID)
+	}
+
+	keyring.signing = signing
+	return keyring, nil
+}
+
+// LoadKeyring loads the keys of the PEM files of dir, PKCS #8 private keys or PKIX public keys named after
+// their key ID, e.g. 2023-01.pem. The keys of retiredKeyIDs are loaded as retired
+func LoadKeyring(dir, signingKeyID string, retiredKeyIDs []string) (*Keyring, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*"+keyFileExt))
+	if err != nil {
+		return nil, err
+	}
+
+	retired := make(map[string]bool, len(retiredKeyIDs))
+	for _, id := range retiredKeyIDs {
+		retired[id] = true
+	}
+
+	keys := make([]Key, 0, len(files))
+	for _, file := range files {
+		key, err := loadKey(file)
+		if err != nil {
+			return nil, err
+		}
+
+		key.Retired = retired[key.ID]
+		keys = append(keys, key)
+	}
+
+	return NewKeyring(signingKeyID, keys...)
+}
+
+func loadKey(file string) (Key, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return Key{}, err
+	}
+
+	key := Key{ID: strings.TrimSuffix(filepath.Base(file), keyFileExt)}
+
+	if private, err := jwt.ParseEdPrivateKeyFromPEM(b); err == nil {
+		key.Private = private.(ed25519.PrivateKey)
+		key.Public = key.Private.Public().(ed25519.PublicKey)
+		return key, nil
+	}
+
+	public, err := jwt.ParseEdPublicKeyFromPEM(b)
+	if err != nil {
+		return Key{}, fmt.Errorf("token key %s isn't an Ed25519 key, %w", file, err)
+	}
+
+	key.Public = public.(ed25519.PublicKey)
+	return key, nil
+}
+
+// SigningKey returns the key that signs new tokens
+func (keyring *Keyring) SigningKey() Key {
+	return *keyring.signing
+}
+
+// VerificationKey returns the public key of id, ErrKeyUnknown and ErrKeyRetired when it can't verify tokens
+func (keyring *Keyring) VerificationKey(id string) (ed25519.PublicKey, error) {
+	key, ok := keyring.keys[id]
+	if !ok {
+		return nil, ErrKeyUnknown
+	}
+	if key.Retired {
+		return nil, ErrKeyRetired
+	}
+	return key.Public, nil
+}
+
+// JWK is an Ed25519 public key as a JSON Web Key (RFC 8037)
+type JWK struct {
+	KeyType   string `json:"kty"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	Use       string `json:"use"`
+}
+
+// JWKS is a JSON Web Key Set, it lets other services verify tokens themselves
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns the public keys of the keyring that verify tokens, ordered by ID
+func (keyring *Keyring) JWKS() JWKS {
+	ids := make([]string, 0, len(keyring.keys))
+	for id := range keyring.keys {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	jwks := JWKS{Keys: []JWK{}}
+	for _, id := range ids {
+		key := keyring.keys[id]
+		if key.Retired {
+			continue
+		}
+
+		jwks.Keys = append(jwks.Keys, JWK{
+			KeyType:   "OKP",
+			Curve:     "Ed25519",
+			X:         base64.RawURLEncoding.EncodeToString(key.Public),
+			KeyID:     key.ID,
+			Algorithm: jwt.SigningMethodEdDSA.Alg(),
+			Use:       "sig",
+		})
+	}
+	return jwks
+}
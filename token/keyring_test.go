@@ -0,0 +1,82 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newRandomKey(t *testing.T, id string) Key {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return Key{ID: id, Public: public, Private: private}
+}
+
+// writeKey writes the private key of key to dir, only its public key when private is false
+func writeKey(t *testing.T, dir string, key Key, private bool) {
+	block := &pem.Block{Type: "PUBLIC KEY"}
+	var err error
+	if private {
+		block.Type = "PRIVATE KEY"
+		block.Bytes, err = x509.MarshalPKCS8PrivateKey(key.Private)
+	} else {
+		block.Bytes, err = x509.MarshalPKIXPublicKey(key.Public)
+	}
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(dir, key.ID+keyFileExt), pem.EncodeToMemory(block), 0600)
+	require.NoError(t, err)
+}
+
+func TestLoadKeyring(t *testing.T) {
+	dir := t.TempDir()
+	current := newRandomKey(t, "2023-06")
+	previous := newRandomKey(t, "2023-01")
+	retired := newRandomKey(t, "2022-06")
+
+	writeKey(t, dir, current, true)
+	writeKey(t, dir, previous, false)
+	writeKey(t, dir, retired, false)
+
+	keyring, err := LoadKeyring(dir, current.ID, []string{retired.ID})
+	require.NoError(t, err)
+	require.Equal(t, current.ID, keyring.SigningKey().ID)
+	require.Equal(t, current.Private, keyring.SigningKey().Private)
+
+	public, err := keyring.VerificationKey(previous.ID)
+	require.NoError(t, err)
+	require.Equal(t, previous.Public, public)
+
+	_, err = keyring.VerificationKey(retired.ID)
+	require.ErrorIs(t, err, ErrKeyRetired)
+
+	_, err = keyring.VerificationKey("unknown")
+	require.ErrorIs(t, err, ErrKeyUnknown)
+
+	jwks := keyring.JWKS()
+	require.Len(t, jwks.Keys, 2)
+	require.Equal(t, previous.ID, jwks.Keys[0].KeyID)
+	require.Equal(t, current.ID, jwks.Keys[1].KeyID)
+
+	// Only keys with a private key sign
+	_, err = LoadKeyring(dir, previous.ID, nil)
+	require.Error(t, err)
+
+	_, err = LoadKeyring(dir, current.ID, []string{current.ID})
+	require.Error(t, err)
+}
+
+func TestLoadKeyringInvalidKey(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "invalid"+keyFileExt), []byte("invalid"), 0600)
+	require.NoError(t, err)
+
+	_, err = LoadKeyring(dir, "invalid", nil)
+	require.Error(t, err)
+}
@@ -1,6 +1,10 @@
 package token
 
-import "time"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 const (
 	AccessTokenExpiration  = time.Hour
@@ -8,7 +12,14 @@ const (
 )
 
 type Maker interface {
-	CreateToken(username string) (string, *Payload, error)
-	CreateRefreshToken(username string) (string, *Payload, error)
+	// CreateToken creates an access token for the session sessionID, its refresh token's ID
+	CreateToken(username string, role string, sessionID uuid.UUID) (string, *Payload, error)
+	CreateRefreshToken(username string, role string) (string, *Payload, error)
 	VerifyToken(token string) (*Payload, error)
 }
+
+// PublicKeyMaker is a Maker whose tokens can be verified by other services with its public keys
+type PublicKeyMaker interface {
+	Maker
+	PublicKeys() JWKS
+}
@@ -2,35 +2,44 @@ package token
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/o1egl/paseto"
 )
 
 type PasetoMaker struct {
 	paseto       *paseto.V2
 	symmetricKey []byte
+	clock        Clock
 }
 
 func NewPasetoMaker(secretKey string) (Maker, error) {
+	return NewPasetoMakerWithClock(secretKey, time.Now)
+}
+
+// NewPasetoMakerWithClock returns a PasetoMaker that issues and verifies tokens at the times told by clock
+func NewPasetoMakerWithClock(secretKey string, clock Clock) (Maker, error) {
 	if len(secretKey) < minSecretKeyLen {
 		return nil, fmt.Errorf("secretKet len is less than the min value %d", minSecretKeyLen)
 	}
 
-	return &PasetoMaker{paseto.NewV2(), []byte(secretKey)}, nil
+	return &PasetoMaker{paseto.NewV2(), []byte(secretKey), clock}, nil
 }
 
-func (pasetoMaker *PasetoMaker) CreateToken(username string) (string, *Payload, error) {
-	payload, err := NewPayload(username, AccessTokenExpiration)
+func (pasetoMaker *PasetoMaker) CreateToken(username string, role string, sessionID uuid.UUID) (string, *Payload, error) {
+	payload, err := newPayload(username, role, AccessTokenExpiration, pasetoMaker.clock())
 	if err != nil {
 		return "", payload, err
 	}
+	payload.SessionID = sessionID
 
 	token, err := pasetoMaker.paseto.Encrypt(pasetoMaker.symmetricKey, payload, nil)
 	return token, payload, err
 }
 
-func (pasetoMaker *PasetoMaker) CreateRefreshToken(username string) (string, *Payload, error) {
-	payload, err := NewPayload(username, RefreshTokenExpiration)
+func (pasetoMaker *PasetoMaker) CreateRefreshToken(username string, role string) (string, *Payload, error) {
+	payload, err := newPayload(username, role, RefreshTokenExpiration, pasetoMaker.clock())
 	if err != nil {
 		return "", payload, err
 	}
@@ -46,7 +55,7 @@ func (pasetoMaker *PasetoMaker) VerifyToken(token string) (*Payload, error) {
 		return nil, err
 	}
 
-	if err = payload.Valid(); err != nil {
+	if err = payload.validAt(pasetoMaker.clock()); err != nil {
 		return nil, err
 	}
 
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/escalopa/gobank/util"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 )
 
@@ -13,9 +14,11 @@ func TestPasetoMaker(t *testing.T) {
 	require.NoError(t, err)
 
 	username := util.RandomOwner()
+	role := "admin"
+	sessionID := uuid.New()
 	issuedAt := time.Now()
 
-	token, payload, err := pasetoMaker.CreateToken(username)
+	token, payload, err := pasetoMaker.CreateToken(username, role, sessionID)
 	require.NoError(t, err)
 	require.NotEmpty(t, token)
 	require.NotEmpty(t, payload)
@@ -26,20 +29,24 @@ func TestPasetoMaker(t *testing.T) {
 	require.NotZero(t, payload.ID)
 
 	require.Equal(t, payload.Username, username)
+	require.Equal(t, payload.Role, role)
+	require.Equal(t, payload.SessionID, sessionID)
 	require.WithinDuration(t, payload.IssuedAt, issuedAt, time.Second)
 }
 
-// func TestPasetoMakerExpired(t *testing.T) {
-// 	JWTMaker, err := NewPasetoMaker(util.RandomString(32))
-// 	require.NoError(t, err)
+func TestPasetoMakerExpired(t *testing.T) {
+	secretKey := util.RandomString(32)
+	pastMaker, err := NewPasetoMakerWithClock(secretKey, pastClock())
+	require.NoError(t, err)
 
-// 	username := util.RandomOwner()
+	token, payload, err := pastMaker.CreateToken(util.RandomOwner(), "customer", uuid.New())
+	require.NoError(t, err)
+	require.NotEmpty(t, payload)
 
-// 	token, payload, err := JWTMaker.CreateToken(username)
-// 	require.NoError(t, err)
-// 	require.NotEmpty(t, payload)
+	pasetoMaker, err := NewPasetoMaker(secretKey)
+	require.NoError(t, err)
 
-// 	payload, err = JWTMaker.VerifyToken(token)
-// 	require.EqualError(t, err, ErrTokenExpired.Error())
-// 	require.Nil(t, payload)
-// }
+	payload, err = pasetoMaker.VerifyToken(token)
+	require.EqualError(t, err, ErrTokenExpired.Error())
+	require.Nil(t, payload)
+}
@@ -15,11 +15,21 @@ var (
 type Payload struct {
 	ID       uuid.UUID `json:"id"`
 	Username string    `json:"username"`
-	IssuedAt time.Time `json:"issued_at"`
-	ExpireAt time.Time `json:"expire_at"`
+	Role     string    `json:"role"`
+	// SessionID is the session an access token was issued for, refresh tokens are their session so it is left empty
+	SessionID uuid.UUID `json:"session_id,omitempty"`
+	IssuedAt  time.Time `json:"issued_at"`
+	ExpireAt  time.Time `json:"expire_at"`
 }
 
-func NewPayload(username string, duration time.Duration) (*Payload, error) {
+// Clock returns the current time, the makers take one so that tests can create tokens that are already expired
+type Clock func() time.Time
+
+func NewPayload(username string, role string, duration time.Duration) (*Payload, error) {
+	return newPayload(username, role, duration, time.Now())
+}
+
+func newPayload(username string, role string, duration time.Duration, now time.Time) (*Payload, error) {
 	tokenID, err := uuid.NewRandom()
 	if err != nil {
 		return nil, err
@@ -28,13 +38,19 @@ func NewPayload(username string, duration time.Duration) (*Payload, error) {
 	return &Payload{
 		ID:       tokenID,
 		Username: username,
-		IssuedAt: time.Now(),
-		ExpireAt: time.Now().Add(duration),
+		Role:     role,
+		IssuedAt: now,
+		ExpireAt: now.Add(duration),
 	}, nil
 }
 
+// Valid implements jwt.Claims, the makers check the expiry against their clock with validAt
 func (payload *Payload) Valid() error {
-	if payload.ExpireAt.Before(time.Now()) {
+	return payload.validAt(time.Now())
+}
+
+func (payload *Payload) validAt(now time.Time) error {
+	if payload.ExpireAt.Before(now) {
 		return ErrTokenExpired
 	}
 	return nil
@@ -1,7 +1,11 @@
 package util
 
 import (
+	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type Config struct {
@@ -47,3 +51,45 @@ func (c *Config) Get(key string) (result string) {
 func (c *Config) Set(key, value string) {
 	c.m[key] = value
 }
+
+// GetDuration parses the value of the key as a duration (e.g. "24h")
+// if the key is not set the fallback is returned
+func (c *Config) GetDuration(key string, fallback time.Duration) (time.Duration, error) {
+	value := c.Get(key)
+	if value == "" {
+		return fallback, nil
+	}
+
+	d, err := time.ParseDuration(strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration for %s, %w", key, err)
+	}
+	return d, nil
+}
+
+// GetInt64 parses the value of the key as a base 10 integer
+// if the key is not set the fallback is returned
+func (c *Config) GetInt64(key string, fallback int64) (int64, error) {
+	value := c.Get(key)
+	if value == "" {
+		return fallback, nil
+	}
+
+	n, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid integer for %s, %w", key, err)
+	}
+	return n, nil
+}
+
+// GetList splits the value of the key on commas, the items are trimmed and the empty ones dropped
+// if the key is not set nil is returned
+func (c *Config) GetList(key string) []string {
+	var list []string
+	for _, item := range strings.Split(c.Get(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
@@ -0,0 +1,50 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigGetDuration(t *testing.T) {
+	c := NewConfig()
+
+	d, err := c.GetDuration("TEST_DURATION", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, d)
+
+	c.Set("TEST_DURATION", "90m")
+	d, err = c.GetDuration("TEST_DURATION", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 90*time.Minute, d)
+
+	c.Set("TEST_DURATION", "invalid")
+	_, err = c.GetDuration("TEST_DURATION", time.Hour)
+	require.Error(t, err)
+}
+
+func TestConfigGetInt64(t *testing.T) {
+	c := NewConfig()
+
+	n, err := c.GetInt64("TEST_INT", 10)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), n)
+
+	c.Set("TEST_INT", "1000")
+	n, err = c.GetInt64("TEST_INT", 10)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), n)
+
+	c.Set("TEST_INT", "1.5")
+	_, err = c.GetInt64("TEST_INT", 10)
+	require.Error(t, err)
+}
+
+func TestConfigGetList(t *testing.T) {
+	c := NewConfig()
+	require.Nil(t, c.GetList("TEST_LIST"))
+
+	c.Set("TEST_LIST", " a, b,,c ")
+	require.Equal(t, []string{"a", "b", "c"}, c.GetList("TEST_LIST"))
+}
@@ -0,0 +1,85 @@
+package util
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const DefaultMaxPageSize = 100
+
+// PageSizeLimit bounds PAGE_SIZE_MAX, the lists fetch one more row than a page and that must still fit in an int32
+const PageSizeLimit = 10_000
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor points at the last row of a page ordered by (created_at, id), or by (amount, id) for sorted searches,
+// or by Key for rows without an id, the next page starts right after it
+type Cursor struct {
+	CreatedAt time.Time `json:"t"`
+	Amount    int64     `json:"a,omitempty"`
+	ID        int64     `json:"id"`
+	Key       string    `json:"k,omitempty"`
+	// Sort is the order the cursor was issued for, a cursor can't be used with another order
+	Sort string `json:"s,omitempty"`
+}
+
+// Encode returns the opaque token handed to clients as next_cursor
+func (c Cursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor parses a token returned by Encode, an empty token is the zero cursor which starts at the first page
+func DecodeCursor(token string) (Cursor, error) {
+	var c Cursor
+	if token == "" {
+		return c, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, ErrInvalidCursor
+	}
+
+	if err := json.Unmarshal(b, &c); err != nil || (c.ID < 1 && c.Key == "") {
+		return Cursor{}, ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// PageSize applies the defaults to a requested page size, zero means the largest page allowed
+func PageSize(requested, max int32) (int32, error) {
+	if requested < 0 {
+		return 0, errors.New("page_size must not be negative")
+	}
+	if requested == 0 || requested > max {
+		return max, nil
+	}
+	return requested, nil
+}
+
+// LoadMaxPageSize reads PAGE_SIZE_MAX, the largest page the lists return, between 1 and PageSizeLimit
+func LoadMaxPageSize(config *Config) (int32, error) {
+	maxPageSize, err := config.GetInt64("PAGE_SIZE_MAX", DefaultMaxPageSize)
+	if err != nil {
+		return 0, err
+	}
+	if maxPageSize < 1 || maxPageSize > PageSizeLimit {
+		return 0, fmt.Errorf("PAGE_SIZE_MAX must be between 1 and %d, provided: %d", PageSizeLimit, maxPageSize)
+	}
+	return int32(maxPageSize), nil
+}
+
+// Paginate cuts rows fetched with a limit of pageSize+1 down to the page, the extra row only tells whether there is
+// a next page. The cursor of the next page is built from the last row of the page, it is empty on the last page
+func Paginate[T any](rows []T, pageSize int32, cursorOf func(T) Cursor) ([]T, string) {
+	if len(rows) <= int(pageSize) {
+		return rows, ""
+	}
+
+	rows = rows[:pageSize]
+	return rows, cursorOf(rows[pageSize-1]).Encode()
+}
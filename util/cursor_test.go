@@ -0,0 +1,88 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	c := Cursor{CreatedAt: time.Date(2022, time.November, 1, 10, 0, 0, 123456000, time.UTC), ID: RandomInteger(1, 1000)}
+
+	decoded, err := DecodeCursor(c.Encode())
+	require.NoError(t, err)
+	require.True(t, c.CreatedAt.Equal(decoded.CreatedAt))
+	require.Equal(t, c.ID, decoded.ID)
+
+	// Rows without an id are paged by their key
+	c = Cursor{Key: RandomOwner()}
+	decoded, err = DecodeCursor(c.Encode())
+	require.NoError(t, err)
+	require.Equal(t, c.Key, decoded.Key)
+
+	// The first page has no cursor
+	decoded, err = DecodeCursor("")
+	require.NoError(t, err)
+	require.Zero(t, decoded)
+
+	for _, token := range []string{"not base64!", "bm90IGpzb24", Cursor{}.Encode()} {
+		_, err = DecodeCursor(token)
+		require.ErrorIs(t, err, ErrInvalidCursor)
+	}
+}
+
+func TestPageSize(t *testing.T) {
+	size, err := PageSize(0, 50)
+	require.NoError(t, err)
+	require.Equal(t, int32(50), size)
+
+	size, err = PageSize(10, 50)
+	require.NoError(t, err)
+	require.Equal(t, int32(10), size)
+
+	size, err = PageSize(500, 50)
+	require.NoError(t, err)
+	require.Equal(t, int32(50), size)
+
+	_, err = PageSize(-1, 50)
+	require.Error(t, err)
+}
+
+func TestLoadMaxPageSize(t *testing.T) {
+	c := NewConfig()
+
+	size, err := LoadMaxPageSize(c)
+	require.NoError(t, err)
+	require.Equal(t, int32(DefaultMaxPageSize), size)
+
+	c.Set("PAGE_SIZE_MAX", "500")
+	size, err = LoadMaxPageSize(c)
+	require.NoError(t, err)
+	require.Equal(t, int32(500), size)
+
+	// The row fetched past the largest page must not overflow
+	for _, invalid := range []string{"0", "10001", "2147483647"} {
+		c.Set("PAGE_SIZE_MAX", invalid)
+		_, err = LoadMaxPageSize(c)
+		require.Error(t, err)
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	cursorOf := func(id int64) Cursor { return Cursor{ID: id} }
+
+	// The extra row is cut and the page ends at its last row
+	page, next := Paginate([]int64{1, 2, 3}, 2, cursorOf)
+	require.Equal(t, []int64{1, 2}, page)
+	require.Equal(t, Cursor{ID: 2}.Encode(), next)
+
+	// The last page has no next cursor
+	page, next = Paginate([]int64{1, 2}, 2, cursorOf)
+	require.Equal(t, []int64{1, 2}, page)
+	require.Empty(t, next)
+
+	page, next = Paginate([]int64{}, 2, cursorOf)
+	require.Empty(t, page)
+	require.Empty(t, next)
+}
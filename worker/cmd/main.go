@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/risk"
+	"github.com/escalopa/gobank/util"
+	"github.com/escalopa/gobank/worker"
+)
+
+func main() {
+	// Load config from environment variables
+	config := util.NewConfig()
+
+	// Initialize the database
+	conn := db.InitDatabase(config)
+
+	rules, err := risk.NewRules(config)
+	if err != nil {
+		log.Fatalf("cannot create risk rules, err: %s", err)
+	}
+	store := db.NewAuditedStore(conn, db.WithRiskRules(rules...))
+
+	scheduledTransferWorker, err := worker.NewScheduledTransferWorker(config, store)
+	if err != nil {
+		log.Fatalf("cannot create scheduled transfer worker, err: %s", err)
+	}
+
+	holdExpiryWorker, err := worker.NewHoldExpiryWorker(config, store)
+	if err != nil {
+		log.Fatalf("cannot create hold expiry worker, err: %s", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		log.Printf("hold expiry worker is running")
+		if err := holdExpiryWorker.Start(ctx); err != nil && err != context.Canceled {
+			log.Fatalf("hold expiry worker stopped, err: %s", err)
+		}
+	}()
+
+	log.Printf("scheduled transfer worker is running")
+	if err := scheduledTransferWorker.Start(ctx); err != nil && err != context.Canceled {
+		log.Fatalf("scheduled transfer worker stopped, err: %s", err)
+	}
+}
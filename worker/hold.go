@@ -0,0 +1,73 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/util"
+)
+
+const (
+	DefaultHoldExpiryInterval = time.Minute
+	// HoldExpiryUserAgent is the user agent of the hold releases the worker makes in the audit log
+	HoldExpiryUserAgent = "hold-expiry-worker"
+)
+
+// HoldExpiryWorker releases the active holds once they expire, giving their amount back to the available balance
+// of their accounts. Expired holds are locked while they are released so several workers can share the same database
+type HoldExpiryWorker struct {
+	store db.Store
+
+	// interval is how long the worker sleeps once no hold is expired
+	interval time.Duration
+}
+
+func NewHoldExpiryWorker(config *util.Config, store db.Store) (*HoldExpiryWorker, error) {
+	interval, err := config.GetDuration("HOLD_EXPIRY_INTERVAL", DefaultHoldExpiryInterval)
+	if err != nil {
+		return nil, err
+	}
+	if interval <= 0 {
+		return nil, fmt.Errorf("HOLD_EXPIRY_INTERVAL must be positive, provided: %s", interval)
+	}
+
+	return &HoldExpiryWorker{store: store, interval: interval}, nil
+}
+
+// Start releases the expired holds every interval until ctx is done
+func (w *HoldExpiryWorker) Start(ctx context.Context) error {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.releaseExpired(ctx); err != nil {
+			log.Printf("cannot release expired holds, err: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// releaseExpired releases the expired holds one at a time until none is left
+func (w *HoldExpiryWorker) releaseExpired(ctx context.Context) error {
+	ctx = db.WithActor(ctx, db.Actor{UserAgent: HoldExpiryUserAgent})
+
+	for ctx.Err() == nil {
+		_, err := w.store.ReleaseExpiredHoldTx(ctx, time.Now())
+		if err != nil {
+			if errors.Is(err, db.ErrNoHoldExpired) {
+				return nil
+			}
+			return err
+		}
+	}
+	return ctx.Err()
+}
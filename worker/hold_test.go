@@ -0,0 +1,54 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockdb "github.com/escalopa/gobank/db/mock"
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHoldExpiryWorker(t *testing.T) {
+	config := util.NewConfig()
+	config.Set("HOLD_EXPIRY_INTERVAL", "-1s")
+
+	_, err := NewHoldExpiryWorker(config, nil)
+	require.Error(t, err)
+
+	config.Set("HOLD_EXPIRY_INTERVAL", "")
+	w, err := NewHoldExpiryWorker(config, nil)
+	require.NoError(t, err)
+	require.Equal(t, DefaultHoldExpiryInterval, w.interval)
+}
+
+func TestReleaseExpired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+
+	config := util.NewConfig()
+	config.Set("HOLD_EXPIRY_INTERVAL", "1s")
+	w, err := NewHoldExpiryWorker(config, store)
+	require.NoError(t, err)
+
+	// Expired holds are released until none is left
+	gomock.InOrder(
+		store.EXPECT().ReleaseExpiredHoldTx(gomock.Any(), gomock.Any()).Times(2).
+			Return(db.HoldTxResult{Hold: db.Hold{ID: 1, Status: db.HoldStatusExpired}}, nil),
+		store.EXPECT().ReleaseExpiredHoldTx(gomock.Any(), gomock.Any()).Times(1).
+			Return(db.HoldTxResult{}, db.ErrNoHoldExpired),
+	)
+	require.NoError(t, w.releaseExpired(context.Background()))
+
+	// The worker stops with its context
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	store.EXPECT().ReleaseExpiredHoldTx(gomock.Any(), gomock.Any()).AnyTimes().
+		Return(db.HoldTxResult{}, db.ErrNoHoldExpired)
+	require.ErrorIs(t, w.Start(ctx), context.DeadlineExceeded)
+}
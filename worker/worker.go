@@ -0,0 +1,144 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/fx"
+	"github.com/escalopa/gobank/util"
+)
+
+const (
+	DefaultInterval = 30 * time.Second
+	// WorkerUserAgent is the user agent of the transfers the worker makes in the audit log
+	WorkerUserAgent = "scheduled-transfer-worker"
+)
+
+var ErrAccountDeleted = func(id int64) error {
+	return fmt.Errorf("account %d is deleted", id)
+}
+
+// ScheduledTransferWorker makes the transfers of the scheduled transfers once they are due,
+// due schedules are locked while they run so several workers can share the same database
+type ScheduledTransferWorker struct {
+	store db.Store
+	rates fx.RateProvider
+
+	// interval is how long the worker sleeps once no schedule is due
+	interval             time.Duration
+	idempotencyRetention time.Duration
+}
+
+func NewScheduledTransferWorker(config *util.Config, store db.Store) (*ScheduledTransferWorker, error) {
+	interval, err := config.GetDuration("SCHEDULED_TRANSFER_INTERVAL", DefaultInterval)
+	if err != nil {
+		return nil, err
+	}
+	if interval <= 0 {
+		return nil, fmt.Errorf("SCHEDULED_TRANSFER_INTERVAL must be positive, provided: %s", interval)
+	}
+
+	retention, err := config.GetDuration("IDEMPOTENCY_KEY_RETENTION", 24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := fx.NewProvider(config)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create rate provider for worker, %w", err)
+	}
+
+	return &ScheduledTransferWorker{store: store, rates: rates, interval: interval, idempotencyRetention: retention}, nil
+}
+
+// Start runs the due scheduled transfers every interval until ctx is done
+func (w *ScheduledTransferWorker) Start(ctx context.Context) error {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.runDue(ctx); err != nil {
+			log.Printf("cannot run scheduled transfers, err: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// runDue runs the scheduled transfers that are due one at a time until none is left
+func (w *ScheduledTransferWorker) runDue(ctx context.Context) error {
+	for ctx.Err() == nil {
+		attempt, err := w.store.RunScheduledTransferTx(ctx, time.Now(), w.transfer)
+		if err != nil {
+			if errors.Is(err, db.ErrNoScheduledTransferDue) {
+				return nil
+			}
+			return err
+		}
+
+		if attempt.Error.Valid {
+			log.Printf("scheduled transfer %d failed, occurrence: %d, err: %s", attempt.ScheduledTransferID, attempt.Occurrence, attempt.Error.String)
+		}
+	}
+	return ctx.Err()
+}
+
+// transfer applies the same rules as the apis to the occurrence of the schedule
+func (w *ScheduledTransferWorker) transfer(ctx context.Context, scheduled db.ScheduledTransfer) (db.TransferTxResult, error) {
+	from, err := w.store.GetAccount(ctx, scheduled.FromAccountID)
+	if err != nil {
+		return db.TransferTxResult{}, err
+	}
+
+	to, err := w.store.GetAccount(ctx, scheduled.ToAccountID)
+	if err != nil {
+		return db.TransferTxResult{}, err
+	}
+
+	if from.IsDeleted {
+		return db.TransferTxResult{}, ErrAccountDeleted(from.ID)
+	}
+
+	if to.IsDeleted {
+		return db.TransferTxResult{}, ErrAccountDeleted(to.ID)
+	}
+
+	if err = from.CanDebit(); err != nil {
+		return db.TransferTxResult{}, fmt.Errorf("account %d: %w", from.ID, err)
+	}
+
+	if err = to.CanCredit(); err != nil {
+		return db.TransferTxResult{}, fmt.Errorf("account %d: %w", to.ID, err)
+	}
+
+	arg := db.TransferTxParam{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        scheduled.Amount,
+		Idempotency: &db.IdempotencyParam{
+			Key:       scheduled.IdempotencyKey(),
+			Username:  scheduled.Owner,
+			Retention: w.idempotencyRetention,
+		},
+	}
+
+	if from.Currency != to.Currency {
+		rate, err := w.rates.Rate(from.Currency, to.Currency)
+		if err != nil {
+			return db.TransferTxResult{}, err
+		}
+		arg.Rate = &rate
+	}
+
+	// The owner scheduled the transfer, so the audit log records them as the actor of its occurrences
+	ctx = db.WithActor(ctx, db.Actor{Username: scheduled.Owner, UserAgent: WorkerUserAgent})
+	return w.store.TransferTx(ctx, arg)
+}
@@ -0,0 +1,179 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/escalopa/gobank/db/mock"
+	db "github.com/escalopa/gobank/db/sqlc"
+	"github.com/escalopa/gobank/fx"
+	"github.com/escalopa/gobank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWorker(t *testing.T, store db.Store) *ScheduledTransferWorker {
+	config := util.NewConfig()
+	config.Set("SCHEDULED_TRANSFER_INTERVAL", "1s")
+
+	w, err := NewScheduledTransferWorker(config, store)
+	require.NoError(t, err)
+
+	w.rates, err = fx.NewStaticProvider(map[fx.Pair]int64{fx.NewPair(util.USD, util.EGP): fx.RateScale / 2})
+	require.NoError(t, err)
+	return w
+}
+
+func randomAccount(currency string) db.Account {
+	return db.Account{
+		ID:       util.RandomInteger(1, 1000),
+		Owner:    util.RandomOwner(),
+		Balance:  util.RandomMoney(),
+		Currency: currency,
+	}
+}
+
+func TestNewScheduledTransferWorker(t *testing.T) {
+	config := util.NewConfig()
+	config.Set("SCHEDULED_TRANSFER_INTERVAL", "0s")
+
+	_, err := NewScheduledTransferWorker(config, nil)
+	require.Error(t, err)
+
+	config.Set("SCHEDULED_TRANSFER_INTERVAL", "")
+	w, err := NewScheduledTransferWorker(config, nil)
+	require.NoError(t, err)
+	require.Equal(t, DefaultInterval, w.interval)
+}
+
+func TestTransfer(t *testing.T) {
+	from, to := randomAccount(util.USD), randomAccount(util.EGP)
+	to.ID = from.ID + 1
+	scheduled := db.ScheduledTransfer{
+		ID:            util.RandomInteger(1, 1000),
+		Owner:         from.Owner,
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        10,
+		Frequency:     db.TransferFrequencyWeekly,
+		Occurrence:    3,
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore)
+		check      func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(from.ID)).Times(1).Return(from, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(to.ID)).Times(1).Return(to, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ context.Context, arg db.TransferTxParam) (db.TransferTxResult, error) {
+						require.Equal(t, from.ID, arg.FromAccountID)
+						require.Equal(t, to.ID, arg.ToAccountID)
+						require.Equal(t, scheduled.Amount, arg.Amount)
+						require.Equal(t, int64(fx.RateScale/2), arg.Rate.Value)
+
+						// Retrying the occurrence replays its transfer
+						require.Equal(t, scheduled.IdempotencyKey(), arg.Idempotency.Key)
+						require.Equal(t, scheduled.Owner, arg.Idempotency.Username)
+						return db.TransferTxResult{Transfer: db.Transfer{ID: 1}}, nil
+					})
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "AccountDeleted",
+			buildStubs: func(store *mockdb.MockStore) {
+				deleted := to
+				deleted.IsDeleted = true
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(from.ID)).Times(1).Return(from, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(to.ID)).Times(1).Return(deleted, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, err error) {
+				require.EqualError(t, err, ErrAccountDeleted(to.ID).Error())
+			},
+		},
+		{
+			name: "AccountFrozen",
+			buildStubs: func(store *mockdb.MockStore) {
+				frozen := from
+				frozen.Status = db.AccountStatusFrozen
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(from.ID)).Times(1).Return(frozen, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(to.ID)).Times(1).Return(to, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, db.ErrAccountFrozen)
+			},
+		},
+		{
+			name: "RateNotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				other := to
+				other.Currency = util.RUB
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(from.ID)).Times(1).Return(from, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(to.ID)).Times(1).Return(other, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, fx.ErrRateNotFound)
+			},
+		},
+		{
+			name: "InsufficientFunds",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(from.ID)).Times(1).Return(from, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(to.ID)).Times(1).Return(to, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(1).Return(db.TransferTxResult{}, db.ErrInsufficientFunds)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, db.ErrInsufficientFunds)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			_, err := newTestWorker(t, store).transfer(context.Background(), scheduled)
+			tc.check(t, err)
+		})
+	}
+}
+
+func TestRunDue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	w := newTestWorker(t, store)
+
+	// Due schedules are run until none is left
+	gomock.InOrder(
+		store.EXPECT().RunScheduledTransferTx(gomock.Any(), gomock.Any(), gomock.Any()).Times(2).
+			Return(db.ScheduledTransferAttempt{ScheduledTransferID: 1, TransferID: sql.NullInt64{Int64: 1, Valid: true}}, nil),
+		store.EXPECT().RunScheduledTransferTx(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).
+			Return(db.ScheduledTransferAttempt{}, db.ErrNoScheduledTransferDue),
+	)
+	require.NoError(t, w.runDue(context.Background()))
+
+	// The worker stops with its context
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	store.EXPECT().RunScheduledTransferTx(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().
+		Return(db.ScheduledTransferAttempt{}, db.ErrNoScheduledTransferDue)
+	require.ErrorIs(t, w.Start(ctx), context.DeadlineExceeded)
+}